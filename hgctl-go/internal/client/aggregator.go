@@ -78,6 +78,132 @@ func (c *AggregatorClient) DeRegisterAvs(ctx context.Context, avsAddress string)
 	return nil
 }
 
+// GetTask returns a single task tracked by the aggregator along with each operator's response
+func (c *AggregatorClient) GetTask(ctx context.Context, taskID string) (*pb.GetTaskResponse, error) {
+	c.logger.Debug("Getting task from aggregator", zap.String("taskID", taskID))
+
+	resp, err := c.client.GetTask(ctx, &pb.GetTaskRequest{
+		TaskId: taskID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListTasks returns a page of tasks tracked by the aggregator
+func (c *AggregatorClient) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
+	c.logger.Debug("Listing tasks from aggregator",
+		zap.String("avsAddress", req.AvsAddress),
+		zap.Strings("statuses", req.Statuses))
+
+	resp, err := c.client.ListTasks(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetOperatorScoreboard returns liveness and performance scores for operators the aggregator has broadcast tasks to
+func (c *AggregatorClient) GetOperatorScoreboard(ctx context.Context, req *pb.GetOperatorScoreboardRequest) ([]*pb.AggregatorOperatorScore, error) {
+	c.logger.Debug("Getting operator scoreboard from aggregator", zap.String("avsAddress", req.AvsAddress))
+
+	resp, err := c.client.GetOperatorScoreboard(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operator scoreboard: %w", err)
+	}
+
+	return resp.Scores, nil
+}
+
+// GetRecoveryStatus returns how pending tasks were recovered after the aggregator last started
+func (c *AggregatorClient) GetRecoveryStatus(ctx context.Context, req *pb.GetRecoveryStatusRequest) ([]*pb.AggregatorRecoveryStatus, error) {
+	c.logger.Debug("Getting recovery status from aggregator", zap.String("avsAddress", req.AvsAddress))
+
+	resp, err := c.client.GetRecoveryStatus(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recovery status: %w", err)
+	}
+
+	return resp.Statuses, nil
+}
+
+// ReplayTasks re-ingests the TaskCreated events of a block range on the aggregator
+func (c *AggregatorClient) ReplayTasks(ctx context.Context, req *pb.ReplayTasksRequest) (*pb.ReplayTasksResponse, error) {
+	c.logger.Debug("Replaying tasks on aggregator",
+		zap.String("avsAddress", req.AvsAddress),
+		zap.Uint32("chainId", req.ChainId),
+		zap.Uint64("fromBlock", req.FromBlock),
+		zap.Uint64("toBlock", req.ToBlock))
+
+	resp, err := c.client.ReplayTasks(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay tasks: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SubmitTask queues an off-chain task for an AVS on the aggregator
+func (c *AggregatorClient) SubmitTask(ctx context.Context, req *pb.SubmitTaskRequest) (*pb.SubmitTaskResponse, error) {
+	c.logger.Debug("Submitting task to aggregator",
+		zap.String("avsAddress", req.AvsAddress),
+		zap.Uint32("operatorSetId", req.OperatorSetId),
+		zap.Uint32("chainId", req.ChainId))
+
+	resp, err := c.client.SubmitTask(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit task: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetCheckpoints returns the last block each chain poller of the aggregator processed
+func (c *AggregatorClient) GetCheckpoints(ctx context.Context, req *pb.GetCheckpointsRequest) ([]*pb.AggregatorCheckpoint, error) {
+	c.logger.Debug("Getting checkpoints from aggregator",
+		zap.String("avsAddress", req.AvsAddress),
+		zap.Uint32("chainId", req.ChainId))
+
+	resp, err := c.client.GetCheckpoints(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get checkpoints: %w", err)
+	}
+
+	return resp.Checkpoints, nil
+}
+
+// RewindCheckpoint moves a chain poller of the aggregator back to an earlier block
+func (c *AggregatorClient) RewindCheckpoint(ctx context.Context, req *pb.RewindCheckpointRequest) (*pb.MoveCheckpointResponse, error) {
+	c.logger.Debug("Rewinding checkpoint on aggregator",
+		zap.String("avsAddress", req.AvsAddress),
+		zap.Uint32("chainId", req.ChainId),
+		zap.Uint64("blockNumber", req.BlockNumber))
+
+	resp, err := c.client.RewindCheckpoint(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rewind checkpoint: %w", err)
+	}
+
+	return resp, nil
+}
+
+// FastForwardCheckpoint moves a chain poller of the aggregator to the chain head
+func (c *AggregatorClient) FastForwardCheckpoint(ctx context.Context, req *pb.FastForwardCheckpointRequest) (*pb.MoveCheckpointResponse, error) {
+	c.logger.Debug("Fast-forwarding checkpoint on aggregator",
+		zap.String("avsAddress", req.AvsAddress),
+		zap.Uint32("chainId", req.ChainId))
+
+	resp, err := c.client.FastForwardCheckpoint(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fast-forward checkpoint: %w", err)
+	}
+
+	return resp, nil
+}
+
 // Close closes the gRPC connection
 func (c *AggregatorClient) Close() error {
 	if c.conn != nil {
@@ -87,6 +87,19 @@ func (c *Client) GetPerformers(ctx context.Context, avsAddress string) ([]*pb.Pe
 	return resp.Performers, nil
 }
 
+func (c *Client) GetAdmissionStats(ctx context.Context, avsAddress string) ([]*pb.AdmissionStats, error) {
+	req := &pb.GetAdmissionStatsRequest{
+		AvsAddress: avsAddress,
+	}
+
+	resp, err := c.client.GetAdmissionStats(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("get admission stats RPC failed: %w", err)
+	}
+
+	return resp.Stats, nil
+}
+
 func (c *Client) RemovePerformer(ctx context.Context, performerID string) error {
 	req := &pb.RemovePerformerRequest{
 		PerformerId: performerID,
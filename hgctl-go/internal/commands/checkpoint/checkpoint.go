@@ -0,0 +1,120 @@
+package checkpoint
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/client"
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/config"
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/output"
+	aggregatorV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/aggregator"
+)
+
+// Command returns the checkpoint command
+func Command() *cli.Command {
+	return &cli.Command{
+		Name:  "checkpoint",
+		Usage: "Inspect and move the aggregator's chain poller checkpoints",
+		Description: `A checkpoint is the last block a chain poller of an AVS processed; the
+poller resumes with the block after it. Rewinding makes the poller process
+blocks again, and fast-forwarding skips every block up to the chain head along
+with the tasks created in them. The poller is paused while its checkpoint moves.`,
+		Subcommands: []*cli.Command{
+			showCommand(),
+			rewindCommand(),
+			fastForwardCommand(),
+		},
+	}
+}
+
+func outputFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  "output",
+		Usage: "Output format (table, json, yaml)",
+		Value: "table",
+	}
+}
+
+func newAggregatorClient(c *cli.Context) (*client.AggregatorClient, *config.Context, error) {
+	currentCtx := c.Context.Value(config.ContextKey).(*config.Context)
+	log := config.LoggerFromContext(c.Context)
+
+	if currentCtx == nil {
+		return nil, nil, fmt.Errorf("no context configured")
+	}
+
+	if currentCtx.AggregatorEndpoint == "" {
+		return nil, nil, fmt.Errorf("aggregator address not configured")
+	}
+
+	aggregatorClient, err := client.NewAggregatorClient(currentCtx.AggregatorEndpoint, log)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create aggregator client: %w", err)
+	}
+	return aggregatorClient, currentCtx, nil
+}
+
+func avsAddressFromFlags(c *cli.Context, currentCtx *config.Context) (string, error) {
+	avsAddress := c.String("avs-address")
+	if avsAddress == "" {
+		avsAddress = currentCtx.AVSAddress
+	}
+	if avsAddress == "" {
+		return "", fmt.Errorf("AVS address not configured. Use --avs-address or run 'hgctl context set --avs-address <address>'")
+	}
+	return avsAddress, nil
+}
+
+func printMove(c *cli.Context, resp *aggregatorV1.MoveCheckpointResponse) error {
+	outputFormat := c.String("output")
+
+	switch outputFormat {
+	case "json":
+		formatter := output.NewFormatter(outputFormat)
+		return formatter.PrintJSON(resp)
+	case "yaml":
+		formatter := output.NewFormatter(outputFormat)
+		return formatter.PrintYAML(resp)
+	default:
+		printCheckpointTable(c, []string{"previous", "current"}, []*aggregatorV1.AggregatorCheckpoint{resp.Previous, resp.Current})
+	}
+	return nil
+}
+
+// printCheckpointTable prints the checkpoints, with an extra first column if labels is set
+func printCheckpointTable(c *cli.Context, labels []string, checkpoints []*aggregatorV1.AggregatorCheckpoint) {
+	table := tablewriter.NewWriter(c.App.Writer)
+	header := []string{"AVS ADDRESS", "CHAIN", "BLOCK", "BLOCK HASH", "BLOCK TIME", "HEAD", "LAG"}
+	if labels != nil {
+		header = append([]string{""}, header...)
+	}
+	table.SetHeader(header)
+
+	for i, cp := range checkpoints {
+		row := []string{
+			cp.AvsAddress,
+			fmt.Sprintf("%d", cp.ChainId),
+			fmt.Sprintf("%d", cp.BlockNumber),
+			cp.BlockHash,
+			blockTime(cp.BlockTimestamp),
+			fmt.Sprintf("%d", cp.IngestionHead),
+			fmt.Sprintf("%d", cp.LagBlocks),
+		}
+		if labels != nil {
+			row = append([]string{labels[i]}, row...)
+		}
+		table.Append(row)
+	}
+
+	table.Render()
+}
+
+func blockTime(timestamp int64) string {
+	if timestamp == 0 {
+		return "-"
+	}
+	return time.Unix(timestamp, 0).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,61 @@
+package checkpoint
+
+import (
+	"github.com/urfave/cli/v2"
+	"go.uber.org/zap"
+
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/config"
+	aggregatorV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/aggregator"
+)
+
+func fastForwardCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "fast-forward",
+		Usage: "Move a chain poller to the chain head, skipping the blocks in between",
+		Description: `Tasks created in the skipped blocks are not picked up; use 'hgctl replay' to
+process them later. The head respects the chain's finality policy.`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "avs-address",
+				Usage: "AVS whose poller to fast-forward (defaults to the context's AVS)",
+			},
+			&cli.UintFlag{
+				Name:     "chain-id",
+				Usage:    "Chain of the poller",
+				Required: true,
+			},
+			outputFlag(),
+		},
+		Action: fastForwardAction,
+	}
+}
+
+func fastForwardAction(c *cli.Context) error {
+	log := config.LoggerFromContext(c.Context)
+
+	aggregatorClient, currentCtx, err := newAggregatorClient(c)
+	if err != nil {
+		return err
+	}
+	defer aggregatorClient.Close()
+
+	avsAddress, err := avsAddressFromFlags(c, currentCtx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := aggregatorClient.FastForwardCheckpoint(c.Context, &aggregatorV1.FastForwardCheckpointRequest{
+		AvsAddress: avsAddress,
+		ChainId:    uint32(c.Uint("chain-id")),
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("Fast-forwarded checkpoint",
+		zap.Uint64("previousBlock", resp.Previous.BlockNumber),
+		zap.Uint64("block", resp.Current.BlockNumber),
+		zap.Uint64("skippedBlocks", resp.Current.BlockNumber-resp.Previous.BlockNumber))
+
+	return printMove(c, resp)
+}
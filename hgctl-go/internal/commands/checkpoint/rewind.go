@@ -0,0 +1,68 @@
+package checkpoint
+
+import (
+	"github.com/urfave/cli/v2"
+	"go.uber.org/zap"
+
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/config"
+	aggregatorV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/aggregator"
+)
+
+func rewindCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "rewind",
+		Usage: "Move a chain poller back so it processes the blocks after --block again",
+		Description: `Tasks of the rewound blocks that the aggregator already stored are skipped as
+duplicates; use 'hgctl replay' to process them again. The block must be before
+the current checkpoint, and within the chain's maximum catch-up depth of the
+head.`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "avs-address",
+				Usage: "AVS whose poller to rewind (defaults to the context's AVS)",
+			},
+			&cli.UintFlag{
+				Name:     "chain-id",
+				Usage:    "Chain of the poller",
+				Required: true,
+			},
+			&cli.Uint64Flag{
+				Name:     "block",
+				Usage:    "Block to rewind to; the poller resumes with the block after it",
+				Required: true,
+			},
+			outputFlag(),
+		},
+		Action: rewindAction,
+	}
+}
+
+func rewindAction(c *cli.Context) error {
+	log := config.LoggerFromContext(c.Context)
+
+	aggregatorClient, currentCtx, err := newAggregatorClient(c)
+	if err != nil {
+		return err
+	}
+	defer aggregatorClient.Close()
+
+	avsAddress, err := avsAddressFromFlags(c, currentCtx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := aggregatorClient.RewindCheckpoint(c.Context, &aggregatorV1.RewindCheckpointRequest{
+		AvsAddress:  avsAddress,
+		ChainId:     uint32(c.Uint("chain-id")),
+		BlockNumber: c.Uint64("block"),
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("Rewound checkpoint",
+		zap.Uint64("previousBlock", resp.Previous.BlockNumber),
+		zap.Uint64("block", resp.Current.BlockNumber))
+
+	return printMove(c, resp)
+}
@@ -0,0 +1,69 @@
+package checkpoint
+
+import (
+	"github.com/urfave/cli/v2"
+	"go.uber.org/zap"
+
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/config"
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/output"
+	aggregatorV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/aggregator"
+)
+
+func showCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "show",
+		Usage: "Show the last block each chain poller processed",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "avs-address",
+				Usage: "Only show checkpoints of this AVS",
+			},
+			&cli.UintFlag{
+				Name:  "chain-id",
+				Usage: "Only show checkpoints on this chain",
+			},
+			outputFlag(),
+		},
+		Action: showAction,
+	}
+}
+
+func showAction(c *cli.Context) error {
+	log := config.LoggerFromContext(c.Context)
+
+	aggregatorClient, _, err := newAggregatorClient(c)
+	if err != nil {
+		return err
+	}
+	defer aggregatorClient.Close()
+
+	checkpoints, err := aggregatorClient.GetCheckpoints(c.Context, &aggregatorV1.GetCheckpointsRequest{
+		AvsAddress: c.String("avs-address"),
+		ChainId:    uint32(c.Uint("chain-id")),
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(checkpoints) == 0 {
+		log.Info("No checkpoints found")
+		return nil
+	}
+
+	log.Info("Found checkpoints", zap.Int("count", len(checkpoints)))
+
+	outputFormat := c.String("output")
+
+	switch outputFormat {
+	case "json":
+		formatter := output.NewFormatter(outputFormat)
+		return formatter.PrintJSON(checkpoints)
+	case "yaml":
+		formatter := output.NewFormatter(outputFormat)
+		return formatter.PrintYAML(checkpoints)
+	default:
+		printCheckpointTable(c, nil, checkpoints)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/commands/checkpoint"
 	contextcmd "github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/commands/context"
 	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/commands/describe"
 	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/commands/eigenlayer"
@@ -12,8 +13,10 @@ import (
 	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/commands/keystore"
 	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/commands/middleware"
 	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/commands/remove"
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/commands/replay"
 	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/commands/run"
 	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/commands/signer"
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/commands/submit"
 	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/commands/telemetry"
 	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/config"
 	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/hooks"
@@ -104,6 +107,9 @@ and deploy AVS artifacts including EigenRuntime specifications.`,
 			DescribeCommand(),
 			DeployCommand(),
 			RemoveCommand(),
+			ReplayCommand(),
+			SubmitCommand(),
+			CheckpointCommand(),
 			ContextCommand(),
 			KeystoreCommand(),
 			SignerCommand(),
@@ -146,6 +152,24 @@ func RemoveCommand() *cli.Command {
 	return cmd
 }
 
+func ReplayCommand() *cli.Command {
+	cmd := replay.Command()
+	cmd.Before = middleware.RequireContext
+	return cmd
+}
+
+func SubmitCommand() *cli.Command {
+	cmd := submit.Command()
+	cmd.Before = middleware.RequireContext
+	return cmd
+}
+
+func CheckpointCommand() *cli.Command {
+	cmd := checkpoint.Command()
+	cmd.Before = middleware.RequireContext
+	return cmd
+}
+
 func ContextCommand() *cli.Command {
 	return contextcmd.Command()
 }
@@ -0,0 +1,118 @@
+package get
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/zap"
+
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/client"
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/config"
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/output"
+)
+
+func admissionCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "admission",
+		Usage:     "Get task admission limits and stats per AVS",
+		ArgsUsage: "[avs-address]",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "output",
+				Usage: "Output format (table, json, yaml)",
+				Value: "table",
+			},
+		},
+		Action: getAdmissionAction,
+	}
+}
+
+func getAdmissionAction(c *cli.Context) error {
+	var avsAddress string
+	if c.NArg() > 0 {
+		avsAddress = c.Args().Get(0)
+	}
+
+	// Get context
+	currentCtx := c.Context.Value(config.ContextKey).(*config.Context)
+	log := config.LoggerFromContext(c.Context)
+
+	if currentCtx == nil {
+		return fmt.Errorf("no context configured")
+	}
+
+	if currentCtx.ExecutorEndpoint == "" {
+		return fmt.Errorf("executor address not configured")
+	}
+
+	log.Info("Getting admission stats")
+
+	// Create executor client
+	executorClient, err := client.NewExecutorClient(currentCtx.ExecutorEndpoint, log)
+	if err != nil {
+		return fmt.Errorf("failed to create executor client: %w", err)
+	}
+	defer executorClient.Close()
+
+	stats, err := executorClient.GetAdmissionStats(c.Context, avsAddress)
+	if err != nil {
+		return fmt.Errorf("failed to get admission stats: %w", err)
+	}
+
+	if len(stats) == 0 {
+		log.Info("No AVSs found")
+		return nil
+	}
+
+	log.Info("Found admission stats", zap.Int("count", len(stats)))
+
+	// Format output
+	outputFormat := c.String("output")
+
+	switch outputFormat {
+	case "json":
+		formatter := output.NewFormatter(outputFormat)
+		return formatter.PrintJSON(stats)
+	case "yaml":
+		formatter := output.NewFormatter(outputFormat)
+		return formatter.PrintYAML(stats)
+	default:
+		// Table output
+		table := tablewriter.NewWriter(c.App.Writer)
+		table.SetHeader([]string{"AVS ADDRESS", "RUNNING", "QUEUED", "TIMEOUT", "ADMITTED", "REJECTED (FULL)", "REJECTED (TIMEOUT)"})
+
+		for _, s := range stats {
+			running := fmt.Sprintf("%d", s.RunningTasks)
+			if s.MaxConcurrentTasks > 0 {
+				running = fmt.Sprintf("%d/%d", s.RunningTasks, s.MaxConcurrentTasks)
+			}
+
+			queued := "-"
+			if s.MaxConcurrentTasks > 0 {
+				queued = fmt.Sprintf("%d/%d", s.QueuedTasks, s.QueueDepth)
+			}
+
+			timeout := "-"
+			if s.TaskTimeoutSeconds > 0 {
+				timeout = (time.Duration(s.TaskTimeoutSeconds) * time.Second).String()
+			}
+
+			table.Append([]string{
+				s.AvsAddress,
+				running,
+				queued,
+				timeout,
+				strconv.FormatUint(s.AdmittedTasks, 10),
+				strconv.FormatUint(s.RejectedQueueFull, 10),
+				strconv.FormatUint(s.RejectedTimeout, 10),
+			})
+		}
+
+		table.Render()
+	}
+
+	return nil
+}
@@ -13,6 +13,10 @@ func Command() *cli.Command {
 			performerCommand(),
 			releaseCommand(),
 			operatorSetCommand(),
+			taskCommand(),
+			scoreboardCommand(),
+			recoveryCommand(),
+			admissionCommand(),
 		},
 	}
 }
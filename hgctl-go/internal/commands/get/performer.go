@@ -81,7 +81,7 @@ func getPerformerAction(c *cli.Context) error {
 	default:
 		// Table output
 		table := tablewriter.NewWriter(c.App.Writer)
-		table.SetHeader([]string{"ID", "AVS ADDRESS", "DIGEST", "STATUS", "LAST HEALTH CHECK"})
+		table.SetHeader([]string{"ID", "AVS ADDRESS", "DIGEST", "STATUS", "REPLICAS", "LAST HEALTH CHECK"})
 
 		for _, p := range performers {
 			digest := p.ArtifactDigest
@@ -89,11 +89,17 @@ func getPerformerAction(c *cli.Context) error {
 				digest = digest[:12] + "..."
 			}
 
+			replicas := "-"
+			if p.Replicas > 0 {
+				replicas = fmt.Sprintf("%d/%d", p.HealthyReplicas, p.Replicas)
+			}
+
 			table.Append([]string{
 				p.PerformerId,
 				p.AvsAddress,
 				digest,
 				p.Status,
+				replicas,
 				p.LastHealthCheck,
 			})
 		}
@@ -0,0 +1,109 @@
+package get
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/zap"
+
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/client"
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/config"
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/output"
+	aggregatorV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/aggregator"
+)
+
+func recoveryCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "recovery",
+		Usage: "Get the progress of pending task recovery from the aggregator",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "avs-address",
+				Usage: "Only show recovery for this AVS",
+			},
+			&cli.StringFlag{
+				Name:  "output",
+				Usage: "Output format (table, json, yaml)",
+				Value: "table",
+			},
+		},
+		Action: getRecoveryAction,
+	}
+}
+
+func getRecoveryAction(c *cli.Context) error {
+	currentCtx := c.Context.Value(config.ContextKey).(*config.Context)
+	log := config.LoggerFromContext(c.Context)
+
+	if currentCtx == nil {
+		return fmt.Errorf("no context configured")
+	}
+
+	if currentCtx.AggregatorEndpoint == "" {
+		return fmt.Errorf("aggregator address not configured")
+	}
+
+	aggregatorClient, err := client.NewAggregatorClient(currentCtx.AggregatorEndpoint, log)
+	if err != nil {
+		return fmt.Errorf("failed to create aggregator client: %w", err)
+	}
+	defer aggregatorClient.Close()
+
+	statuses, err := aggregatorClient.GetRecoveryStatus(c.Context, &aggregatorV1.GetRecoveryStatusRequest{
+		AvsAddress: c.String("avs-address"),
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(statuses) == 0 {
+		log.Info("No registered AVSs found")
+		return nil
+	}
+
+	log.Info("Found recovery statuses", zap.Int("count", len(statuses)))
+
+	outputFormat := c.String("output")
+
+	switch outputFormat {
+	case "json":
+		formatter := output.NewFormatter(outputFormat)
+		return formatter.PrintJSON(statuses)
+	case "yaml":
+		formatter := output.NewFormatter(outputFormat)
+		return formatter.PrintYAML(statuses)
+	default:
+		table := tablewriter.NewWriter(c.App.Writer)
+		table.SetHeader([]string{"AVS ADDRESS", "STATE", "PENDING", "RECOVERED", "SKIPPED", "EXPIRED", "DURATION", "ERROR"})
+
+		for _, s := range statuses {
+			table.Append([]string{
+				s.AvsAddress,
+				s.State,
+				fmt.Sprintf("%d", s.Pending),
+				fmt.Sprintf("%d", s.Recovered),
+				fmt.Sprintf("%d", s.Skipped),
+				fmt.Sprintf("%d", s.Expired),
+				recoveryDuration(s),
+				s.Error,
+			})
+		}
+
+		table.Render()
+	}
+
+	return nil
+}
+
+func recoveryDuration(s *aggregatorV1.AggregatorRecoveryStatus) string {
+	if s.StartedAt == 0 {
+		return "-"
+	}
+	end := time.Now()
+	if s.CompletedAt != 0 {
+		end = time.Unix(s.CompletedAt, 0)
+	}
+	return end.Sub(time.Unix(s.StartedAt, 0)).String()
+}
@@ -0,0 +1,111 @@
+package get
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/zap"
+
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/client"
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/config"
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/output"
+	aggregatorV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/aggregator"
+)
+
+func scoreboardCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "scoreboard",
+		Usage: "Get operator liveness and performance scores from the aggregator",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "avs-address",
+				Usage: "Only show operators for this AVS",
+			},
+			&cli.IntFlag{
+				Name:  "operator-set-id",
+				Usage: "Only show operators in this operator set",
+				Value: -1,
+			},
+			&cli.StringFlag{
+				Name:  "output",
+				Usage: "Output format (table, json, yaml)",
+				Value: "table",
+			},
+		},
+		Action: getScoreboardAction,
+	}
+}
+
+func getScoreboardAction(c *cli.Context) error {
+	currentCtx := c.Context.Value(config.ContextKey).(*config.Context)
+	log := config.LoggerFromContext(c.Context)
+
+	if currentCtx == nil {
+		return fmt.Errorf("no context configured")
+	}
+
+	if currentCtx.AggregatorEndpoint == "" {
+		return fmt.Errorf("aggregator address not configured")
+	}
+
+	aggregatorClient, err := client.NewAggregatorClient(currentCtx.AggregatorEndpoint, log)
+	if err != nil {
+		return fmt.Errorf("failed to create aggregator client: %w", err)
+	}
+	defer aggregatorClient.Close()
+
+	req := &aggregatorV1.GetOperatorScoreboardRequest{
+		AvsAddress: c.String("avs-address"),
+	}
+	if opsetId := c.Int("operator-set-id"); opsetId >= 0 {
+		id := uint32(opsetId)
+		req.OperatorSetId = &id
+	}
+
+	scores, err := aggregatorClient.GetOperatorScoreboard(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	if len(scores) == 0 {
+		log.Info("No operator scores found")
+		return nil
+	}
+
+	log.Info("Found operator scores", zap.Int("count", len(scores)))
+
+	outputFormat := c.String("output")
+
+	switch outputFormat {
+	case "json":
+		formatter := output.NewFormatter(outputFormat)
+		return formatter.PrintJSON(scores)
+	case "yaml":
+		formatter := output.NewFormatter(outputFormat)
+		return formatter.PrintYAML(scores)
+	default:
+		table := tablewriter.NewWriter(c.App.Writer)
+		table.SetHeader([]string{"AVS ADDRESS", "OPSET", "OPERATOR", "ASSIGNED", "RESPONSE RATE", "P50", "P99", "SIG FAILURES", "DISAGREEMENTS", "CONSECUTIVE MISSES"})
+
+		for _, s := range scores {
+			table.Append([]string{
+				s.AvsAddress,
+				fmt.Sprintf("%d", s.OperatorSetId),
+				s.OperatorAddress,
+				fmt.Sprintf("%d", s.TasksAssigned),
+				fmt.Sprintf("%.1f%%", s.ResponseRate*100),
+				(time.Duration(s.MedianLatencyMs) * time.Millisecond).String(),
+				(time.Duration(s.P99LatencyMs) * time.Millisecond).String(),
+				fmt.Sprintf("%d", s.SignatureFailures),
+				fmt.Sprintf("%d", s.DigestDisagreements),
+				fmt.Sprintf("%d", s.ConsecutiveMisses),
+			})
+		}
+
+		table.Render()
+	}
+
+	return nil
+}
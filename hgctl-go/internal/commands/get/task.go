@@ -0,0 +1,205 @@
+package get
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/zap"
+
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/client"
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/config"
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/output"
+	aggregatorV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/aggregator"
+)
+
+func taskCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "task",
+		Usage:     "Get tasks tracked by the aggregator",
+		ArgsUsage: "[task-id]",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "avs-address",
+				Usage: "Only list tasks for this AVS",
+			},
+			&cli.StringSliceFlag{
+				Name:  "status",
+				Usage: "Only list tasks with this status (pending, processing, completed, failed)",
+			},
+			&cli.IntFlag{
+				Name:  "operator-set-id",
+				Usage: "Only list tasks for this operator set",
+				Value: -1,
+			},
+			&cli.UintFlag{
+				Name:  "chain-id",
+				Usage: "Only list tasks from this chain",
+			},
+			&cli.DurationFlag{
+				Name:  "since",
+				Usage: "Only list tasks created within this duration (e.g. 1h)",
+			},
+			&cli.UintFlag{
+				Name:  "limit",
+				Usage: "Maximum number of tasks to return",
+				Value: 50,
+			},
+			&cli.StringFlag{
+				Name:  "page-token",
+				Usage: "Page token returned by a previous call",
+			},
+			&cli.StringFlag{
+				Name:  "output",
+				Usage: "Output format (table, json, yaml)",
+				Value: "table",
+			},
+		},
+		Action: getTaskAction,
+	}
+}
+
+func getTaskAction(c *cli.Context) error {
+	currentCtx := c.Context.Value(config.ContextKey).(*config.Context)
+	log := config.LoggerFromContext(c.Context)
+
+	if currentCtx == nil {
+		return fmt.Errorf("no context configured")
+	}
+
+	if currentCtx.AggregatorEndpoint == "" {
+		return fmt.Errorf("aggregator address not configured")
+	}
+
+	aggregatorClient, err := client.NewAggregatorClient(currentCtx.AggregatorEndpoint, log)
+	if err != nil {
+		return fmt.Errorf("failed to create aggregator client: %w", err)
+	}
+	defer aggregatorClient.Close()
+
+	if c.NArg() > 0 {
+		resp, err := aggregatorClient.GetTask(c.Context, c.Args().Get(0))
+		if err != nil {
+			return err
+		}
+		return printTask(c, resp)
+	}
+
+	req := &aggregatorV1.ListTasksRequest{
+		AvsAddress: c.String("avs-address"),
+		Statuses:   c.StringSlice("status"),
+		ChainId:    uint32(c.Uint("chain-id")),
+		PageSize:   uint32(c.Uint("limit")),
+		PageToken:  c.String("page-token"),
+	}
+	if opsetId := c.Int("operator-set-id"); opsetId >= 0 {
+		id := uint32(opsetId)
+		req.OperatorSetId = &id
+	}
+	if since := c.Duration("since"); since > 0 {
+		req.CreatedAfter = time.Now().Add(-since).Unix()
+	}
+
+	resp, err := aggregatorClient.ListTasks(c.Context, req)
+	if err != nil {
+		return err
+	}
+	tasks := resp.Tasks
+
+	if len(tasks) == 0 {
+		log.Info("No tasks found")
+		return nil
+	}
+
+	log.Info("Found tasks", zap.Int("count", len(tasks)))
+
+	outputFormat := c.String("output")
+
+	switch outputFormat {
+	case "json":
+		formatter := output.NewFormatter(outputFormat)
+		return formatter.PrintJSON(tasks)
+	case "yaml":
+		formatter := output.NewFormatter(outputFormat)
+		return formatter.PrintYAML(tasks)
+	default:
+		printTaskTable(c, tasks)
+
+		if resp.NextPageToken != "" {
+			fmt.Fprintf(c.App.Writer, "\nMore tasks available, use --page-token %s\n", resp.NextPageToken)
+		}
+	}
+
+	return nil
+}
+
+func printTask(c *cli.Context, resp *aggregatorV1.GetTaskResponse) error {
+	outputFormat := c.String("output")
+
+	switch outputFormat {
+	case "json":
+		formatter := output.NewFormatter(outputFormat)
+		return formatter.PrintJSON(resp)
+	case "yaml":
+		formatter := output.NewFormatter(outputFormat)
+		return formatter.PrintYAML(resp)
+	default:
+		printTaskTable(c, []*aggregatorV1.AggregatorTask{resp.Task})
+
+		if len(resp.Responses) == 0 {
+			return nil
+		}
+
+		fmt.Fprintln(c.App.Writer)
+		table := tablewriter.NewWriter(c.App.Writer)
+		table.SetHeader([]string{"OPERATOR", "STATUS", "OUTPUT DIGEST", "LATENCY", "ERROR"})
+		for _, r := range resp.Responses {
+			latency := ""
+			if r.LatencyMs > 0 {
+				latency = (time.Duration(r.LatencyMs) * time.Millisecond).String()
+			}
+			table.Append([]string{
+				r.OperatorAddress,
+				r.Status,
+				r.OutputDigest,
+				latency,
+				strings.TrimSpace(r.Error),
+			})
+		}
+		table.Render()
+
+		if cert := resp.Task.Certificate; cert != nil {
+			fmt.Fprintf(c.App.Writer, "\nCertificate: %s curve, response digest %s", cert.CurveType, cert.TaskResponseDigest)
+			if cert.ReceiptHash != "" {
+				fmt.Fprintf(c.App.Writer, ", receipt %s", cert.ReceiptHash)
+			}
+			fmt.Fprintln(c.App.Writer)
+		}
+	}
+
+	return nil
+}
+
+func printTaskTable(c *cli.Context, tasks []*aggregatorV1.AggregatorTask) {
+	table := tablewriter.NewWriter(c.App.Writer)
+	table.SetHeader([]string{"TASK ID", "AVS ADDRESS", "OPSET", "CHAIN", "SOURCE", "STATUS", "CREATED", "RESPONSES", "TX HASH", "ERROR"})
+
+	for _, t := range tasks {
+		table.Append([]string{
+			t.TaskId,
+			t.AvsAddress,
+			fmt.Sprintf("%d", t.OperatorSetId),
+			fmt.Sprintf("%d", t.ChainId),
+			t.Source,
+			t.Status,
+			time.Unix(t.CreatedAt, 0).UTC().Format(time.RFC3339),
+			fmt.Sprintf("%d", len(t.RespondingOperators)),
+			t.SubmissionTxHash,
+			strings.TrimSpace(t.Error),
+		})
+	}
+
+	table.Render()
+}
@@ -0,0 +1,140 @@
+package replay
+
+import (
+	"fmt"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/zap"
+
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/client"
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/config"
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/output"
+	aggregatorV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/aggregator"
+)
+
+func Command() *cli.Command {
+	return &cli.Command{
+		Name:  "replay",
+		Usage: "Replay the tasks created in a block range on the aggregator",
+		Description: `Scans a chain for the TaskCreated events of an AVS between two blocks and
+feeds them to the aggregator again, e.g. after an outage or a bug fix.
+
+Tasks that already completed or are still pending are skipped, as are tasks
+whose deadline has passed. Use --dry-run to list the tasks that would be
+replayed without queueing them, and add --ignore-deadlines to include expired
+tasks in that list.`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "avs-address",
+				Usage: "AVS to replay tasks for (defaults to the context's AVS)",
+			},
+			&cli.UintFlag{
+				Name:     "chain-id",
+				Usage:    "Chain to scan for TaskCreated events",
+				Required: true,
+			},
+			&cli.Uint64Flag{
+				Name:     "from-block",
+				Usage:    "First block to scan",
+				Required: true,
+			},
+			&cli.Uint64Flag{
+				Name:     "to-block",
+				Usage:    "Last block to scan",
+				Required: true,
+			},
+			&cli.BoolFlag{
+				Name:  "dry-run",
+				Usage: "List the tasks that would be replayed without queueing them",
+			},
+			&cli.BoolFlag{
+				Name:  "ignore-deadlines",
+				Usage: "With --dry-run, list tasks whose deadline has passed as replayable",
+			},
+			&cli.StringFlag{
+				Name:  "output",
+				Usage: "Output format (table, json, yaml)",
+				Value: "table",
+			},
+		},
+		Action: replayAction,
+	}
+}
+
+func replayAction(c *cli.Context) error {
+	currentCtx := c.Context.Value(config.ContextKey).(*config.Context)
+	log := config.LoggerFromContext(c.Context)
+
+	if currentCtx == nil {
+		return fmt.Errorf("no context configured")
+	}
+
+	if currentCtx.AggregatorEndpoint == "" {
+		return fmt.Errorf("aggregator address not configured")
+	}
+
+	avsAddress := c.String("avs-address")
+	if avsAddress == "" {
+		avsAddress = currentCtx.AVSAddress
+	}
+	if avsAddress == "" {
+		return fmt.Errorf("AVS address not configured. Use --avs-address or run 'hgctl context set --avs-address <address>'")
+	}
+
+	if c.Bool("ignore-deadlines") && !c.Bool("dry-run") {
+		return fmt.Errorf("--ignore-deadlines can only be used with --dry-run")
+	}
+
+	aggregatorClient, err := client.NewAggregatorClient(currentCtx.AggregatorEndpoint, log)
+	if err != nil {
+		return fmt.Errorf("failed to create aggregator client: %w", err)
+	}
+	defer aggregatorClient.Close()
+
+	resp, err := aggregatorClient.ReplayTasks(c.Context, &aggregatorV1.ReplayTasksRequest{
+		AvsAddress:      avsAddress,
+		ChainId:         uint32(c.Uint("chain-id")),
+		FromBlock:       c.Uint64("from-block"),
+		ToBlock:         c.Uint64("to-block"),
+		DryRun:          c.Bool("dry-run"),
+		IgnoreDeadlines: c.Bool("ignore-deadlines"),
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("Replayed tasks",
+		zap.Int("found", len(resp.Tasks)),
+		zap.Uint64("replayed", resp.Replayed),
+		zap.Uint64("skippedCompleted", resp.SkippedCompleted),
+		zap.Uint64("skippedInProgress", resp.SkippedInProgress),
+		zap.Uint64("skippedExpired", resp.SkippedExpired),
+		zap.Bool("dryRun", c.Bool("dry-run")))
+
+	outputFormat := c.String("output")
+
+	switch outputFormat {
+	case "json":
+		formatter := output.NewFormatter(outputFormat)
+		return formatter.PrintJSON(resp)
+	case "yaml":
+		formatter := output.NewFormatter(outputFormat)
+		return formatter.PrintYAML(resp)
+	default:
+		table := tablewriter.NewWriter(c.App.Writer)
+		table.SetHeader([]string{"TASK ID", "BLOCK", "OUTCOME"})
+
+		for _, task := range resp.Tasks {
+			table.Append([]string{
+				task.TaskId,
+				fmt.Sprintf("%d", task.BlockNumber),
+				task.Outcome,
+			})
+		}
+
+		table.Render()
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package submit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/zap"
+
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/client"
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/config"
+	"github.com/Layr-Labs/hourglass-monorepo/hgctl-go/internal/output"
+	aggregatorV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/aggregator"
+)
+
+func Command() *cli.Command {
+	return &cli.Command{
+		Name:  "submit",
+		Usage: "Submit an off-chain task to the aggregator",
+		Description: `Queues a task for an AVS without creating it on chain. The AVS must have the
+submit task source enabled in the aggregator config.
+
+The task is signed by the operators like any other task, but its certificate
+is kept by the aggregator instead of being submitted; fetch it with
+'hgctl get task <task-id>'. Submitting again with the same --idempotency-key
+returns the task created the first time.`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "avs-address",
+				Usage: "AVS to submit the task to (defaults to the context's AVS)",
+			},
+			&cli.UintFlag{
+				Name:     "operator-set-id",
+				Usage:    "Executor operator set to run the task",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:     "payload",
+				Usage:    "Task payload as 0x-prefixed hex",
+				Required: true,
+			},
+			&cli.UintFlag{
+				Name:  "chain-id",
+				Usage: "Chain whose TaskMailbox holds the operator set config (defaults to the L1)",
+			},
+			&cli.Uint64Flag{
+				Name:  "reference-block",
+				Usage: "Block the operator set is read at (defaults to the last block the aggregator processed)",
+			},
+			&cli.DurationFlag{
+				Name:  "timeout",
+				Usage: "Time the operators have to respond (defaults to the AVS's default task timeout)",
+			},
+			&cli.StringFlag{
+				Name:  "idempotency-key",
+				Usage: "Key that makes a repeated submission return the same task",
+			},
+			&cli.StringFlag{
+				Name:  "output",
+				Usage: "Output format (table, json, yaml)",
+				Value: "table",
+			},
+		},
+		Action: submitAction,
+	}
+}
+
+func submitAction(c *cli.Context) error {
+	currentCtx := c.Context.Value(config.ContextKey).(*config.Context)
+	log := config.LoggerFromContext(c.Context)
+
+	if currentCtx == nil {
+		return fmt.Errorf("no context configured")
+	}
+
+	if currentCtx.AggregatorEndpoint == "" {
+		return fmt.Errorf("aggregator address not configured")
+	}
+
+	avsAddress := c.String("avs-address")
+	if avsAddress == "" {
+		avsAddress = currentCtx.AVSAddress
+	}
+	if avsAddress == "" {
+		return fmt.Errorf("AVS address not configured. Use --avs-address or run 'hgctl context set --avs-address <address>'")
+	}
+
+	payload, err := hexutil.Decode(c.String("payload"))
+	if err != nil {
+		return fmt.Errorf("invalid payload, expected 0x-prefixed hex: %w", err)
+	}
+
+	if c.Duration("timeout") < 0 {
+		return fmt.Errorf("--timeout must not be negative")
+	}
+
+	req := &aggregatorV1.SubmitTaskRequest{
+		AvsAddress:           avsAddress,
+		OperatorSetId:        uint32(c.Uint("operator-set-id")),
+		Payload:              payload,
+		ChainId:              uint32(c.Uint("chain-id")),
+		ReferenceBlockNumber: c.Uint64("reference-block"),
+		IdempotencyKey:       c.String("idempotency-key"),
+	}
+	if timeout := c.Duration("timeout"); timeout > 0 {
+		req.Deadline = time.Now().Add(timeout).Unix()
+	}
+
+	aggregatorClient, err := client.NewAggregatorClient(currentCtx.AggregatorEndpoint, log)
+	if err != nil {
+		return fmt.Errorf("failed to create aggregator client: %w", err)
+	}
+	defer aggregatorClient.Close()
+
+	resp, err := aggregatorClient.SubmitTask(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Submitted task",
+		zap.String("taskId", resp.TaskId),
+		zap.Uint32("chainId", resp.ChainId),
+		zap.Uint64("referenceBlockNumber", resp.ReferenceBlockNumber))
+
+	outputFormat := c.String("output")
+
+	switch outputFormat {
+	case "json":
+		formatter := output.NewFormatter(outputFormat)
+		return formatter.PrintJSON(resp)
+	case "yaml":
+		formatter := output.NewFormatter(outputFormat)
+		return formatter.PrintYAML(resp)
+	default:
+		table := tablewriter.NewWriter(c.App.Writer)
+		table.SetHeader([]string{"TASK ID", "CHAIN", "REFERENCE BLOCK", "DEADLINE"})
+		table.Append([]string{
+			resp.TaskId,
+			fmt.Sprintf("%d", resp.ChainId),
+			fmt.Sprintf("%d", resp.ReferenceBlockNumber),
+			time.Unix(resp.Deadline, 0).Format(time.RFC3339),
+		})
+		table.Render()
+	}
+
+	return nil
+}
@@ -11,10 +11,12 @@ import (
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage/badger"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage/memory"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/auth"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contractStore/inMemoryContractStore"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contracts"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/eigenlayer"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/logger"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/peering/peeringDataFetcher"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/shutdown"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/signer/signerUtils"
@@ -60,6 +62,16 @@ var runCmd = &cobra.Command{
 		}
 		imContractStore := inMemoryContractStore.NewInMemoryContractStore(coreContracts, l)
 
+		// Chains declared in config are used next to, or override, the built-in ones
+		for _, chain := range Config.Chains {
+			config.RegisterChain(chain.ChainId, &chain.ChainDefinition)
+			if chain.CoreContracts != nil {
+				if err := imContractStore.SetChainContracts(chain.ChainId, chain.CoreContracts); err != nil {
+					return fmt.Errorf("failed to set contracts for chain %d: %w", chain.ChainId, err)
+				}
+			}
+		}
+
 		// Allow overriding contracts from the runtime config
 		if Config.OverrideContracts != nil {
 			if Config.OverrideContracts.TaskMailbox != nil && len(Config.OverrideContracts.TaskMailbox.Contract) > 0 {
@@ -92,6 +104,7 @@ var runCmd = &cobra.Command{
 			&aggregatorConfig.Chain{
 				ChainId: l1Chain.ChainId,
 				RpcURL:  l1Chain.RpcURL,
+				RpcURLs: l1Chain.RpcURLs,
 			},
 			Config.Operator.OperatorPrivateKey,
 			l,
@@ -140,6 +153,7 @@ var runCmd = &cobra.Command{
 				L1ChainId:        Config.L1ChainId,
 				Authentication:   authConfig,
 				TLSEnabled:       Config.TLSEnabled,
+				TaskScheduling:   Config.TaskScheduling,
 			},
 			imContractStore,
 			tlp,
@@ -158,6 +172,18 @@ var runCmd = &cobra.Command{
 
 		ctx, cancel := context.WithCancel(cmd.Context())
 
+		if Config.Metrics != nil && Config.Metrics.Enabled {
+			metricsServer, err := metrics.NewServer(Config.Metrics, l)
+			if err != nil {
+				cancel()
+				return fmt.Errorf("failed to create metrics server: %w", err)
+			}
+			if err := metricsServer.Start(ctx); err != nil {
+				cancel()
+				return fmt.Errorf("failed to start metrics server: %w", err)
+			}
+		}
+
 		go func() {
 			if err := agg.Start(ctx); err != nil {
 				cancel()
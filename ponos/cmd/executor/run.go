@@ -19,6 +19,7 @@ import (
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/executor/storage/badger"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/executor/storage/memory"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/logger"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/shutdown"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/transactionSigner"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/util"
@@ -73,6 +74,14 @@ var runCmd = &cobra.Command{
 
 		imContractStore := inMemoryContractStore.NewInMemoryContractStore(coreContracts, l)
 
+		// The L1 chain may be declared in config next to, or override, the built-in ones
+		config.RegisterChain(Config.L1Chain.ChainId, &Config.L1Chain.ChainDefinition)
+		if Config.L1Chain.CoreContracts != nil {
+			if err := imContractStore.SetChainContracts(Config.L1Chain.ChainId, Config.L1Chain.CoreContracts); err != nil {
+				return fmt.Errorf("failed to set contracts for chain %d: %w", Config.L1Chain.ChainId, err)
+			}
+		}
+
 		// Allow overriding contracts from the runtime config
 		if Config.OverrideContracts != nil {
 			if Config.OverrideContracts.TaskMailbox != nil && len(Config.OverrideContracts.TaskMailbox.Contract) > 0 {
@@ -86,8 +95,10 @@ var runCmd = &cobra.Command{
 			}
 		}
 
-		ethereumClient := ethereum.NewEthereumClient(&ethereum.EthereumClientConfig{
-			BaseUrl: Config.L1Chain.RpcUrl,
+		ethereumClient := ethereum.NewMultiEndpointClient(&ethereum.MultiEndpointClientConfig{
+			ChainId:   Config.L1Chain.ChainId,
+			Urls:      Config.L1Chain.RpcEndpoints(),
+			BlockType: ethereum.BlockType_Latest,
 		}, l)
 
 		ethClient, err := ethereumClient.GetEthereumContractCaller()
@@ -107,7 +118,7 @@ var runCmd = &cobra.Command{
 			return fmt.Errorf("failed to create private key signer: %w", err)
 		}
 
-		cc, err := caller.NewContractCallerFromEthereumClient(ethereumClient, privateKeySigner, l)
+		cc, err := caller.NewContractCaller(ethClient, privateKeySigner, l)
 		if err != nil {
 			return fmt.Errorf("failed to initialize contract caller: %w", err)
 		}
@@ -142,6 +153,18 @@ var runCmd = &cobra.Command{
 
 		ctx, cancel := context.WithCancel(context.Background())
 
+		if Config.Metrics != nil && Config.Metrics.Enabled {
+			metricsServer, err := metrics.NewServer(Config.Metrics, l)
+			if err != nil {
+				cancel()
+				return fmt.Errorf("failed to create metrics server: %w", err)
+			}
+			if err := metricsServer.Start(ctx); err != nil {
+				cancel()
+				return fmt.Errorf("failed to start metrics server: %w", err)
+			}
+		}
+
 		if err := exec.Initialize(ctx); err != nil {
 			l.Sugar().Fatalw("Failed to initialize executor", zap.Error(err))
 		}
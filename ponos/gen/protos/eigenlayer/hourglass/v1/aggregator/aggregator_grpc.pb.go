@@ -19,9 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AggregatorManagementService_RegisterAvs_FullMethodName       = "/eigenlayer.hourglass.v1.AggregatorManagementService/RegisterAvs"
-	AggregatorManagementService_DeRegisterAvs_FullMethodName     = "/eigenlayer.hourglass.v1.AggregatorManagementService/DeRegisterAvs"
-	AggregatorManagementService_GetChallengeToken_FullMethodName = "/eigenlayer.hourglass.v1.AggregatorManagementService/GetChallengeToken"
+	AggregatorManagementService_RegisterAvs_FullMethodName           = "/eigenlayer.hourglass.v1.AggregatorManagementService/RegisterAvs"
+	AggregatorManagementService_DeRegisterAvs_FullMethodName         = "/eigenlayer.hourglass.v1.AggregatorManagementService/DeRegisterAvs"
+	AggregatorManagementService_GetChallengeToken_FullMethodName     = "/eigenlayer.hourglass.v1.AggregatorManagementService/GetChallengeToken"
+	AggregatorManagementService_GetTask_FullMethodName               = "/eigenlayer.hourglass.v1.AggregatorManagementService/GetTask"
+	AggregatorManagementService_ListTasks_FullMethodName             = "/eigenlayer.hourglass.v1.AggregatorManagementService/ListTasks"
+	AggregatorManagementService_GetOperatorScoreboard_FullMethodName = "/eigenlayer.hourglass.v1.AggregatorManagementService/GetOperatorScoreboard"
+	AggregatorManagementService_GetRecoveryStatus_FullMethodName     = "/eigenlayer.hourglass.v1.AggregatorManagementService/GetRecoveryStatus"
+	AggregatorManagementService_ReplayTasks_FullMethodName           = "/eigenlayer.hourglass.v1.AggregatorManagementService/ReplayTasks"
+	AggregatorManagementService_SubmitTask_FullMethodName            = "/eigenlayer.hourglass.v1.AggregatorManagementService/SubmitTask"
+	AggregatorManagementService_GetCheckpoints_FullMethodName        = "/eigenlayer.hourglass.v1.AggregatorManagementService/GetCheckpoints"
+	AggregatorManagementService_RewindCheckpoint_FullMethodName      = "/eigenlayer.hourglass.v1.AggregatorManagementService/RewindCheckpoint"
+	AggregatorManagementService_FastForwardCheckpoint_FullMethodName = "/eigenlayer.hourglass.v1.AggregatorManagementService/FastForwardCheckpoint"
 )
 
 // AggregatorManagementServiceClient is the client API for AggregatorManagementService service.
@@ -32,6 +41,25 @@ type AggregatorManagementServiceClient interface {
 	DeRegisterAvs(ctx context.Context, in *DeRegisterAvsRequest, opts ...grpc.CallOption) (*DeRegisterAvsResponse, error)
 	// GetChallengeToken returns a challenge token for authentication purposes
 	GetChallengeToken(ctx context.Context, in *AggregatorGetChallengeTokenRequest, opts ...grpc.CallOption) (*AggregatorGetChallengeTokenResponse, error)
+	// GetTask returns a single task along with its outcome
+	GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*GetTaskResponse, error)
+	// ListTasks returns a page of tasks matching the request filters
+	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	// GetOperatorScoreboard returns liveness and performance scores for operators tasks were broadcast to
+	GetOperatorScoreboard(ctx context.Context, in *GetOperatorScoreboardRequest, opts ...grpc.CallOption) (*GetOperatorScoreboardResponse, error)
+	// GetRecoveryStatus returns the progress of pending task recovery after a restart
+	GetRecoveryStatus(ctx context.Context, in *GetRecoveryStatusRequest, opts ...grpc.CallOption) (*GetRecoveryStatusResponse, error)
+	// ReplayTasks re-ingests the TaskCreated events of a block range, skipping tasks that already completed
+	ReplayTasks(ctx context.Context, in *ReplayTasksRequest, opts ...grpc.CallOption) (*ReplayTasksResponse, error)
+	// SubmitTask queues an off-chain task for an AVS that accepts submitted tasks. Its
+	// certificate is kept by the aggregator and returned by GetTask instead of being submitted on chain
+	SubmitTask(ctx context.Context, in *SubmitTaskRequest, opts ...grpc.CallOption) (*SubmitTaskResponse, error)
+	// GetCheckpoints returns the last block each chain poller processed
+	GetCheckpoints(ctx context.Context, in *GetCheckpointsRequest, opts ...grpc.CallOption) (*GetCheckpointsResponse, error)
+	// RewindCheckpoint moves a chain poller back so that it processes the blocks after the given block again
+	RewindCheckpoint(ctx context.Context, in *RewindCheckpointRequest, opts ...grpc.CallOption) (*MoveCheckpointResponse, error)
+	// FastForwardCheckpoint moves a chain poller to the chain head, skipping the blocks in between
+	FastForwardCheckpoint(ctx context.Context, in *FastForwardCheckpointRequest, opts ...grpc.CallOption) (*MoveCheckpointResponse, error)
 }
 
 type aggregatorManagementServiceClient struct {
@@ -72,6 +100,96 @@ func (c *aggregatorManagementServiceClient) GetChallengeToken(ctx context.Contex
 	return out, nil
 }
 
+func (c *aggregatorManagementServiceClient) GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*GetTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTaskResponse)
+	err := c.cc.Invoke(ctx, AggregatorManagementService_GetTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aggregatorManagementServiceClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTasksResponse)
+	err := c.cc.Invoke(ctx, AggregatorManagementService_ListTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aggregatorManagementServiceClient) GetOperatorScoreboard(ctx context.Context, in *GetOperatorScoreboardRequest, opts ...grpc.CallOption) (*GetOperatorScoreboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOperatorScoreboardResponse)
+	err := c.cc.Invoke(ctx, AggregatorManagementService_GetOperatorScoreboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aggregatorManagementServiceClient) GetRecoveryStatus(ctx context.Context, in *GetRecoveryStatusRequest, opts ...grpc.CallOption) (*GetRecoveryStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecoveryStatusResponse)
+	err := c.cc.Invoke(ctx, AggregatorManagementService_GetRecoveryStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aggregatorManagementServiceClient) ReplayTasks(ctx context.Context, in *ReplayTasksRequest, opts ...grpc.CallOption) (*ReplayTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayTasksResponse)
+	err := c.cc.Invoke(ctx, AggregatorManagementService_ReplayTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aggregatorManagementServiceClient) SubmitTask(ctx context.Context, in *SubmitTaskRequest, opts ...grpc.CallOption) (*SubmitTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitTaskResponse)
+	err := c.cc.Invoke(ctx, AggregatorManagementService_SubmitTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aggregatorManagementServiceClient) GetCheckpoints(ctx context.Context, in *GetCheckpointsRequest, opts ...grpc.CallOption) (*GetCheckpointsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCheckpointsResponse)
+	err := c.cc.Invoke(ctx, AggregatorManagementService_GetCheckpoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aggregatorManagementServiceClient) RewindCheckpoint(ctx context.Context, in *RewindCheckpointRequest, opts ...grpc.CallOption) (*MoveCheckpointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveCheckpointResponse)
+	err := c.cc.Invoke(ctx, AggregatorManagementService_RewindCheckpoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aggregatorManagementServiceClient) FastForwardCheckpoint(ctx context.Context, in *FastForwardCheckpointRequest, opts ...grpc.CallOption) (*MoveCheckpointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveCheckpointResponse)
+	err := c.cc.Invoke(ctx, AggregatorManagementService_FastForwardCheckpoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AggregatorManagementServiceServer is the server API for AggregatorManagementService service.
 // All implementations should embed UnimplementedAggregatorManagementServiceServer
 // for forward compatibility.
@@ -80,6 +198,25 @@ type AggregatorManagementServiceServer interface {
 	DeRegisterAvs(context.Context, *DeRegisterAvsRequest) (*DeRegisterAvsResponse, error)
 	// GetChallengeToken returns a challenge token for authentication purposes
 	GetChallengeToken(context.Context, *AggregatorGetChallengeTokenRequest) (*AggregatorGetChallengeTokenResponse, error)
+	// GetTask returns a single task along with its outcome
+	GetTask(context.Context, *GetTaskRequest) (*GetTaskResponse, error)
+	// ListTasks returns a page of tasks matching the request filters
+	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	// GetOperatorScoreboard returns liveness and performance scores for operators tasks were broadcast to
+	GetOperatorScoreboard(context.Context, *GetOperatorScoreboardRequest) (*GetOperatorScoreboardResponse, error)
+	// GetRecoveryStatus returns the progress of pending task recovery after a restart
+	GetRecoveryStatus(context.Context, *GetRecoveryStatusRequest) (*GetRecoveryStatusResponse, error)
+	// ReplayTasks re-ingests the TaskCreated events of a block range, skipping tasks that already completed
+	ReplayTasks(context.Context, *ReplayTasksRequest) (*ReplayTasksResponse, error)
+	// SubmitTask queues an off-chain task for an AVS that accepts submitted tasks. Its
+	// certificate is kept by the aggregator and returned by GetTask instead of being submitted on chain
+	SubmitTask(context.Context, *SubmitTaskRequest) (*SubmitTaskResponse, error)
+	// GetCheckpoints returns the last block each chain poller processed
+	GetCheckpoints(context.Context, *GetCheckpointsRequest) (*GetCheckpointsResponse, error)
+	// RewindCheckpoint moves a chain poller back so that it processes the blocks after the given block again
+	RewindCheckpoint(context.Context, *RewindCheckpointRequest) (*MoveCheckpointResponse, error)
+	// FastForwardCheckpoint moves a chain poller to the chain head, skipping the blocks in between
+	FastForwardCheckpoint(context.Context, *FastForwardCheckpointRequest) (*MoveCheckpointResponse, error)
 }
 
 // UnimplementedAggregatorManagementServiceServer should be embedded to have
@@ -98,6 +235,33 @@ func (UnimplementedAggregatorManagementServiceServer) DeRegisterAvs(context.Cont
 func (UnimplementedAggregatorManagementServiceServer) GetChallengeToken(context.Context, *AggregatorGetChallengeTokenRequest) (*AggregatorGetChallengeTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChallengeToken not implemented")
 }
+func (UnimplementedAggregatorManagementServiceServer) GetTask(context.Context, *GetTaskRequest) (*GetTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTask not implemented")
+}
+func (UnimplementedAggregatorManagementServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedAggregatorManagementServiceServer) GetOperatorScoreboard(context.Context, *GetOperatorScoreboardRequest) (*GetOperatorScoreboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperatorScoreboard not implemented")
+}
+func (UnimplementedAggregatorManagementServiceServer) GetRecoveryStatus(context.Context, *GetRecoveryStatusRequest) (*GetRecoveryStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecoveryStatus not implemented")
+}
+func (UnimplementedAggregatorManagementServiceServer) ReplayTasks(context.Context, *ReplayTasksRequest) (*ReplayTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayTasks not implemented")
+}
+func (UnimplementedAggregatorManagementServiceServer) SubmitTask(context.Context, *SubmitTaskRequest) (*SubmitTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTask not implemented")
+}
+func (UnimplementedAggregatorManagementServiceServer) GetCheckpoints(context.Context, *GetCheckpointsRequest) (*GetCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCheckpoints not implemented")
+}
+func (UnimplementedAggregatorManagementServiceServer) RewindCheckpoint(context.Context, *RewindCheckpointRequest) (*MoveCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewindCheckpoint not implemented")
+}
+func (UnimplementedAggregatorManagementServiceServer) FastForwardCheckpoint(context.Context, *FastForwardCheckpointRequest) (*MoveCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FastForwardCheckpoint not implemented")
+}
 func (UnimplementedAggregatorManagementServiceServer) testEmbeddedByValue() {}
 
 // UnsafeAggregatorManagementServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -172,6 +336,168 @@ func _AggregatorManagementService_GetChallengeToken_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AggregatorManagementService_GetTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AggregatorManagementServiceServer).GetTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AggregatorManagementService_GetTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregatorManagementServiceServer).GetTask(ctx, req.(*GetTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AggregatorManagementService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AggregatorManagementServiceServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AggregatorManagementService_ListTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregatorManagementServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AggregatorManagementService_GetOperatorScoreboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperatorScoreboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AggregatorManagementServiceServer).GetOperatorScoreboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AggregatorManagementService_GetOperatorScoreboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregatorManagementServiceServer).GetOperatorScoreboard(ctx, req.(*GetOperatorScoreboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AggregatorManagementService_GetRecoveryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecoveryStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AggregatorManagementServiceServer).GetRecoveryStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AggregatorManagementService_GetRecoveryStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregatorManagementServiceServer).GetRecoveryStatus(ctx, req.(*GetRecoveryStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AggregatorManagementService_ReplayTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AggregatorManagementServiceServer).ReplayTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AggregatorManagementService_ReplayTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregatorManagementServiceServer).ReplayTasks(ctx, req.(*ReplayTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AggregatorManagementService_SubmitTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AggregatorManagementServiceServer).SubmitTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AggregatorManagementService_SubmitTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregatorManagementServiceServer).SubmitTask(ctx, req.(*SubmitTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AggregatorManagementService_GetCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AggregatorManagementServiceServer).GetCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AggregatorManagementService_GetCheckpoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregatorManagementServiceServer).GetCheckpoints(ctx, req.(*GetCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AggregatorManagementService_RewindCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewindCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AggregatorManagementServiceServer).RewindCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AggregatorManagementService_RewindCheckpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregatorManagementServiceServer).RewindCheckpoint(ctx, req.(*RewindCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AggregatorManagementService_FastForwardCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FastForwardCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AggregatorManagementServiceServer).FastForwardCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AggregatorManagementService_FastForwardCheckpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregatorManagementServiceServer).FastForwardCheckpoint(ctx, req.(*FastForwardCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AggregatorManagementService_ServiceDesc is the grpc.ServiceDesc for AggregatorManagementService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +517,42 @@ var AggregatorManagementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChallengeToken",
 			Handler:    _AggregatorManagementService_GetChallengeToken_Handler,
 		},
+		{
+			MethodName: "GetTask",
+			Handler:    _AggregatorManagementService_GetTask_Handler,
+		},
+		{
+			MethodName: "ListTasks",
+			Handler:    _AggregatorManagementService_ListTasks_Handler,
+		},
+		{
+			MethodName: "GetOperatorScoreboard",
+			Handler:    _AggregatorManagementService_GetOperatorScoreboard_Handler,
+		},
+		{
+			MethodName: "GetRecoveryStatus",
+			Handler:    _AggregatorManagementService_GetRecoveryStatus_Handler,
+		},
+		{
+			MethodName: "ReplayTasks",
+			Handler:    _AggregatorManagementService_ReplayTasks_Handler,
+		},
+		{
+			MethodName: "SubmitTask",
+			Handler:    _AggregatorManagementService_SubmitTask_Handler,
+		},
+		{
+			MethodName: "GetCheckpoints",
+			Handler:    _AggregatorManagementService_GetCheckpoints_Handler,
+		},
+		{
+			MethodName: "RewindCheckpoint",
+			Handler:    _AggregatorManagementService_RewindCheckpoint_Handler,
+		},
+		{
+			MethodName: "FastForwardCheckpoint",
+			Handler:    _AggregatorManagementService_FastForwardCheckpoint_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eigenlayer/hourglass/v1/aggregator/aggregator.proto",
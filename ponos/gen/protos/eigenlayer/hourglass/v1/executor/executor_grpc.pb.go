@@ -129,6 +129,7 @@ const (
 	ExecutorManagementService_ListPerformers_FullMethodName    = "/eigenlayer.hourglass.v1.ExecutorManagementService/ListPerformers"
 	ExecutorManagementService_RemovePerformer_FullMethodName   = "/eigenlayer.hourglass.v1.ExecutorManagementService/RemovePerformer"
 	ExecutorManagementService_GetChallengeToken_FullMethodName = "/eigenlayer.hourglass.v1.ExecutorManagementService/GetChallengeToken"
+	ExecutorManagementService_GetAdmissionStats_FullMethodName = "/eigenlayer.hourglass.v1.ExecutorManagementService/GetAdmissionStats"
 )
 
 // ExecutorManagementServiceClient is the client API for ExecutorManagementService service.
@@ -143,6 +144,8 @@ type ExecutorManagementServiceClient interface {
 	RemovePerformer(ctx context.Context, in *RemovePerformerRequest, opts ...grpc.CallOption) (*RemovePerformerResponse, error)
 	// GetChallengeToken returns a challenge token for authentication purposes
 	GetChallengeToken(ctx context.Context, in *GetChallengeTokenRequest, opts ...grpc.CallOption) (*GetChallengeTokenResponse, error)
+	// GetAdmissionStats returns the admission limits and counters of each AVS
+	GetAdmissionStats(ctx context.Context, in *GetAdmissionStatsRequest, opts ...grpc.CallOption) (*GetAdmissionStatsResponse, error)
 }
 
 type executorManagementServiceClient struct {
@@ -193,6 +196,16 @@ func (c *executorManagementServiceClient) GetChallengeToken(ctx context.Context,
 	return out, nil
 }
 
+func (c *executorManagementServiceClient) GetAdmissionStats(ctx context.Context, in *GetAdmissionStatsRequest, opts ...grpc.CallOption) (*GetAdmissionStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAdmissionStatsResponse)
+	err := c.cc.Invoke(ctx, ExecutorManagementService_GetAdmissionStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExecutorManagementServiceServer is the server API for ExecutorManagementService service.
 // All implementations should embed UnimplementedExecutorManagementServiceServer
 // for forward compatibility.
@@ -205,6 +218,8 @@ type ExecutorManagementServiceServer interface {
 	RemovePerformer(context.Context, *RemovePerformerRequest) (*RemovePerformerResponse, error)
 	// GetChallengeToken returns a challenge token for authentication purposes
 	GetChallengeToken(context.Context, *GetChallengeTokenRequest) (*GetChallengeTokenResponse, error)
+	// GetAdmissionStats returns the admission limits and counters of each AVS
+	GetAdmissionStats(context.Context, *GetAdmissionStatsRequest) (*GetAdmissionStatsResponse, error)
 }
 
 // UnimplementedExecutorManagementServiceServer should be embedded to have
@@ -226,6 +241,9 @@ func (UnimplementedExecutorManagementServiceServer) RemovePerformer(context.Cont
 func (UnimplementedExecutorManagementServiceServer) GetChallengeToken(context.Context, *GetChallengeTokenRequest) (*GetChallengeTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChallengeToken not implemented")
 }
+func (UnimplementedExecutorManagementServiceServer) GetAdmissionStats(context.Context, *GetAdmissionStatsRequest) (*GetAdmissionStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdmissionStats not implemented")
+}
 func (UnimplementedExecutorManagementServiceServer) testEmbeddedByValue() {}
 
 // UnsafeExecutorManagementServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -318,6 +336,24 @@ func _ExecutorManagementService_GetChallengeToken_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecutorManagementService_GetAdmissionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdmissionStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorManagementServiceServer).GetAdmissionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorManagementService_GetAdmissionStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorManagementServiceServer).GetAdmissionStats(ctx, req.(*GetAdmissionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExecutorManagementService_ServiceDesc is the grpc.ServiceDesc for ExecutorManagementService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -341,6 +377,10 @@ var ExecutorManagementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChallengeToken",
 			Handler:    _ExecutorManagementService_GetChallengeToken_Handler,
 		},
+		{
+			MethodName: "GetAdmissionStats",
+			Handler:    _ExecutorManagementService_GetAdmissionStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eigenlayer/hourglass/v1/executor/executor.proto",
@@ -53,6 +53,18 @@ func (mr *MockIBlockContextManagerMockRecorder) CancelBlock(blockNumber any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBlock", reflect.TypeOf((*MockIBlockContextManager)(nil).CancelBlock), blockNumber)
 }
 
+// CancelTasks mocks base method.
+func (m *MockIBlockContextManager) CancelTasks(taskIds []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CancelTasks", taskIds)
+}
+
+// CancelTasks indicates an expected call of CancelTasks.
+func (mr *MockIBlockContextManagerMockRecorder) CancelTasks(taskIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTasks", reflect.TypeOf((*MockIBlockContextManager)(nil).CancelTasks), taskIds)
+}
+
 // GetContext mocks base method.
 func (m *MockIBlockContextManager) GetContext(blockNumber uint64, task *types.Task) context.Context {
 	m.ctrl.T.Helper()
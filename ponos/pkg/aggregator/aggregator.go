@@ -3,6 +3,7 @@ package aggregator
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -14,7 +15,9 @@ import (
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/auth"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/chainPoller/EVMChainPoller"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/ethereum"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/executorClient"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contextManager"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contextManager/taskBlockContextManager"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contractCaller"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contractCaller/caller"
@@ -24,6 +27,7 @@ import (
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/peering"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/rpcServer"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/signer"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/taskSource"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/transactionLogParser"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/transactionSigner"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
@@ -46,6 +50,7 @@ type AggregatorConfig struct {
 	L1ChainId        config.ChainId
 	Authentication   *auth.Config
 	TLSEnabled       bool
+	TaskScheduling   *aggregatorConfig.TaskSchedulingConfig
 }
 
 // AvsExecutionManagerInfo encapsulates all information related to a running AVS
@@ -53,6 +58,12 @@ type AvsExecutionManagerInfo struct {
 	Address          string
 	ExecutionManager *avsExecutionManager.AvsExecutionManager
 	CancelFunc       context.CancelFunc
+	// ChainPollers are the AVS's pollers by chain, used to replay tasks
+	ChainPollers map[config.ChainId]*EVMChainPoller.EVMChainPoller
+	// TaskSources are the AVS's sources of off-chain tasks
+	TaskSources []taskSource.TaskSource
+	// SubmitSource accepts tasks from the SubmitTask RPC, nil unless enabled for the AVS
+	SubmitSource *taskSource.SubmitSource
 }
 
 type Aggregator struct {
@@ -90,6 +101,18 @@ type Aggregator struct {
 
 	// authVerifier handles authentication for management APIs
 	authVerifier *auth.Verifier
+
+	// executorClients pools connections to executors across all AVSs
+	executorClients *executorClient.ExecutorClientPool
+
+	// taskScheduler limits how many tasks are processed at once across all AVSs
+	taskScheduler *avsExecutionManager.TaskScheduler
+
+	// blockFeedsMutex protects concurrent access to blockFeeds
+	blockFeedsMutex sync.Mutex
+
+	// blockFeeds map of chainId to the block ingestion shared by every AVS on the chain
+	blockFeeds map[config.ChainId]*EVMChainPoller.BlockFeed
 }
 
 func NewAggregatorWithManagementRpcServer(
@@ -131,6 +154,11 @@ func NewAggregator(
 
 	authVerifier := getAuthVerifier(cfg, signers, logger)
 
+	maxConcurrentTasks := 0
+	if cfg.TaskScheduling != nil {
+		maxConcurrentTasks = cfg.TaskScheduling.MaxConcurrentTasks
+	}
+
 	return &Aggregator{
 		contractStore:        contractStore,
 		transactionLogParser: lp,
@@ -141,8 +169,13 @@ func NewAggregator(
 		store:                store,
 		chainContractCallers: make(map[config.ChainId]contractCaller.IContractCaller),
 		avsManagers:          make(map[string]*AvsExecutionManagerInfo),
+		blockFeeds:           make(map[config.ChainId]*EVMChainPoller.BlockFeed),
 		managementRpcServer:  managementRpcServer,
 		authVerifier:         authVerifier,
+		executorClients: executorClient.NewExecutorClientPool(&executorClient.ExecutorClientPoolConfig{
+			TLSEnabled: cfg.TLSEnabled,
+		}, logger),
+		taskScheduler: avsExecutionManager.NewTaskScheduler(maxConcurrentTasks),
 	}, nil
 }
 
@@ -192,14 +225,29 @@ func (a *Aggregator) registerAvs(avs *aggregatorConfig.AggregatorAvs) (err error
 		return fmt.Errorf("failed to get valid chains for AVS %s: %w", avs.Address, err)
 	}
 
+	taskProcessing := avs.TaskProcessing
+	if taskProcessing == nil {
+		taskProcessing = &aggregatorConfig.TaskProcessingConfig{}
+	}
+
 	om := operatorManager.NewOperatorManager(&operatorManager.OperatorManagerConfig{
 		AvsAddress: avs.Address,
 		ChainIds:   supportedChains,
 		L1ChainId:  a.config.L1ChainId,
+		CacheSize:  taskProcessing.OperatorCacheSize,
 	}, a.chainContractCallers, a.peeringDataFetcher, a.logger)
+	queueDepth := taskProcessing.QueueDepth
+	if queueDepth <= 0 {
+		queueDepth = avsExecutionManager.DefaultTaskQueueDepth
+	}
 
-	taskQueue := make(chan *types.Task)
-	chainPollers := a.getChainPollers(supportedChains, avs.Address, taskQueue)
+	taskQueue := make(chan *types.Task, queueDepth)
+	blockContextManagers := make(map[config.ChainId]contextManager.IBlockContextManager)
+	chainPollers := a.getChainPollers(supportedChains, avs, taskQueue, blockContextManagers, om)
+	taskSources, submitSource, err := a.getTaskSources(supportedChains, avs, taskQueue)
+	if err != nil {
+		return fmt.Errorf("failed to create task sources for AVS %s: %w", avs.Address, err)
+	}
 
 	avsCtx, avsCancel := context.WithCancel(a.rootCtx)
 	defer func() {
@@ -220,6 +268,12 @@ func (a *Aggregator) registerAvs(avs *aggregatorConfig.AggregatorAvs) (err error
 		L1ChainId:                a.config.L1ChainId,
 		AggregatorAddress:        a.config.Address,
 		TlsEnabled:               a.config.TLSEnabled,
+
+		MaxConcurrentTasks:               taskProcessing.MaxConcurrentTasks,
+		MaxConcurrentTasksPerOperatorSet: taskProcessing.MaxConcurrentTasksPerOperatorSet,
+		TaskQueueDepth:                   queueDepth,
+		SchedulingWeight:                 taskProcessing.Weight,
+		MinTimeToDeadline:                time.Duration(taskProcessing.MinTimeToDeadlineSeconds) * time.Second,
 	}
 
 	aem, err := avsExecutionManager.NewAvsExecutionManager(
@@ -228,7 +282,10 @@ func (a *Aggregator) registerAvs(avs *aggregatorConfig.AggregatorAvs) (err error
 		a.signers,
 		a.contractStore,
 		om,
+		a.executorClients,
+		a.taskScheduler,
 		taskQueue,
+		blockContextManagers,
 		a.store,
 		a.logger,
 	)
@@ -242,11 +299,17 @@ func (a *Aggregator) registerAvs(avs *aggregatorConfig.AggregatorAvs) (err error
 		return fmt.Errorf("aggregator not started, cannot register AVS %s", avs.Address)
 	}
 
-	err = a.storeAvsManager(avs.Address, aem, avsCancel)
+	err = a.storeAvsManager(avs.Address, aem, chainPollers, taskSources, submitSource, avsCancel)
 	if err != nil {
 		return err
 	}
 
+	err = a.startTaskSources(avsCtx, avs.Address, taskSources)
+	if err != nil {
+		a.removeAvsManager(avs.Address)
+		return err
+	}
+
 	err = aem.Start(avsCtx)
 	if err != nil {
 		a.removeAvsManager(avs.Address)
@@ -330,8 +393,9 @@ func InitializeContractCaller(
 	privateKeyConfig *config.ECDSAKeyConfig,
 	logger *zap.Logger,
 ) (contractCaller.IContractCaller, error) {
-	ec := ethereum.NewEthereumClient(&ethereum.EthereumClientConfig{
-		BaseUrl:   chain.RpcURL,
+	ec := ethereum.NewMultiEndpointClient(&ethereum.MultiEndpointClientConfig{
+		ChainId:   chain.ChainId,
+		Urls:      chain.RpcEndpoints(),
 		BlockType: ethereum.BlockType_Latest,
 	}, logger)
 
@@ -373,6 +437,7 @@ func (a *Aggregator) initializeContractCallers() (map[config.ChainId]contractCal
 func (a *Aggregator) Start(ctx context.Context) error {
 
 	a.rootCtx = ctx
+	a.executorClients.Start(ctx)
 
 	for _, avs := range a.config.AVSs {
 
@@ -469,8 +534,16 @@ func getAuthVerifier(cfg *AggregatorConfig, signers signer.Signers, logger *zap.
 	return authVerifier
 }
 
-// getChainPollers creates and returns a map of chain pollers for the given AVS
-func (a *Aggregator) getChainPollers(supportedChains []config.ChainId, avsAddress string, taskQueue chan *types.Task) map[config.ChainId]*EVMChainPoller.EVMChainPoller {
+// getChainPollers creates and returns a map of chain pollers for the given AVS, adding the
+// block context manager each poller cancels reorged tasks through to blockContextManagers
+func (a *Aggregator) getChainPollers(
+	supportedChains []config.ChainId,
+	avs *aggregatorConfig.AggregatorAvs,
+	taskQueue chan *types.Task,
+	blockContextManagers map[config.ChainId]contextManager.IBlockContextManager,
+	om *operatorManager.OperatorManager,
+) map[config.ChainId]*EVMChainPoller.EVMChainPoller {
+	avsAddress := avs.Address
 	chainPollers := make(map[config.ChainId]*EVMChainPoller.EVMChainPoller)
 
 	for _, chainId := range supportedChains {
@@ -480,32 +553,44 @@ func (a *Aggregator) getChainPollers(supportedChains []config.ChainId, avsAddres
 			continue
 		}
 
-		ec := ethereum.NewEthereumClient(&ethereum.EthereumClientConfig{
-			BaseUrl:   chain.RpcURL,
-			BlockType: ethereum.BlockType_Latest,
-		}, a.logger)
-
-		pollInterval := chain.PollIntervalSeconds
-		if pollInterval <= 0 {
-			a.logger.Sugar().Warnw("Invalid poll interval for chain", "chainId", chainId, "pollInterval", pollInterval)
-			pollInterval = defaultPollIntervalSeconds
-		}
-
 		pollerConfig := &EVMChainPoller.EVMChainPollerConfig{
 			ChainId:              chainId,
 			AvsAddress:           avsAddress,
-			PollingInterval:      time.Duration(pollInterval) * time.Second,
+			PollingInterval:      pollIntervalForChain(chain),
 			InterestingContracts: a.contractStore.ListContractAddressesForChain(chainId),
+			LogRequestTimeout:    time.Duration(chain.LogRequestTimeoutSeconds) * time.Second,
+			OnOperatorSetChanged: func(operatorSetId uint32) {
+				// the change reaches every chain the operator set's table is transported to
+				for _, tableChainId := range supportedChains {
+					om.InvalidateOperatorSet(tableChainId, operatorSetId)
+				}
+			},
+		}
+		if chain.CatchUp != nil {
+			pollerConfig.CatchUpWindowSize = chain.CatchUp.WindowSize
+			pollerConfig.MaxCatchUpDepth = chain.CatchUp.MaxDepth
+			pollerConfig.SkipExpiredTasks = chain.CatchUp.ExpiredTaskPolicy == aggregatorConfig.ExpiredTaskPolicySkip
 		}
+		finality := chain.Finality
+		if avs.Finality != nil {
+			finality = avs.Finality
+		}
+		if finality != nil {
+			pollerConfig.Finality = EVMChainPoller.FinalityPolicy(finality.Policy)
+			pollerConfig.Confirmations = finality.Confirmations
+		}
+
+		blockContextManager := taskBlockContextManager.NewTaskBlockContextManager(a.rootCtx, a.store, a.logger)
+		blockContextManagers[chainId] = blockContextManager
 
 		poller := EVMChainPoller.NewEVMChainPoller(
-			ec,
+			a.getBlockFeed(chain),
 			taskQueue,
 			a.transactionLogParser,
 			pollerConfig,
 			a.contractStore,
 			a.store,
-			taskBlockContextManager.NewTaskBlockContextManager(a.rootCtx, a.store, a.logger),
+			blockContextManager,
 			a.logger,
 		)
 		chainPollers[chainId] = poller
@@ -518,8 +603,56 @@ func (a *Aggregator) getChainPollers(supportedChains []config.ChainId, avsAddres
 	return chainPollers
 }
 
+// getBlockFeed returns the block ingestion shared by every AVS on the chain,
+// starting it the first time an AVS on the chain is registered
+func (a *Aggregator) getBlockFeed(chain *aggregatorConfig.Chain) *EVMChainPoller.BlockFeed {
+	a.blockFeedsMutex.Lock()
+	defer a.blockFeedsMutex.Unlock()
+
+	if feed, ok := a.blockFeeds[chain.ChainId]; ok {
+		return feed
+	}
+
+	ec := ethereum.NewMultiEndpointClient(&ethereum.MultiEndpointClientConfig{
+		ChainId:   chain.ChainId,
+		Urls:      chain.RpcEndpoints(),
+		BlockType: ethereum.BlockType_Latest,
+		Quorum:    chain.RpcQuorum,
+	}, a.logger)
+
+	if chain.PollIntervalSeconds <= 0 {
+		a.logger.Sugar().Warnw("Invalid poll interval for chain", "chainId", chain.ChainId, "pollInterval", chain.PollIntervalSeconds)
+	}
+	feed := EVMChainPoller.NewBlockFeed(ec, &EVMChainPoller.BlockFeedConfig{
+		ChainId:              chain.ChainId,
+		PollingInterval:      pollIntervalForChain(chain),
+		InterestingContracts: a.contractStore.ListContractAddressesForChain(chain.ChainId),
+		WebsocketURL:         chain.WsURL,
+		LogRequestTimeout:    time.Duration(chain.LogRequestTimeoutSeconds) * time.Second,
+	}, a.logger)
+	feed.Start(a.rootCtx)
+	a.blockFeeds[chain.ChainId] = feed
+
+	a.logger.Sugar().Infow("Created block feed for chain", "chainId", chain.ChainId)
+	return feed
+}
+
+func pollIntervalForChain(chain *aggregatorConfig.Chain) time.Duration {
+	if chain.PollIntervalSeconds <= 0 {
+		return defaultPollIntervalSeconds * time.Second
+	}
+	return time.Duration(chain.PollIntervalSeconds) * time.Second
+}
+
 // storeAvsManager stores the AVS manager in the map with proper mutex handling
-func (a *Aggregator) storeAvsManager(avsAddress string, aem *avsExecutionManager.AvsExecutionManager, cancelFunc context.CancelFunc) error {
+func (a *Aggregator) storeAvsManager(
+	avsAddress string,
+	aem *avsExecutionManager.AvsExecutionManager,
+	chainPollers map[config.ChainId]*EVMChainPoller.EVMChainPoller,
+	taskSources []taskSource.TaskSource,
+	submitSource *taskSource.SubmitSource,
+	cancelFunc context.CancelFunc,
+) error {
 	a.avsMutex.Lock()
 	defer a.avsMutex.Unlock()
 
@@ -531,6 +664,9 @@ func (a *Aggregator) storeAvsManager(avsAddress string, aem *avsExecutionManager
 		Address:          avsAddress,
 		ExecutionManager: aem,
 		CancelFunc:       cancelFunc,
+		ChainPollers:     chainPollers,
+		TaskSources:      taskSources,
+		SubmitSource:     submitSource,
 	}
 	a.avsManagers[avsAddress] = avsInfo
 
@@ -595,6 +731,61 @@ func (a *Aggregator) startPollers(
 	return nil
 }
 
+// getTaskSources creates the sources of off-chain tasks enabled for the AVS. Their
+// tasks go to the same task queue as the tasks of the chain pollers.
+func (a *Aggregator) getTaskSources(
+	supportedChains []config.ChainId,
+	avs *aggregatorConfig.AggregatorAvs,
+	taskQueue chan *types.Task,
+) ([]taskSource.TaskSource, *taskSource.SubmitSource, error) {
+	sourcesConfig := avs.TaskSources
+	if sourcesConfig == nil || (sourcesConfig.Submit == nil || !sourcesConfig.Submit.Enabled) && sourcesConfig.File == nil {
+		return nil, nil, nil
+	}
+
+	defaultChainId := supportedChains[0]
+	if slices.Contains(supportedChains, a.config.L1ChainId) {
+		defaultChainId = a.config.L1ChainId
+	}
+	sink := taskSource.NewTaskSink(&taskSource.TaskSinkConfig{
+		AvsAddress:     avs.Address,
+		ChainIds:       supportedChains,
+		DefaultChainId: defaultChainId,
+		DefaultTimeout: time.Duration(sourcesConfig.DefaultTimeoutSeconds) * time.Second,
+		MaxTimeout:     time.Duration(sourcesConfig.MaxTimeoutSeconds) * time.Second,
+	}, a.store, taskQueue, a.logger)
+
+	var sources []taskSource.TaskSource
+	var submitSource *taskSource.SubmitSource
+	if sourcesConfig.Submit != nil && sourcesConfig.Submit.Enabled {
+		if a.authVerifier == nil {
+			return nil, nil, fmt.Errorf("the submit task source requires authentication to be enabled")
+		}
+		submitSource = taskSource.NewSubmitSource(sink)
+		sources = append(sources, submitSource)
+	}
+	if sourcesConfig.File != nil {
+		sources = append(sources, taskSource.NewFileSource(&taskSource.FileSourceConfig{
+			Path:         sourcesConfig.File.Path,
+			PollInterval: time.Duration(sourcesConfig.File.PollIntervalSeconds) * time.Second,
+		}, sink, a.logger))
+	}
+	return sources, submitSource, nil
+}
+
+// startTaskSources starts the off-chain task sources of this AVS
+func (a *Aggregator) startTaskSources(ctx context.Context, avsAddress string, sources []taskSource.TaskSource) error {
+	for _, source := range sources {
+		if err := source.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start %s task source: %w", source.Name(), err)
+		}
+		a.logger.Sugar().Infow("Started task source",
+			zap.String("source", source.Name()),
+			zap.String("avsAddress", avsAddress))
+	}
+	return nil
+}
+
 func (a *Aggregator) registerHandlers() {
 	aggregatorV1.RegisterAggregatorManagementServiceServer(a.managementRpcServer.GetGrpcServer(), a)
 }
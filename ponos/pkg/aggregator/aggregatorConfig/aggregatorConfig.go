@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/auth"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/util"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
@@ -23,6 +24,32 @@ type Chain struct {
 	ChainId             config.ChainId `json:"chainId" yaml:"chainId"`
 	RpcURL              string         `json:"rpcUrl" yaml:"rpcUrl"`
 	PollIntervalSeconds int            `json:"pollIntervalSeconds" yaml:"pollIntervalSeconds"`
+
+	// RpcURLs are additional endpoints for the chain. Reads, contract calls and transactions
+	// go to the fastest healthy endpoint and fail over to the others when it errors
+	RpcURLs []string `json:"rpcUrls,omitempty" yaml:"rpcUrls,omitempty"`
+
+	// RpcQuorum is how many endpoints must agree on the chain head, blocks and logs.
+	// 0 or 1 reads from a single endpoint at a time
+	RpcQuorum int `json:"rpcQuorum,omitempty" yaml:"rpcQuorum,omitempty"`
+
+	// WsURL is an optional websocket endpoint; when set, new blocks are picked up from
+	// a newHeads subscription as they are produced instead of on the poll interval
+	WsURL string `json:"wsUrl,omitempty" yaml:"wsUrl,omitempty"`
+
+	// LogRequestTimeoutSeconds bounds each eth_getLogs request. Defaults to 30
+	LogRequestTimeoutSeconds int `json:"logRequestTimeoutSeconds,omitempty" yaml:"logRequestTimeoutSeconds,omitempty"`
+
+	// CatchUp controls how the chain is read when the aggregator is far behind the head
+	CatchUp *CatchUpConfig `json:"catchUp,omitempty" yaml:"catchUp,omitempty"`
+
+	// Finality is how settled a block must be before its tasks are picked up. AVSs
+	// can override it. Defaults to the latest block
+	Finality *FinalityConfig `json:"finality,omitempty" yaml:"finality,omitempty"`
+
+	// ChainDefinition declares the role and EigenLayer contract addresses of chains
+	// ponos has no built-in addresses for, or overrides the built-in ones
+	config.ChainDefinition `json:",inline" yaml:",inline"`
 }
 
 func (c *Chain) Validate() field.ErrorList {
@@ -32,23 +59,239 @@ func (c *Chain) Validate() field.ErrorList {
 	}
 	if c.ChainId == 0 {
 		allErrors = append(allErrors, field.Required(field.NewPath("chainId"), "chainId is required"))
+	} else {
+		allErrors = append(allErrors, c.ChainDefinition.Validate(c.ChainId)...)
+	}
+	endpoints := c.RpcEndpoints()
+	if len(endpoints) == 0 {
+		allErrors = append(allErrors, field.Required(field.NewPath("rpcUrl"), "rpcUrl or rpcUrls is required"))
+	}
+	for i, u := range c.RpcURLs {
+		if u == "" {
+			allErrors = append(allErrors, field.Required(field.NewPath("rpcUrls").Index(i), "rpcUrls must not contain empty values"))
+		}
+	}
+	if c.RpcQuorum < 0 || c.RpcQuorum > len(endpoints) {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("rpcQuorum"), c.RpcQuorum, "rpcQuorum must be between 0 and the number of rpc endpoints"))
 	}
-	if !slices.Contains(config.SupportedChainIds, c.ChainId) {
-		allErrors = append(allErrors, field.Invalid(field.NewPath("chainId"), c.ChainId, "unsupported chainId"))
+	if c.WsURL != "" && !strings.HasPrefix(c.WsURL, "ws://") && !strings.HasPrefix(c.WsURL, "wss://") {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("wsUrl"), c.WsURL, "wsUrl must be a ws:// or wss:// URL"))
+	}
+	if c.LogRequestTimeoutSeconds < 0 {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("logRequestTimeoutSeconds"), c.LogRequestTimeoutSeconds, "logRequestTimeoutSeconds must not be negative"))
+	}
+	if c.CatchUp != nil {
+		allErrors = append(allErrors, c.CatchUp.Validate()...)
+	}
+	if c.Finality != nil {
+		allErrors = append(allErrors, c.Finality.Validate()...)
+	}
+	return allErrors
+}
+
+const (
+	// ExpiredTaskPolicyRecord stores tasks whose deadline passed before they were ingested as failed
+	ExpiredTaskPolicyRecord = "record"
+	// ExpiredTaskPolicySkip drops tasks whose deadline passed before they were ingested
+	ExpiredTaskPolicySkip = "skip"
+)
+
+// CatchUpConfig controls how a chain poller catches up after downtime
+type CatchUpConfig struct {
+	// WindowSize is the number of blocks covered by each ranged eth_getLogs request. Defaults to 500
+	WindowSize uint64 `json:"windowSize,omitempty" yaml:"windowSize,omitempty"`
+	// MaxDepth is the furthest behind the head, in blocks, the poller catches up
+	// from; older blocks are skipped. 0 means no limit
+	MaxDepth uint64 `json:"maxDepth,omitempty" yaml:"maxDepth,omitempty"`
+	// ExpiredTaskPolicy is what happens to tasks whose deadline passed before they
+	// were ingested: "record" (the default) stores them as failed, "skip" drops them
+	ExpiredTaskPolicy string `json:"expiredTaskPolicy,omitempty" yaml:"expiredTaskPolicy,omitempty"`
+}
+
+func (cuc *CatchUpConfig) Validate() field.ErrorList {
+	var allErrors field.ErrorList
+	if cuc.ExpiredTaskPolicy != "" && cuc.ExpiredTaskPolicy != ExpiredTaskPolicyRecord && cuc.ExpiredTaskPolicy != ExpiredTaskPolicySkip {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("catchUp", "expiredTaskPolicy"), cuc.ExpiredTaskPolicy, "expiredTaskPolicy must be 'record' or 'skip'"))
 	}
-	if c.RpcURL == "" {
-		allErrors = append(allErrors, field.Required(field.NewPath("rpcUrl"), "rpcUrl is required"))
+	return allErrors
+}
+
+const (
+	// FinalityPolicyLatest picks up tasks from the chain head
+	FinalityPolicyLatest = "latest"
+	// FinalityPolicyConfirmations picks up tasks once a number of blocks have been built on their block
+	FinalityPolicyConfirmations = "confirmations"
+	// FinalityPolicySafe picks up tasks once their block is safe
+	FinalityPolicySafe = "safe"
+	// FinalityPolicyFinalized picks up tasks once their block is finalized
+	FinalityPolicyFinalized = "finalized"
+)
+
+// FinalityConfig trades task latency against the risk of a task being reorged away
+type FinalityConfig struct {
+	// Policy is one of "latest" (the default), "confirmations", "safe" or "finalized"
+	Policy string `json:"policy,omitempty" yaml:"policy,omitempty"`
+	// Confirmations is the number of blocks to wait for with the "confirmations" policy
+	Confirmations uint64 `json:"confirmations,omitempty" yaml:"confirmations,omitempty"`
+}
+
+func (fc *FinalityConfig) Validate() field.ErrorList {
+	var allErrors field.ErrorList
+	switch fc.Policy {
+	case "", FinalityPolicyLatest, FinalityPolicySafe, FinalityPolicyFinalized:
+		if fc.Confirmations != 0 {
+			allErrors = append(allErrors, field.Invalid(field.NewPath("finality", "confirmations"), fc.Confirmations, "confirmations is only used with the 'confirmations' policy"))
+		}
+	case FinalityPolicyConfirmations:
+		if fc.Confirmations == 0 {
+			allErrors = append(allErrors, field.Required(field.NewPath("finality", "confirmations"), "confirmations is required with the 'confirmations' policy"))
+		}
+	default:
+		allErrors = append(allErrors, field.Invalid(field.NewPath("finality", "policy"), fc.Policy, "policy must be 'latest', 'confirmations', 'safe' or 'finalized'"))
 	}
 	return allErrors
 }
 
 func (c *Chain) IsAnvilRpc() bool {
-	return strings.Contains(c.RpcURL, "127.0.0.1:8545")
+	for _, u := range c.RpcEndpoints() {
+		if strings.Contains(u, "127.0.0.1:8545") {
+			return true
+		}
+	}
+	return false
+}
+
+// RpcEndpoints returns every configured RPC endpoint, rpcUrl first
+func (c *Chain) RpcEndpoints() []string {
+	endpoints := make([]string, 0, len(c.RpcURLs)+1)
+	for _, u := range append([]string{c.RpcURL}, c.RpcURLs...) {
+		if u != "" && !slices.Contains(endpoints, u) {
+			endpoints = append(endpoints, u)
+		}
+	}
+	return endpoints
+}
+
+// TaskProcessingConfig controls how many tasks an AVS processes concurrently
+type TaskProcessingConfig struct {
+	// MaxConcurrentTasks is the number of tasks processed in parallel for the AVS
+	MaxConcurrentTasks int `json:"maxConcurrentTasks,omitempty" yaml:"maxConcurrentTasks,omitempty"`
+	// MaxConcurrentTasksPerOperatorSet caps the in-flight tasks for a single operator set
+	MaxConcurrentTasksPerOperatorSet int `json:"maxConcurrentTasksPerOperatorSet,omitempty" yaml:"maxConcurrentTasksPerOperatorSet,omitempty"`
+	// QueueDepth is the number of tasks buffered ahead of the workers before ingestion is throttled
+	QueueDepth int `json:"queueDepth,omitempty" yaml:"queueDepth,omitempty"`
+	// Weight is the AVS's share of task slots, relative to other AVSs, when the
+	// aggregator-wide task limit is contended. Defaults to 1
+	Weight int `json:"weight,omitempty" yaml:"weight,omitempty"`
+	// MinTimeToDeadlineSeconds is the least time a task must have left before its
+	// deadline to be processed; tasks with less are dropped and recorded as expired
+	MinTimeToDeadlineSeconds int `json:"minTimeToDeadlineSeconds,omitempty" yaml:"minTimeToDeadlineSeconds,omitempty"`
+	// OperatorCacheSize is the number of resolved operator sets kept in memory for the AVS. Defaults to 256
+	OperatorCacheSize int `json:"operatorCacheSize,omitempty" yaml:"operatorCacheSize,omitempty"`
+}
+
+func (tpc *TaskProcessingConfig) Validate() field.ErrorList {
+	var allErrors field.ErrorList
+	if tpc.MaxConcurrentTasks < 0 {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("maxConcurrentTasks"), tpc.MaxConcurrentTasks, "maxConcurrentTasks must not be negative"))
+	}
+	if tpc.MaxConcurrentTasksPerOperatorSet < 0 {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("maxConcurrentTasksPerOperatorSet"), tpc.MaxConcurrentTasksPerOperatorSet, "maxConcurrentTasksPerOperatorSet must not be negative"))
+	}
+	if tpc.MaxConcurrentTasks > 0 && tpc.MaxConcurrentTasksPerOperatorSet > tpc.MaxConcurrentTasks {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("maxConcurrentTasksPerOperatorSet"), tpc.MaxConcurrentTasksPerOperatorSet, "maxConcurrentTasksPerOperatorSet must not exceed maxConcurrentTasks"))
+	}
+	if tpc.QueueDepth < 0 {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("queueDepth"), tpc.QueueDepth, "queueDepth must not be negative"))
+	}
+	if tpc.Weight < 0 {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("weight"), tpc.Weight, "weight must not be negative"))
+	}
+	if tpc.MinTimeToDeadlineSeconds < 0 {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("minTimeToDeadlineSeconds"), tpc.MinTimeToDeadlineSeconds, "minTimeToDeadlineSeconds must not be negative"))
+	}
+	if tpc.OperatorCacheSize < 0 {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("operatorCacheSize"), tpc.OperatorCacheSize, "operatorCacheSize must not be negative"))
+	}
+	return allErrors
+}
+
+// TaskSchedulingConfig controls how tasks are scheduled across every AVS of the aggregator
+type TaskSchedulingConfig struct {
+	// MaxConcurrentTasks caps the tasks processed at once across all AVSs. When it is
+	// reached, free slots go to the most urgent task after weighting by AVS.
+	// Defaults to no limit beyond each AVS's own
+	MaxConcurrentTasks int `json:"maxConcurrentTasks,omitempty" yaml:"maxConcurrentTasks,omitempty"`
+}
+
+func (tsc *TaskSchedulingConfig) Validate() field.ErrorList {
+	var allErrors field.ErrorList
+	if tsc.MaxConcurrentTasks < 0 {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("maxConcurrentTasks"), tsc.MaxConcurrentTasks, "maxConcurrentTasks must not be negative"))
+	}
+	return allErrors
+}
+
+// TaskSourcesConfig enables sources of off-chain tasks for an AVS, next to the tasks
+// created on chain. Off-chain tasks are signed by the operators like any other task,
+// but their certificates are kept by the aggregator instead of being submitted
+type TaskSourcesConfig struct {
+	// Submit accepts tasks through the SubmitTask management RPC, which requires
+	// authentication to be enabled
+	Submit *SubmitTaskSourceConfig `json:"submit,omitempty" yaml:"submit,omitempty"`
+	// File reads tasks from a JSON lines file
+	File *FileTaskSourceConfig `json:"file,omitempty" yaml:"file,omitempty"`
+	// DefaultTimeoutSeconds is the deadline, from submission, of tasks that do not set one. Defaults to 60
+	DefaultTimeoutSeconds int `json:"defaultTimeoutSeconds,omitempty" yaml:"defaultTimeoutSeconds,omitempty"`
+	// MaxTimeoutSeconds caps how far in the future the deadline of a task can be. Defaults to 3600
+	MaxTimeoutSeconds int `json:"maxTimeoutSeconds,omitempty" yaml:"maxTimeoutSeconds,omitempty"`
+}
+
+type SubmitTaskSourceConfig struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+}
+
+type FileTaskSourceConfig struct {
+	// Path of the file, one JSON task per line
+	Path string `json:"path" yaml:"path"`
+	// PollIntervalSeconds is how often the file is checked for new lines. Defaults to 1
+	PollIntervalSeconds int `json:"pollIntervalSeconds,omitempty" yaml:"pollIntervalSeconds,omitempty"`
+}
+
+func (tsc *TaskSourcesConfig) Validate() field.ErrorList {
+	var allErrors field.ErrorList
+	if tsc.DefaultTimeoutSeconds < 0 {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("taskSources", "defaultTimeoutSeconds"), tsc.DefaultTimeoutSeconds, "defaultTimeoutSeconds must not be negative"))
+	}
+	if tsc.MaxTimeoutSeconds < 0 {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("taskSources", "maxTimeoutSeconds"), tsc.MaxTimeoutSeconds, "maxTimeoutSeconds must not be negative"))
+	}
+	if tsc.DefaultTimeoutSeconds > 0 && tsc.MaxTimeoutSeconds > 0 && tsc.DefaultTimeoutSeconds > tsc.MaxTimeoutSeconds {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("taskSources", "defaultTimeoutSeconds"), tsc.DefaultTimeoutSeconds, "defaultTimeoutSeconds must not exceed maxTimeoutSeconds"))
+	}
+	if tsc.File != nil {
+		if tsc.File.Path == "" {
+			allErrors = append(allErrors, field.Required(field.NewPath("taskSources", "file", "path"), "path is required"))
+		}
+		if tsc.File.PollIntervalSeconds < 0 {
+			allErrors = append(allErrors, field.Invalid(field.NewPath("taskSources", "file", "pollIntervalSeconds"), tsc.File.PollIntervalSeconds, "pollIntervalSeconds must not be negative"))
+		}
+	}
+	return allErrors
 }
 
 type AggregatorAvs struct {
 	Address  string `json:"address" yaml:"address"`
 	ChainIds []uint `json:"chainIds" yaml:"chainIds"`
+
+	// TaskProcessing optionally overrides the default task concurrency for the AVS
+	TaskProcessing *TaskProcessingConfig `json:"taskProcessing,omitempty" yaml:"taskProcessing,omitempty"`
+
+	// Finality optionally overrides the finality policy of the chains for the AVS
+	Finality *FinalityConfig `json:"finality,omitempty" yaml:"finality,omitempty"`
+
+	// TaskSources optionally enables sources of off-chain tasks for the AVS
+	TaskSources *TaskSourcesConfig `json:"taskSources,omitempty" yaml:"taskSources,omitempty"`
 }
 
 func (aa *AggregatorAvs) Validate() error {
@@ -56,6 +299,15 @@ func (aa *AggregatorAvs) Validate() error {
 	if aa.Address == "" {
 		allErrors = append(allErrors, field.Required(field.NewPath("address"), "address is required"))
 	}
+	if aa.TaskProcessing != nil {
+		allErrors = append(allErrors, aa.TaskProcessing.Validate()...)
+	}
+	if aa.Finality != nil {
+		allErrors = append(allErrors, aa.Finality.Validate()...)
+	}
+	if aa.TaskSources != nil {
+		allErrors = append(allErrors, aa.TaskSources.Validate()...)
+	}
 	if len(allErrors) > 0 {
 		return allErrors.ToAggregate()
 	}
@@ -132,6 +384,12 @@ type AggregatorConfig struct {
 
 	// Authentication configuration for mgmt apis
 	Authentication auth.Config `json:"authentication,omitempty" yaml:"authentication,omitempty"`
+
+	// Metrics configures the Prometheus metrics endpoint
+	Metrics *metrics.Config `json:"metrics,omitempty" yaml:"metrics,omitempty"`
+
+	// TaskScheduling optionally limits task processing across all AVSs
+	TaskScheduling *TaskSchedulingConfig `json:"taskScheduling,omitempty" yaml:"taskScheduling,omitempty"`
 }
 
 func (arc *AggregatorConfig) Validate() error {
@@ -167,6 +425,8 @@ func (arc *AggregatorConfig) Validate() error {
 		})
 		if found == nil {
 			allErrors = append(allErrors, field.Invalid(field.NewPath("l1ChainId"), arc.L1ChainId, "l1ChainId must be one of the configured chains"))
+		} else if found.RoleForChain(found.ChainId) != config.ChainRoleL1 {
+			allErrors = append(allErrors, field.Invalid(field.NewPath("l1ChainId"), arc.L1ChainId, "l1ChainId must be a chain with the l1 role"))
 		}
 	}
 
@@ -183,6 +443,18 @@ func (arc *AggregatorConfig) Validate() error {
 		}
 	}
 
+	if arc.Metrics != nil {
+		if metricsErrors := arc.Metrics.Validate(); len(metricsErrors) > 0 {
+			allErrors = append(allErrors, field.Invalid(field.NewPath("metrics"), arc.Metrics, metricsErrors.ToAggregate().Error()))
+		}
+	}
+
+	if arc.TaskScheduling != nil {
+		if schedulingErrors := arc.TaskScheduling.Validate(); len(schedulingErrors) > 0 {
+			allErrors = append(allErrors, field.Invalid(field.NewPath("taskScheduling"), arc.TaskScheduling, schedulingErrors.ToAggregate().Error()))
+		}
+	}
+
 	return allErrors.ToAggregate()
 }
 
@@ -1,6 +1,7 @@
 package aggregatorConfig
 
 import (
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -66,6 +67,58 @@ func Test_AggregatorConfig(t *testing.T) {
 			})
 		})
 	})
+	t.Run("Chains declared in config", func(t *testing.T) {
+		t.Run("Should accept a chain that is not built in", func(t *testing.T) {
+			c, err := NewAggregatorConfigFromYamlBytes([]byte(validYamlConfiguredChain))
+			assert.Nil(t, err)
+			assert.Equal(t, config.ChainRoleL2, c.Chains[1].Role)
+			assert.Equal(t, "0x132b466d9d5723531F68797519DfED701aC2C749", c.Chains[1].CoreContracts.TaskMailbox)
+			assert.Equal(t, "0x55F4b21681977F412B318eCB204cB933bD1dF57c", c.Chains[1].TableCalculators.BN254)
+			for _, chain := range c.Chains {
+				assert.Empty(t, chain.Validate())
+			}
+		})
+		t.Run("Should require the role and task mailbox of a chain that is not built in", func(t *testing.T) {
+			chain := &Chain{Name: "custom", ChainId: 424242, RpcURL: "http://localhost:8545"}
+			errs := chain.Validate()
+			assert.Len(t, errs, 2)
+			assert.Contains(t, errs.ToAggregate().Error(), "role")
+			assert.Contains(t, errs.ToAggregate().Error(), "coreContracts.taskMailbox")
+		})
+		t.Run("Should reject an l1ChainId that is declared as an L2", func(t *testing.T) {
+			c, err := NewAggregatorConfigFromYamlBytes([]byte(validYamlConfiguredChain))
+			assert.Nil(t, err)
+			c.L1ChainId = 424242
+			err = c.Validate()
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), "l1ChainId must be a chain with the l1 role")
+		})
+	})
+	t.Run("Task sources", func(t *testing.T) {
+		t.Run("Should accept submit and file sources", func(t *testing.T) {
+			avs := &AggregatorAvs{
+				Address: "0xavs",
+				TaskSources: &TaskSourcesConfig{
+					Submit:                &SubmitTaskSourceConfig{Enabled: true},
+					File:                  &FileTaskSourceConfig{Path: "/tmp/tasks.jsonl"},
+					DefaultTimeoutSeconds: 30,
+					MaxTimeoutSeconds:     600,
+				},
+			}
+			assert.Nil(t, avs.Validate())
+		})
+		t.Run("Should require the file path and a default timeout within the max", func(t *testing.T) {
+			sources := &TaskSourcesConfig{
+				File:                  &FileTaskSourceConfig{},
+				DefaultTimeoutSeconds: 600,
+				MaxTimeoutSeconds:     30,
+			}
+			errs := sources.Validate()
+			assert.Len(t, errs, 2)
+			assert.Contains(t, errs.ToAggregate().Error(), "taskSources.file.path")
+			assert.Contains(t, errs.ToAggregate().Error(), "defaultTimeoutSeconds must not exceed maxTimeoutSeconds")
+		})
+	})
 }
 
 const (
@@ -149,6 +202,23 @@ chains:
     network: mainnet
     chainId: 1
     rpcUrl: https://mainnet.infura.io/v3/YOUR_INFURA_PROJECT_ID
+`
+	validYamlConfiguredChain = `
+---
+l1ChainId: 1
+chains:
+  - name: ethereum
+    chainId: 1
+    rpcUrl: https://mainnet.infura.io/v3/YOUR_INFURA_PROJECT_ID
+  - name: custom-l2
+    chainId: 424242
+    rpcUrl: http://localhost:9545
+    role: l2
+    coreContracts:
+      taskMailbox: "0x132b466d9d5723531F68797519DfED701aC2C749"
+      bn254CertificateVerifier: "0x3F55654b2b2b86bB11bE2f72657f9C33bf88120A"
+    tableCalculators:
+      bn254: "0x55F4b21681977F412B318eCB204cB933bD1dF57c"
 `
 	validYamlChainsAndAvss = `
 ---
@@ -12,9 +12,12 @@ import (
 	"github.com/Layr-Labs/crypto-libs/pkg/ecdsa"
 	"github.com/Layr-Labs/crypto-libs/pkg/signing"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/executorClient"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contextManager"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contractCaller"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contractStore"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/operatorManager"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/signer"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/signing/aggregation"
@@ -22,6 +25,7 @@ import (
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
 	"go.uber.org/zap"
 )
 
@@ -30,6 +34,11 @@ const (
 	STAKE_PROPORTION_THRESHOLD
 )
 
+const (
+	DefaultMaxConcurrentTasks = 10
+	DefaultTaskQueueDepth     = 100
+)
+
 type AvsExecutionManagerConfig struct {
 	AvsAddress               string
 	SupportedChainIds        []config.ChainId
@@ -37,6 +46,20 @@ type AvsExecutionManagerConfig struct {
 	AggregatorAddress        string
 	L1ChainId                config.ChainId
 	TlsEnabled               bool
+
+	// MaxConcurrentTasks is the number of tasks processed in parallel
+	MaxConcurrentTasks int
+	// MaxConcurrentTasksPerOperatorSet caps in-flight tasks for a single operator set.
+	// Defaults to MaxConcurrentTasks
+	MaxConcurrentTasksPerOperatorSet int
+	// TaskQueueDepth bounds how many tasks may wait, ordered by deadline, for a worker
+	TaskQueueDepth int
+	// SchedulingWeight is the AVS's share of task slots when the aggregator-wide
+	// limit is contended. Defaults to DefaultSchedulingWeight
+	SchedulingWeight int
+	// MinTimeToDeadline is the least time a task must have left to be dispatched;
+	// tasks with less are dropped and recorded as expired
+	MinTimeToDeadline time.Duration
 }
 
 type OperatorSet struct {
@@ -78,14 +101,33 @@ type AvsExecutionManager struct {
 
 	operatorManager *operatorManager.OperatorManager
 
+	// executorClients is the connection pool to executors shared by every AVS
+	executorClients *executorClient.ExecutorClientPool
+
 	contractStore contractStore.IContractStore
 
 	taskQueue chan *types.Task
 
+	// blockContextManagers hand out the contexts that cancel a chain's tasks when their block is
+	// reorged, for tasks that don't come from the chain poller
+	blockContextManagers map[config.ChainId]contextManager.IBlockContextManager
+
 	inflightTasks sync.Map
 
 	store storage.AggregatorStore
 
+	// pendingTasks holds tasks taken off taskQueue until a worker is free for them
+	pendingTasks *deadlineQueue
+
+	// scheduler limits how many tasks run at once across every AVS
+	scheduler *TaskScheduler
+
+	workerPool *taskWorkerPool
+
+	scoreboard *operatorScoreboard
+
+	recovery *recoveryTracker
+
 	avsConfigMutex sync.Mutex
 }
 
@@ -95,7 +137,10 @@ func NewAvsExecutionManager(
 	signers signer.Signers,
 	cs contractStore.IContractStore,
 	om *operatorManager.OperatorManager,
+	executorClients *executorClient.ExecutorClientPool,
+	scheduler *TaskScheduler,
 	taskQueue chan *types.Task,
+	blockContextManagers map[config.ChainId]contextManager.IBlockContextManager,
 	store storage.AggregatorStore,
 	logger *zap.Logger,
 ) (*AvsExecutionManager, error) {
@@ -118,6 +163,18 @@ func NewAvsExecutionManager(
 	if store == nil {
 		return nil, fmt.Errorf("store is required")
 	}
+	if config.MaxConcurrentTasks <= 0 {
+		config.MaxConcurrentTasks = DefaultMaxConcurrentTasks
+	}
+	if config.MaxConcurrentTasksPerOperatorSet <= 0 {
+		config.MaxConcurrentTasksPerOperatorSet = config.MaxConcurrentTasks
+	}
+	if config.TaskQueueDepth <= 0 {
+		config.TaskQueueDepth = DefaultTaskQueueDepth
+	}
+	if config.SchedulingWeight <= 0 {
+		config.SchedulingWeight = DefaultSchedulingWeight
+	}
 
 	manager := &AvsExecutionManager{
 		config:               config,
@@ -126,10 +183,37 @@ func NewAvsExecutionManager(
 		signers:              signers,
 		contractStore:        cs,
 		operatorManager:      om,
+		executorClients:      executorClients,
 		store:                store,
 		inflightTasks:        sync.Map{},
 		taskQueue:            taskQueue,
-	}
+		blockContextManagers: blockContextManagers,
+		pendingTasks:         newDeadlineQueue(config.TaskQueueDepth),
+		scheduler:            scheduler,
+		scoreboard:           newOperatorScoreboard(config.AvsAddress),
+		recovery:             newRecoveryTracker(),
+	}
+	manager.workerPool = newTaskWorkerPool(
+		config.AvsAddress,
+		config.MaxConcurrentTasks,
+		config.MaxConcurrentTasksPerOperatorSet,
+		func(ctx context.Context, task *types.Task) {
+			defer manager.scheduler.Release()
+
+			inFlight := metrics.AggregatorTasksInFlight.WithLabelValues(manager.config.AvsAddress)
+			inFlight.Inc()
+			defer inFlight.Dec()
+
+			err := manager.handleTask(ctx, task)
+			manager.recordTaskOutcome(task, err)
+			if err != nil {
+				manager.logger.Sugar().Errorw("Failed to handle task",
+					"taskId", task.TaskId,
+					"error", err,
+				)
+			}
+		},
+	)
 	return manager, nil
 }
 
@@ -139,103 +223,25 @@ func (em *AvsExecutionManager) Start(ctx context.Context) error {
 		zap.String("contractAddress", em.config.AvsAddress),
 		zap.Any("supportedChainIds", em.config.SupportedChainIds),
 		zap.String("avsAddress", em.config.AvsAddress),
+		zap.Int("maxConcurrentTasks", em.config.MaxConcurrentTasks),
+		zap.Int("maxConcurrentTasksPerOperatorSet", em.config.MaxConcurrentTasksPerOperatorSet),
+		zap.Int("schedulingWeight", em.config.SchedulingWeight),
+		zap.Duration("minTimeToDeadline", em.config.MinTimeToDeadline),
 	)
 
-	if err := em.recoverPendingTasks(ctx); err != nil {
-		em.logger.Sugar().Warnw("Failed to recover pending tasks",
-			"error", err,
-			"avsAddress", em.config.AvsAddress)
-		// Continue anyway - this is not a fatal error
-	}
+	go em.enqueueTasks(ctx)
+	go em.dispatchTasks(ctx)
 
+	// Recovery feeds the task queue in the background so that a large backlog is
+	// streamed through with backpressure instead of blocking startup. A failure to
+	// recover is not fatal; it is reported through GetRecoveryStats.
 	go func() {
-		for {
-			select {
-			case task := <-em.taskQueue:
-
-				em.logger.Sugar().Infow("Received task from queue",
-					zap.String("taskId", task.TaskId),
-				)
-				if err := em.handleTask(ctx, task); err != nil {
-					em.logger.Sugar().Errorw("Failed to handle task",
-						"taskId", task.TaskId,
-						"error", err,
-					)
-				}
-			case <-ctx.Done():
-
-				em.logger.Sugar().Infow("AvsExecutionManager context done, exiting")
-				if ctx.Err() != nil {
-					em.logger.Sugar().Errorw("Error stopping AvsExecutionManager")
-				}
-				return
-			}
-		}
+		_ = em.recoverPendingTasks(ctx)
 	}()
 
 	return nil
 }
 
-// recoverPendingTasks loads pending tasks from storage and re-queues them
-func (em *AvsExecutionManager) recoverPendingTasks(ctx context.Context) error {
-	pendingTasks, err := em.store.ListPendingTasksForAVS(ctx, em.config.AvsAddress)
-	if err != nil {
-		return fmt.Errorf("failed to list pending tasks: %w", err)
-	}
-
-	if len(pendingTasks) == 0 {
-		return nil
-	}
-
-	em.logger.Sugar().Infow("Recovering pending tasks from storage",
-		"count", len(pendingTasks),
-		"avsAddress", em.config.AvsAddress)
-
-	recovered := 0
-	for _, task := range pendingTasks {
-
-		if task.DeadlineUnixSeconds != nil && time.Now().After(*task.DeadlineUnixSeconds) {
-			em.logger.Sugar().Warnw("Skipping expired task during recovery",
-				"taskId", task.TaskId,
-				"deadline", task.DeadlineUnixSeconds.Unix(),
-				"currentTime", time.Now().Unix())
-
-			if err := em.store.UpdateTaskStatus(ctx, task.TaskId, storage.TaskStatusFailed); err != nil {
-				em.logger.Sugar().Warnw("Failed to mark expired task as failed",
-					"error", err,
-					"taskId", task.TaskId)
-			}
-			continue
-		}
-
-		if _, exists := em.inflightTasks.Load(task.TaskId); exists {
-			em.logger.Sugar().Warnw("Task already in flight, skipping recovery",
-				"taskId", task.TaskId)
-			continue
-		}
-
-		select {
-		case em.taskQueue <- task:
-			recovered++
-			em.logger.Sugar().Infow("Re-queued recovered task",
-				"taskId", task.TaskId,
-				"avsAddress", task.AVSAddress)
-		default:
-			em.logger.Sugar().Warnw("Task queue full, cannot recover task",
-				"taskId", task.TaskId)
-			// If we can't queue it now, it will be picked up on next restart
-			break
-		}
-	}
-
-	em.logger.Sugar().Infow("Task recovery completed",
-		"totalPending", len(pendingTasks),
-		"recovered", recovered,
-		"avsAddress", em.config.AvsAddress)
-
-	return nil
-}
-
 func (em *AvsExecutionManager) getExecutorTaskConfig(
 	ctx context.Context,
 	task *types.Task,
@@ -327,9 +333,10 @@ func (em *AvsExecutionManager) handleTask(ctx context.Context, task *types.Task)
 	em.logger.Sugar().Infow("Handling task",
 		zap.String("taskId", task.TaskId),
 	)
-	if _, ok := em.inflightTasks.Load(task.TaskId); ok {
+	if _, loaded := em.inflightTasks.LoadOrStore(task.TaskId, task); loaded {
 		return fmt.Errorf("task %s is already being processed", task.TaskId)
 	}
+	defer em.inflightTasks.Delete(task.TaskId)
 
 	if err := em.store.UpdateTaskStatus(ctx, task.TaskId, storage.TaskStatusProcessing); err != nil {
 		em.logger.Sugar().Warnw("Failed to update task status to processing",
@@ -426,16 +433,20 @@ func (em *AvsExecutionManager) handleTask(ctx context.Context, task *types.Task)
 		return fmt.Errorf("no L1 contract caller found")
 	}
 
+	sessionCtx, cancelSession := newTaskSessionContext(ctx, task)
+	defer cancelSession()
+
 	// TODO: pass in the known values we indexed to verify against the response during aggregation
 	if opsetCurveType == config.CurveTypeBN254 {
 		ts, err := taskSession.NewBN254TaskSession(
-			ctx,
+			sessionCtx,
 			task,
 			l1Cc,
 			em.config.AggregatorAddress,
 			signerToUse,
 			operatorPeersWeight,
 			em.config.TlsEnabled,
+			em.executorClients,
 			em.logger,
 		)
 		if err != nil {
@@ -448,13 +459,14 @@ func (em *AvsExecutionManager) handleTask(ctx context.Context, task *types.Task)
 		return em.processBN254Task(ctx, task, ts, chainCC, operatorPeersWeight)
 	} else if opsetCurveType == config.CurveTypeECDSA {
 		ts, err := taskSession.NewECDSATaskSession(
-			ctx,
+			sessionCtx,
 			task,
 			l1Cc,
 			em.config.AggregatorAddress,
 			signerToUse,
 			operatorPeersWeight,
 			em.config.TlsEnabled,
+			em.executorClients,
 			em.logger,
 		)
 		if err != nil {
@@ -473,6 +485,27 @@ func (em *AvsExecutionManager) handleTask(ctx context.Context, task *types.Task)
 	return fmt.Errorf("unsupported curve type: %s", opsetCurveType)
 }
 
+// newTaskSessionContext returns the context a task session runs under. It ends at the
+// task deadline, or earlier if the task's own context is cancelled (e.g. its block
+// was reorged out), so that broadcasts and retries stop with the task.
+func newTaskSessionContext(ctx context.Context, task *types.Task) (context.Context, context.CancelFunc) {
+	var sessionCtx context.Context
+	var cancel context.CancelFunc
+	if task.DeadlineUnixSeconds != nil {
+		sessionCtx, cancel = context.WithDeadline(ctx, *task.DeadlineUnixSeconds)
+	} else {
+		sessionCtx, cancel = context.WithCancel(ctx)
+	}
+	if task.Context != nil {
+		stop := context.AfterFunc(task.Context, cancel)
+		return sessionCtx, func() {
+			stop()
+			cancel()
+		}
+	}
+	return sessionCtx, cancel
+}
+
 func (em *AvsExecutionManager) processBN254Task(
 	ctx context.Context,
 	task *types.Task,
@@ -497,19 +530,29 @@ func (em *AvsExecutionManager) processBN254Task(
 			zap.String("taskId", task.TaskId),
 		)
 		cert, err := ts.Process()
+		certifiedDigest := ""
+		if err == nil && cert != nil {
+			certifiedDigest = outputDigest(cert.TaskResponse)
+		}
+		em.recordOperatorResponses(ctx, task, ts.Responses(), certifiedDigest)
+		outcome := &storage.TaskOutcome{RespondingOperators: ts.RespondingOperators()}
 		if err != nil {
 			em.logger.Sugar().Errorw("Failed to process task",
 				zap.String("taskId", task.TaskId),
 				zap.Error(err),
 			)
-			errorsChan <- fmt.Errorf("failed to process task: %w", err)
+			err = fmt.Errorf("failed to process task: %w", err)
+			em.saveTaskOutcome(ctx, task.TaskId, outcome, err)
+			errorsChan <- err
 			return
 		}
 		if cert == nil {
 			em.logger.Sugar().Errorw("Received nil aggregate certificate",
 				zap.String("taskId", task.TaskId),
 			)
-			errorsChan <- fmt.Errorf("received nil aggregate certificate")
+			err = fmt.Errorf("received nil aggregate certificate")
+			em.saveTaskOutcome(ctx, task.TaskId, outcome, err)
+			errorsChan <- err
 			return
 		}
 		em.logger.Sugar().Infow("Received task response and certificate",
@@ -519,6 +562,16 @@ func (em *AvsExecutionManager) processBN254Task(
 
 		// Convert certificate to submission parameters
 		params := cert.ToSubmitParams()
+		outcome.TaskResponseDigest = hexutil.Encode(cert.TaskResponseDigest[:])
+		taskCert := newBN254TaskCertificate(task.TaskId, cert)
+		em.saveTaskCertificate(ctx, taskCert)
+		if !task.IsOnChain() {
+			em.keepOffChainResult(ctx, task, outcome)
+			doneChan <- true
+			return
+		}
+
+		submissionStart := time.Now()
 		receipt, err := chainCC.SubmitBN254TaskResultRetryable(
 			ctx,
 			params,
@@ -526,9 +579,16 @@ func (em *AvsExecutionManager) processBN254Task(
 			operatorPeersWeight.RootReferenceTimestamp,
 			operatorPeersWeight.OperatorInfoTreeRoot,
 		)
+		em.recordSubmission(task, config.CurveTypeBN254, submissionStart, receipt)
+		if receipt != nil {
+			setCertificateReceipt(taskCert, receipt)
+			em.saveTaskCertificate(ctx, taskCert)
+		}
 		if err != nil {
 			em.logger.Sugar().Errorw("Failed to submit task result", "error", err)
-			errorsChan <- fmt.Errorf("failed to submit task result: %w", err)
+			err = fmt.Errorf("failed to submit task result: %w", err)
+			em.saveTaskOutcome(ctx, task.TaskId, outcome, err)
+			errorsChan <- err
 			return
 		} else {
 			em.logger.Sugar().Infow("Successfully submitted task result",
@@ -536,6 +596,8 @@ func (em *AvsExecutionManager) processBN254Task(
 				zap.String("transactionHash", receipt.TxHash.String()),
 			)
 		}
+		outcome.SubmissionTxHash = receipt.TxHash.String()
+		em.saveTaskOutcome(ctx, task.TaskId, outcome, nil)
 		doneChan <- true
 	}(chainCC)
 
@@ -551,8 +613,6 @@ func (em *AvsExecutionManager) processBN254Task(
 				"taskId", task.TaskId,
 			)
 		}
-		// Remove from inflight tasks
-		em.inflightTasks.Delete(task.TaskId)
 		return nil
 
 	case err := <-errorsChan:
@@ -564,25 +624,23 @@ func (em *AvsExecutionManager) processBN254Task(
 				"taskId", task.TaskId,
 			)
 		}
-		// Remove from inflight tasks
-		em.inflightTasks.Delete(task.TaskId)
 		return err
 
 	case <-task.Context.Done():
-		switch err := ctx.Err(); {
+		switch err := task.Context.Err(); {
 		case errors.Is(err, context.Canceled):
 			em.logger.Sugar().Errorw("task session context done",
 				zap.String("taskId", task.TaskId),
-				zap.Error(ctx.Err()),
+				zap.Error(err),
 			)
 			return fmt.Errorf("task session context cancelled: %w", err)
 
 		case errors.Is(err, context.DeadlineExceeded):
 			em.logger.Sugar().Errorw("task session context deadline exceeded",
 				zap.String("taskId", task.TaskId),
-				zap.Error(ctx.Err()),
+				zap.Error(err),
 			)
-			return fmt.Errorf("task session context deadline exceeded: %w", ctx.Err())
+			return fmt.Errorf("task session context deadline exceeded: %w", err)
 
 		default:
 			if err != nil {
@@ -629,13 +687,21 @@ func (em *AvsExecutionManager) processECDSATask(
 		)
 
 		cert, err := ts.Process()
+		certifiedDigest := ""
+		if err == nil && cert != nil {
+			certifiedDigest = outputDigest(cert.TaskResponse)
+		}
+		em.recordOperatorResponses(ctx, task, ts.Responses(), certifiedDigest)
+		outcome := &storage.TaskOutcome{RespondingOperators: ts.RespondingOperators()}
 
 		if err != nil {
 			em.logger.Sugar().Errorw("Failed to process task",
 				zap.String("taskId", task.TaskId),
 				zap.Error(err),
 			)
-			errorsChan <- fmt.Errorf("failed to process task: %w", err)
+			err = fmt.Errorf("failed to process task: %w", err)
+			em.saveTaskOutcome(ctx, task.TaskId, outcome, err)
+			errorsChan <- err
 			return
 		}
 
@@ -643,7 +709,9 @@ func (em *AvsExecutionManager) processECDSATask(
 			em.logger.Sugar().Errorw("Received nil aggregate certificate",
 				zap.String("taskId", task.TaskId),
 			)
-			errorsChan <- fmt.Errorf("received nil aggregate certificate")
+			err = fmt.Errorf("received nil aggregate certificate")
+			em.saveTaskOutcome(ctx, task.TaskId, outcome, err)
+			errorsChan <- err
 			return
 		}
 
@@ -654,10 +722,27 @@ func (em *AvsExecutionManager) processECDSATask(
 
 		// Convert certificate to submission parameters
 		params := cert.ToSubmitParams()
+		outcome.TaskResponseDigest = hexutil.Encode(cert.TaskResponseDigest[:])
+		taskCert := newECDSATaskCertificate(task.TaskId, cert)
+		em.saveTaskCertificate(ctx, taskCert)
+		if !task.IsOnChain() {
+			em.keepOffChainResult(ctx, task, outcome)
+			doneChan <- true
+			return
+		}
+
+		submissionStart := time.Now()
 		receipt, err := chainCC.SubmitECDSATaskResultRetryable(ctx, params, operatorPeersWeight.RootReferenceTimestamp)
+		em.recordSubmission(task, config.CurveTypeECDSA, submissionStart, receipt)
+		if receipt != nil {
+			setCertificateReceipt(taskCert, receipt)
+			em.saveTaskCertificate(ctx, taskCert)
+		}
 		if err != nil {
 			em.logger.Sugar().Errorw("Failed to submit task result", "error", err)
-			errorsChan <- fmt.Errorf("failed to submit task result: %w", err)
+			err = fmt.Errorf("failed to submit task result: %w", err)
+			em.saveTaskOutcome(ctx, task.TaskId, outcome, err)
+			errorsChan <- err
 			return
 
 		} else {
@@ -667,6 +752,8 @@ func (em *AvsExecutionManager) processECDSATask(
 			)
 		}
 
+		outcome.SubmissionTxHash = receipt.TxHash.String()
+		em.saveTaskOutcome(ctx, task.TaskId, outcome, nil)
 		doneChan <- true
 	}(chainCC)
 
@@ -683,9 +770,6 @@ func (em *AvsExecutionManager) processECDSATask(
 				"taskId", task.TaskId,
 			)
 		}
-
-		// Remove from inflight tasks
-		em.inflightTasks.Delete(task.TaskId)
 		return nil
 
 	case err := <-errorsChan:
@@ -697,23 +781,20 @@ func (em *AvsExecutionManager) processECDSATask(
 				"taskId", task.TaskId,
 			)
 		}
-
-		// Remove from inflight tasks
-		em.inflightTasks.Delete(task.TaskId)
 		return err
 	case <-task.Context.Done():
-		switch err := ctx.Err(); {
+		switch err := task.Context.Err(); {
 		case errors.Is(err, context.Canceled):
 			em.logger.Sugar().Errorw("task session context cancelled",
 				zap.String("taskId", task.TaskId),
-				zap.Error(ctx.Err()),
+				zap.Error(err),
 			)
 			return fmt.Errorf("task session context cancelled: %w", err)
 
 		case errors.Is(err, context.DeadlineExceeded):
 			em.logger.Sugar().Errorw("task session context deadline exceeded",
 				zap.String("taskId", task.TaskId),
-				zap.Error(ctx.Err()),
+				zap.Error(err),
 			)
 			if updateErr := em.store.UpdateTaskStatus(ctx, task.TaskId, storage.TaskStatusFailed); updateErr != nil {
 				em.logger.Sugar().Warnw("Failed to update task status to failed",
@@ -721,7 +802,7 @@ func (em *AvsExecutionManager) processECDSATask(
 					"taskId", task.TaskId,
 				)
 			}
-			return fmt.Errorf("task session context deadline exceeded: %w", ctx.Err())
+			return fmt.Errorf("task session context deadline exceeded: %w", err)
 
 		default:
 			if err != nil {
@@ -742,6 +823,87 @@ func (em *AvsExecutionManager) processECDSATask(
 	}
 }
 
+// GetOperatorScores returns the scoreboard for operators this AVS has broadcast
+// tasks to, optionally limited to a single operator set
+func (em *AvsExecutionManager) GetOperatorScores(operatorSetId *uint32) []OperatorScore {
+	return em.scoreboard.Scores(operatorSetId)
+}
+
+// keepOffChainResult completes a task that did not come from a TaskMailbox. There is
+// nothing to submit its result to, so the certificate saved for it is left for its
+// submitter to fetch and post.
+func (em *AvsExecutionManager) keepOffChainResult(ctx context.Context, task *types.Task, outcome *storage.TaskOutcome) {
+	em.logger.Sugar().Infow("Kept certificate of off-chain task for its submitter",
+		zap.String("taskId", task.TaskId),
+		zap.String("source", task.Source),
+		zap.String("taskResponseDigest", outcome.TaskResponseDigest),
+	)
+	em.saveTaskOutcome(ctx, task.TaskId, outcome, nil)
+}
+
+// saveTaskOutcome persists how a task was resolved so it can be queried through the management API
+func (em *AvsExecutionManager) saveTaskOutcome(ctx context.Context, taskId string, outcome *storage.TaskOutcome, taskErr error) {
+	if taskErr != nil {
+		outcome.Error = taskErr.Error()
+	}
+	if err := em.store.SaveTaskOutcome(ctx, taskId, outcome); err != nil {
+		em.logger.Sugar().Warnw("Failed to save task outcome",
+			"error", err,
+			"taskId", taskId,
+		)
+	}
+}
+
+// recordOperatorResponses persists how each operator responded to a task and
+// folds the responses into the operator scoreboard
+func (em *AvsExecutionManager) recordOperatorResponses(ctx context.Context, task *types.Task, responses []*storage.OperatorResponse, certifiedDigest string) {
+	for _, response := range responses {
+		if err := em.store.SaveOperatorResponse(ctx, response); err != nil {
+			em.logger.Sugar().Warnw("Failed to save operator response",
+				"error", err,
+				"taskId", response.TaskId,
+				"operatorAddress", response.OperatorAddress,
+			)
+		}
+	}
+	em.scoreboard.Record(task.OperatorSetId, responses, certifiedDigest)
+}
+
+// saveTaskCertificate persists the aggregated certificate for a task
+func (em *AvsExecutionManager) saveTaskCertificate(ctx context.Context, cert *storage.TaskCertificate) {
+	if err := em.store.SaveTaskCertificate(ctx, cert); err != nil {
+		em.logger.Sugar().Warnw("Failed to save task certificate",
+			"error", err,
+			"taskId", cert.TaskId,
+		)
+	}
+}
+
+// recordTaskOutcome classifies the result of handleTask for metrics
+func (em *AvsExecutionManager) recordTaskOutcome(task *types.Task, err error) {
+	chainId := chainIdLabel(task.ChainId)
+	switch {
+	case err == nil:
+		metrics.AggregatorTasksCompleted.WithLabelValues(em.config.AvsAddress, chainId).Inc()
+	case errors.Is(err, context.DeadlineExceeded):
+		metrics.AggregatorTasksExpired.WithLabelValues(em.config.AvsAddress, chainId).Inc()
+	default:
+		metrics.AggregatorTasksFailed.WithLabelValues(em.config.AvsAddress, chainId).Inc()
+	}
+}
+
+func (em *AvsExecutionManager) recordSubmission(task *types.Task, curveType config.CurveType, start time.Time, receipt *ethereumTypes.Receipt) {
+	labels := []string{em.config.AvsAddress, chainIdLabel(task.ChainId), curveType.String()}
+	metrics.AggregatorCertificateSubmissionSeconds.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+	if receipt != nil {
+		metrics.AggregatorCertificateSubmissionGas.WithLabelValues(labels...).Observe(float64(receipt.GasUsed))
+	}
+}
+
+func chainIdLabel(chainId config.ChainId) string {
+	return fmt.Sprintf("%d", chainId)
+}
+
 func (em *AvsExecutionManager) getContractCallerForChain(chainId config.ChainId) (contractCaller.IContractCaller, error) {
 	caller, ok := em.chainContractCallers[chainId]
 	if !ok {
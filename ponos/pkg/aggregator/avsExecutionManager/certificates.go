@@ -0,0 +1,77 @@
+package avsExecutionManager
+
+import (
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/signing/aggregation"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// newBN254TaskCertificate converts an aggregated BN254 certificate into its storage representation
+func newBN254TaskCertificate(taskId string, cert *aggregation.AggregatedBN254Certificate) *storage.TaskCertificate {
+	bn254Cert := &storage.BN254Certificate{
+		NonSignerOperators: make([]storage.CertificateOperator, 0, len(cert.NonSignerOperators)),
+	}
+	if cert.SignersSignature != nil {
+		bn254Cert.SignersSignature = cert.SignersSignature.Bytes()
+	}
+	if cert.SignersPublicKey != nil && cert.SignersPublicKey.G2Affine != nil {
+		bn254Cert.SignersPublicKey = cert.SignersPublicKey.Marshal()
+	}
+	for _, op := range cert.NonSignerOperators {
+		nonSigner := storage.CertificateOperator{
+			OperatorAddress: op.Address,
+			OperatorIndex:   op.OperatorIndex,
+		}
+		if op.PublicKey != nil {
+			nonSigner.PublicKey = op.PublicKey.Bytes()
+		}
+		bn254Cert.NonSignerOperators = append(bn254Cert.NonSignerOperators, nonSigner)
+	}
+
+	taskCert := &storage.TaskCertificate{
+		TaskId:             taskId,
+		CurveType:          config.CurveTypeBN254,
+		TaskResponse:       cert.TaskResponse,
+		TaskResponseDigest: hexutil.Encode(cert.TaskResponseDigest[:]),
+		BN254:              bn254Cert,
+	}
+	if cert.SignedAt != nil {
+		taskCert.SignedAt = *cert.SignedAt
+	}
+	return taskCert
+}
+
+// newECDSATaskCertificate converts an aggregated ECDSA certificate into its storage representation
+func newECDSATaskCertificate(taskId string, cert *aggregation.AggregatedECDSACertificate) *storage.TaskCertificate {
+	signatures := make(map[string][]byte, len(cert.SignersSignatures))
+	for address, sig := range cert.SignersSignatures {
+		signatures[address.String()] = sig
+	}
+
+	taskCert := &storage.TaskCertificate{
+		TaskId:             taskId,
+		CurveType:          config.CurveTypeECDSA,
+		TaskResponse:       cert.TaskResponse,
+		TaskResponseDigest: hexutil.Encode(cert.TaskResponseDigest[:]),
+		ECDSA: &storage.ECDSACertificate{
+			SignersSignatures: signatures,
+		},
+	}
+	if cert.SignedAt != nil {
+		taskCert.SignedAt = *cert.SignedAt
+	}
+	return taskCert
+}
+
+// setCertificateReceipt records the submission receipt on a certificate, if there is one
+func setCertificateReceipt(cert *storage.TaskCertificate, receipt *ethereumTypes.Receipt) {
+	if receipt == nil {
+		return
+	}
+	cert.ReceiptHash = receipt.TxHash.String()
+	cert.SubmittedAt = time.Now()
+}
@@ -0,0 +1,207 @@
+package avsExecutionManager
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+)
+
+type queuedTask struct {
+	task *types.Task
+	// seq orders tasks with the same deadline, or without one, by arrival
+	seq uint64
+}
+
+// before reports whether a should run before b: earliest deadline first, tasks
+// without a deadline last, ties broken by arrival
+func (a *queuedTask) before(b *queuedTask) bool {
+	da, db := a.task.DeadlineUnixSeconds, b.task.DeadlineUnixSeconds
+	switch {
+	case da != nil && db != nil && !da.Equal(*db):
+		return da.Before(*db)
+	case da != nil && db == nil:
+		return true
+	case da == nil && db != nil:
+		return false
+	default:
+		return a.seq < b.seq
+	}
+}
+
+// taskHeap implements heap.Interface
+type taskHeap []*queuedTask
+
+func (h taskHeap) Len() int           { return len(h) }
+func (h taskHeap) Less(i, j int) bool { return h[i].before(h[j]) }
+func (h taskHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *taskHeap) Push(x any) {
+	*h = append(*h, x.(*queuedTask))
+}
+
+func (h *taskHeap) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return item
+}
+
+// deadlineQueue holds tasks waiting for a worker. Each operator set has its own
+// queue ordered earliest deadline first, so that when one operator set is
+// saturated the most urgent task of another can still be picked. The queue is
+// bounded; Push blocks while it is full.
+type deadlineQueue struct {
+	capacity int
+
+	mu      sync.Mutex
+	byOpset map[uint32]*taskHeap
+	// queued is the IDs of the tasks in the queue, so that a task that reaches the
+	// queue twice, e.g. from the chain poller and from recovery, is only kept once
+	queued map[string]struct{}
+	size   int
+	seq    uint64
+
+	// changed is closed and replaced every time a task is added or removed
+	changed chan struct{}
+}
+
+func newDeadlineQueue(capacity int) *deadlineQueue {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &deadlineQueue{
+		capacity: capacity,
+		byOpset:  make(map[uint32]*taskHeap),
+		queued:   make(map[string]struct{}),
+		changed:  make(chan struct{}),
+	}
+}
+
+// Push adds a task to the queue, blocking while the queue is full. A task that is
+// already queued is ignored.
+func (q *deadlineQueue) Push(ctx context.Context, task *types.Task) error {
+	for {
+		q.mu.Lock()
+		if _, ok := q.queued[task.TaskId]; ok {
+			q.mu.Unlock()
+			return nil
+		}
+		if q.size < q.capacity {
+			h, ok := q.byOpset[task.OperatorSetId]
+			if !ok {
+				h = &taskHeap{}
+				q.byOpset[task.OperatorSetId] = h
+			}
+			q.seq++
+			heap.Push(h, &queuedTask{task: task, seq: q.seq})
+			q.queued[task.TaskId] = struct{}{}
+			q.size++
+			q.notifyLocked()
+			q.mu.Unlock()
+			return nil
+		}
+		changed := q.changed
+		q.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Peek returns the most urgent task among the operator sets accepted by eligible
+// without removing it
+func (q *deadlineQueue) Peek(eligible func(operatorSetId uint32) bool) *types.Task {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, next := q.nextLocked(eligible); next != nil {
+		return next.task
+	}
+	return nil
+}
+
+// Pop removes and returns the most urgent task among the operator sets accepted by eligible
+func (q *deadlineQueue) Pop(eligible func(operatorSetId uint32) bool) *types.Task {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	operatorSetId, next := q.nextLocked(eligible)
+	if next == nil {
+		return nil
+	}
+	q.popLocked(operatorSetId)
+	return next.task
+}
+
+// RemoveExpired removes and returns every task that is due before cutoff
+func (q *deadlineQueue) RemoveExpired(cutoff time.Time) []*types.Task {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var expired []*types.Task
+	for operatorSetId, h := range q.byOpset {
+		for h.Len() > 0 {
+			deadline := (*h)[0].task.DeadlineUnixSeconds
+			if deadline == nil || deadline.After(cutoff) {
+				break
+			}
+			expired = append(expired, q.popLocked(operatorSetId).task)
+		}
+	}
+	return expired
+}
+
+// Changed returns a channel that is closed the next time a task is added or removed
+func (q *deadlineQueue) Changed() <-chan struct{} {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.changed
+}
+
+func (q *deadlineQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.size
+}
+
+// LOCKING: assumes the caller holds q.mu
+func (q *deadlineQueue) nextLocked(eligible func(operatorSetId uint32) bool) (uint32, *queuedTask) {
+	var (
+		operatorSetId uint32
+		next          *queuedTask
+	)
+	for id, h := range q.byOpset {
+		head := (*h)[0]
+		if (next == nil || head.before(next)) && eligible(id) {
+			operatorSetId, next = id, head
+		}
+	}
+	return operatorSetId, next
+}
+
+// LOCKING: assumes the caller holds q.mu
+func (q *deadlineQueue) popLocked(operatorSetId uint32) *queuedTask {
+	h := q.byOpset[operatorSetId]
+	item := heap.Pop(h).(*queuedTask)
+	if h.Len() == 0 {
+		delete(q.byOpset, operatorSetId)
+	}
+	delete(q.queued, item.task.TaskId)
+	q.size--
+	q.notifyLocked()
+	return item
+}
+
+// LOCKING: assumes the caller holds q.mu
+func (q *deadlineQueue) notifyLocked() {
+	close(q.changed)
+	q.changed = make(chan struct{})
+}
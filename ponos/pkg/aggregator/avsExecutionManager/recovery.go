@@ -0,0 +1,188 @@
+package avsExecutionManager
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+	"go.uber.org/zap"
+)
+
+// RecoveryState is the progress of pending task recovery after a restart
+type RecoveryState string
+
+const (
+	RecoveryStateNotStarted RecoveryState = "not_started"
+	RecoveryStateRunning    RecoveryState = "running"
+	RecoveryStateCompleted  RecoveryState = "completed"
+	RecoveryStateFailed     RecoveryState = "failed"
+)
+
+// RecoveryStats reports how pending tasks found in storage at startup were handled
+type RecoveryStats struct {
+	State       RecoveryState
+	StartedAt   time.Time
+	CompletedAt time.Time
+
+	// Pending is the number of pending tasks found in storage
+	Pending int
+	// Recovered is the number of tasks handed back to the task queue
+	Recovered int
+	// Skipped is the number of tasks that were already in flight
+	Skipped int
+	// Expired is the number of tasks whose deadline passed before they could be recovered
+	Expired int
+	// Error describes why recovery failed or stopped early
+	Error string
+}
+
+type recoveryTracker struct {
+	mu    sync.Mutex
+	stats RecoveryStats
+}
+
+func newRecoveryTracker() *recoveryTracker {
+	return &recoveryTracker{stats: RecoveryStats{State: RecoveryStateNotStarted}}
+}
+
+func (r *recoveryTracker) update(fn func(stats *RecoveryStats)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	fn(&r.stats)
+}
+
+func (r *recoveryTracker) snapshot() RecoveryStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.stats
+}
+
+// GetRecoveryStats returns the progress of pending task recovery
+func (em *AvsExecutionManager) GetRecoveryStats() RecoveryStats {
+	return em.recovery.snapshot()
+}
+
+// sortTasksByDeadline orders tasks by ascending deadline so the most urgent are
+// recovered first; tasks without a deadline go last
+func sortTasksByDeadline(tasks []*types.Task) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		di, dj := tasks[i].DeadlineUnixSeconds, tasks[j].DeadlineUnixSeconds
+		switch {
+		case di == nil:
+			return false
+		case dj == nil:
+			return true
+		default:
+			return di.Before(*dj)
+		}
+	})
+}
+
+// recoverPendingTasks loads pending tasks from storage and streams them through
+// the task queue, most urgent first. Sends block while the queue is full, so the
+// whole backlog is recovered at the pace the workers can take it. Tasks whose
+// deadline has already passed when their turn comes are marked failed.
+func (em *AvsExecutionManager) recoverPendingTasks(ctx context.Context) error {
+	em.recovery.update(func(stats *RecoveryStats) {
+		stats.State = RecoveryStateRunning
+		stats.StartedAt = time.Now()
+	})
+
+	pendingTasks, err := em.store.ListPendingTasksForAVS(ctx, em.config.AvsAddress)
+	if err != nil {
+		err = fmt.Errorf("failed to list pending tasks: %w", err)
+		em.finishRecovery(err)
+		return err
+	}
+	em.recovery.update(func(stats *RecoveryStats) {
+		stats.Pending = len(pendingTasks)
+	})
+
+	if len(pendingTasks) == 0 {
+		em.finishRecovery(nil)
+		return nil
+	}
+
+	em.logger.Sugar().Infow("Recovering pending tasks from storage",
+		"count", len(pendingTasks),
+		"avsAddress", em.config.AvsAddress)
+
+	sortTasksByDeadline(pendingTasks)
+	for _, task := range pendingTasks {
+		if task.DeadlineUnixSeconds != nil && time.Now().After(*task.DeadlineUnixSeconds) {
+			em.expireRecoveredTask(ctx, task)
+			continue
+		}
+
+		if _, exists := em.inflightTasks.Load(task.TaskId); exists {
+			em.logger.Sugar().Warnw("Task already in flight, skipping recovery",
+				"taskId", task.TaskId)
+			em.recovery.update(func(stats *RecoveryStats) { stats.Skipped++ })
+			metrics.AggregatorTasksRecovered.WithLabelValues(em.config.AvsAddress, "skipped").Inc()
+			continue
+		}
+
+		em.attachBlockContext(task)
+
+		select {
+		case em.taskQueue <- task:
+			em.logger.Sugar().Infow("Re-queued recovered task",
+				"taskId", task.TaskId,
+				"avsAddress", task.AVSAddress)
+			em.recovery.update(func(stats *RecoveryStats) { stats.Recovered++ })
+			metrics.AggregatorTasksRecovered.WithLabelValues(em.config.AvsAddress, "recovered").Inc()
+		case <-ctx.Done():
+			err := fmt.Errorf("recovery stopped before all pending tasks were queued: %w", ctx.Err())
+			em.finishRecovery(err)
+			return err
+		}
+	}
+
+	em.finishRecovery(nil)
+	stats := em.recovery.snapshot()
+	em.logger.Sugar().Infow("Task recovery completed",
+		"totalPending", stats.Pending,
+		"recovered", stats.Recovered,
+		"skipped", stats.Skipped,
+		"expired", stats.Expired,
+		"avsAddress", em.config.AvsAddress)
+
+	return nil
+}
+
+// attachBlockContext gives a recovered task the context of its source block, so it is
+// cancelled like any other task of the block if the block is reorged
+func (em *AvsExecutionManager) attachBlockContext(task *types.Task) {
+	bcm, ok := em.blockContextManagers[task.ChainId]
+	if !ok || task.DeadlineUnixSeconds == nil {
+		return
+	}
+	task.Context = bcm.GetContext(task.SourceBlockNumber, task)
+}
+
+func (em *AvsExecutionManager) expireRecoveredTask(ctx context.Context, task *types.Task) {
+	em.expireTask(ctx, task, "deadline passed before the task could be recovered")
+	em.recovery.update(func(stats *RecoveryStats) { stats.Expired++ })
+	metrics.AggregatorTasksRecovered.WithLabelValues(em.config.AvsAddress, "expired").Inc()
+}
+
+func (em *AvsExecutionManager) finishRecovery(err error) {
+	em.recovery.update(func(stats *RecoveryStats) {
+		stats.CompletedAt = time.Now()
+		stats.State = RecoveryStateCompleted
+		if err != nil {
+			stats.State = RecoveryStateFailed
+			stats.Error = err.Error()
+		}
+	})
+	if err != nil {
+		em.logger.Sugar().Warnw("Failed to recover pending tasks",
+			zap.Error(err),
+			zap.String("avsAddress", em.config.AvsAddress),
+		)
+	}
+}
@@ -0,0 +1,302 @@
+package avsExecutionManager
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/mocks"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage/memory"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contextManager"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contextManager/taskBlockContextManager"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+)
+
+const testRecoveryAvsAddress = "0xavs"
+
+type testContextKey string
+
+func newTestRecoveryManager(t *testing.T, queueSize int) (*AvsExecutionManager, *memory.InMemoryAggregatorStore) {
+	store := memory.NewInMemoryAggregatorStore()
+	return &AvsExecutionManager{
+		logger:    zaptest.NewLogger(t),
+		config:    &AvsExecutionManagerConfig{AvsAddress: testRecoveryAvsAddress},
+		taskQueue: make(chan *types.Task, queueSize),
+		store:     store,
+		recovery:  newRecoveryTracker(),
+	}, store
+}
+
+func savePendingTestTask(t *testing.T, store storage.AggregatorStore, taskId string, deadline *time.Time) {
+	require.NoError(t, store.SavePendingTask(context.Background(), &types.Task{
+		TaskId:              taskId,
+		AVSAddress:          testRecoveryAvsAddress,
+		DeadlineUnixSeconds: deadline,
+	}))
+}
+
+func Test_RecoverPendingTasks(t *testing.T) {
+	t.Run("streams every pending task through a small queue, most urgent first", func(t *testing.T) {
+		em, store := newTestRecoveryManager(t, 1)
+		now := time.Now()
+		for i := 9; i >= 0; i-- {
+			deadline := now.Add(time.Duration(i+1) * time.Minute)
+			savePendingTestTask(t, store, fmt.Sprintf("task-%d", i), &deadline)
+		}
+		savePendingTestTask(t, store, "no-deadline", nil)
+
+		done := make(chan error, 1)
+		go func() { done <- em.recoverPendingTasks(context.Background()) }()
+
+		var received []string
+		for len(received) < 11 {
+			select {
+			case task := <-em.taskQueue:
+				// a slow consumer holds recovery back instead of dropping tasks
+				time.Sleep(5 * time.Millisecond)
+				received = append(received, task.TaskId)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("timed out after receiving %d tasks", len(received))
+			}
+		}
+		require.NoError(t, <-done)
+
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, fmt.Sprintf("task-%d", i), received[i])
+		}
+		assert.Equal(t, "no-deadline", received[10])
+
+		stats := em.GetRecoveryStats()
+		assert.Equal(t, RecoveryStateCompleted, stats.State)
+		assert.Equal(t, 11, stats.Pending)
+		assert.Equal(t, 11, stats.Recovered)
+		assert.False(t, stats.CompletedAt.Before(stats.StartedAt))
+	})
+
+	t.Run("expires overdue tasks and skips tasks already in flight", func(t *testing.T) {
+		em, store := newTestRecoveryManager(t, 10)
+		past := time.Now().Add(-time.Minute)
+		future := time.Now().Add(time.Minute)
+		savePendingTestTask(t, store, "expired", &past)
+		savePendingTestTask(t, store, "inflight", &future)
+		savePendingTestTask(t, store, "recovered", &future)
+		em.inflightTasks.Store("inflight", &types.Task{TaskId: "inflight"})
+
+		require.NoError(t, em.recoverPendingTasks(context.Background()))
+
+		stats := em.GetRecoveryStats()
+		assert.Equal(t, RecoveryStats{
+			State:       RecoveryStateCompleted,
+			StartedAt:   stats.StartedAt,
+			CompletedAt: stats.CompletedAt,
+			Pending:     3,
+			Recovered:   1,
+			Skipped:     1,
+			Expired:     1,
+		}, stats)
+
+		require.Len(t, em.taskQueue, 1)
+		assert.Equal(t, "recovered", (<-em.taskQueue).TaskId)
+
+		record, err := store.GetTaskRecord(context.Background(), "expired")
+		require.NoError(t, err)
+		assert.Equal(t, storage.TaskStatusFailed, record.Status)
+	})
+
+	t.Run("completes when there is nothing to recover", func(t *testing.T) {
+		em, _ := newTestRecoveryManager(t, 10)
+
+		require.NoError(t, em.recoverPendingTasks(context.Background()))
+
+		assert.Empty(t, em.taskQueue)
+		stats := em.GetRecoveryStats()
+		assert.Equal(t, RecoveryStateCompleted, stats.State)
+		assert.Equal(t, 0, stats.Pending)
+	})
+
+	t.Run("marks expired tasks failed and leaves valid tasks pending", func(t *testing.T) {
+		em, store := newTestRecoveryManager(t, 10)
+		past := time.Now().Add(-time.Hour)
+		future := time.Now().Add(time.Hour)
+		savePendingTestTask(t, store, "expired-task-1", &past)
+		savePendingTestTask(t, store, "expired-task-2", &past)
+		savePendingTestTask(t, store, "valid-task", &future)
+
+		require.NoError(t, em.recoverPendingTasks(context.Background()))
+
+		require.Len(t, em.taskQueue, 1, "only the valid task should be queued")
+		assert.Equal(t, "valid-task", (<-em.taskQueue).TaskId)
+
+		// the valid task stays pending until the execution manager processes it
+		pendingTasks, err := store.ListPendingTasksForAVS(context.Background(), testRecoveryAvsAddress)
+		require.NoError(t, err)
+		require.Len(t, pendingTasks, 1)
+		assert.Equal(t, "valid-task", pendingTasks[0].TaskId)
+
+		for _, taskId := range []string{"expired-task-1", "expired-task-2"} {
+			record, err := store.GetTaskRecord(context.Background(), taskId)
+			require.NoError(t, err)
+			assert.Equal(t, storage.TaskStatusFailed, record.Status, taskId)
+		}
+	})
+
+	t.Run("queues tasks as they were stored, leaving them pending", func(t *testing.T) {
+		em, store := newTestRecoveryManager(t, 10)
+		future := time.Now().Add(time.Hour)
+		for i := 1; i <= 3; i++ {
+			require.NoError(t, store.SavePendingTask(context.Background(), &types.Task{
+				TaskId:              fmt.Sprintf("task-%d", i),
+				AVSAddress:          testRecoveryAvsAddress,
+				DeadlineUnixSeconds: &future,
+				Payload:             []byte(fmt.Sprintf("payload-%d", i)),
+				ReferenceTimestamp:  100,
+				SourceBlockNumber:   uint64(2000 + i),
+			}))
+		}
+
+		require.NoError(t, em.recoverPendingTasks(context.Background()))
+
+		require.Len(t, em.taskQueue, 3)
+		for len(em.taskQueue) > 0 {
+			task := <-em.taskQueue
+			stored, err := store.GetTask(context.Background(), task.TaskId)
+			require.NoError(t, err)
+			assert.Equal(t, stored.AVSAddress, task.AVSAddress)
+			assert.Equal(t, stored.Payload, task.Payload)
+			assert.Equal(t, stored.SourceBlockNumber, task.SourceBlockNumber)
+		}
+
+		pendingTasks, err := store.ListPendingTasksForAVS(context.Background(), testRecoveryAvsAddress)
+		require.NoError(t, err)
+		assert.Len(t, pendingTasks, 3, "tasks stay pending until the execution manager processes them")
+	})
+
+	t.Run("keeps tasks waiting for queue space pending", func(t *testing.T) {
+		em, store := newTestRecoveryManager(t, 2)
+		future := time.Now().Add(time.Hour)
+		for i := 1; i <= 4; i++ {
+			savePendingTestTask(t, store, fmt.Sprintf("task-%d", i), &future)
+		}
+
+		done := make(chan error, 1)
+		go func() { done <- em.recoverPendingTasks(context.Background()) }()
+
+		require.Eventually(t, func() bool {
+			return len(em.taskQueue) == 2
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, RecoveryStateRunning, em.GetRecoveryStats().State)
+		pendingTasks, err := store.ListPendingTasksForAVS(context.Background(), testRecoveryAvsAddress)
+		require.NoError(t, err)
+		assert.Len(t, pendingTasks, 4)
+
+		for i := 0; i < 4; i++ {
+			select {
+			case <-em.taskQueue:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("timed out after receiving %d tasks", i)
+			}
+		}
+		require.NoError(t, <-done)
+		assert.Equal(t, 4, em.GetRecoveryStats().Recovered)
+	})
+
+	t.Run("fills task contexts from the block context manager", func(t *testing.T) {
+		em, store := newTestRecoveryManager(t, 10)
+		ctrl := gomock.NewController(t)
+		bcm := mocks.NewMockIBlockContextManager(ctrl)
+		em.blockContextManagers = map[config.ChainId]contextManager.IBlockContextManager{config.ChainId(1): bcm}
+
+		past := time.Now().Add(-time.Hour)
+		future := time.Now().Add(time.Hour)
+		for _, task := range []*types.Task{
+			{TaskId: "task-1", DeadlineUnixSeconds: &future, SourceBlockNumber: 100},
+			{TaskId: "task-2", DeadlineUnixSeconds: &future, SourceBlockNumber: 101},
+			{TaskId: "expired-task", DeadlineUnixSeconds: &past, SourceBlockNumber: 99},
+		} {
+			task.AVSAddress = testRecoveryAvsAddress
+			task.ChainId = config.ChainId(1)
+			require.NoError(t, store.SavePendingTask(context.Background(), task))
+		}
+
+		// expired tasks are failed before a context is requested for them
+		bcm.EXPECT().
+			GetContext(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(blockNumber uint64, task *types.Task) context.Context {
+				assert.NotEqual(t, "expired-task", task.TaskId)
+				return context.WithValue(context.Background(), testContextKey("block"), blockNumber)
+			}).
+			Times(2)
+
+		require.NoError(t, em.recoverPendingTasks(context.Background()))
+
+		require.Len(t, em.taskQueue, 2)
+		for len(em.taskQueue) > 0 {
+			task := <-em.taskQueue
+			require.NotNil(t, task.Context)
+			assert.Equal(t, task.SourceBlockNumber, task.Context.Value(testContextKey("block")))
+		}
+	})
+
+	t.Run("gives recovered tasks the context of their source block", func(t *testing.T) {
+		em, store := newTestRecoveryManager(t, 10)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		bcm := taskBlockContextManager.NewTaskBlockContextManager(ctx, store, zaptest.NewLogger(t))
+		em.blockContextManagers = map[config.ChainId]contextManager.IBlockContextManager{config.ChainId(1): bcm}
+
+		future := time.Now().Add(time.Minute)
+		require.NoError(t, store.SavePendingTask(context.Background(), &types.Task{
+			TaskId:              "reorged",
+			AVSAddress:          testRecoveryAvsAddress,
+			ChainId:             config.ChainId(1),
+			SourceBlockNumber:   100,
+			DeadlineUnixSeconds: &future,
+		}))
+		savePendingTestTask(t, store, "other-chain", &future)
+
+		require.NoError(t, em.recoverPendingTasks(context.Background()))
+		require.Len(t, em.taskQueue, 2)
+		recovered := map[string]*types.Task{}
+		for len(em.taskQueue) > 0 {
+			task := <-em.taskQueue
+			recovered[task.TaskId] = task
+		}
+		assert.Nil(t, recovered["other-chain"].Context)
+
+		// a reorg of the source block cancels the recovered task
+		reorged := recovered["reorged"]
+		require.NotNil(t, reorged.Context)
+		require.NoError(t, reorged.Context.Err())
+		bcm.CancelBlock(100)
+		assert.ErrorIs(t, reorged.Context.Err(), context.Canceled)
+	})
+
+	t.Run("reports a failure when stopped before the backlog is queued", func(t *testing.T) {
+		em, store := newTestRecoveryManager(t, 1)
+		future := time.Now().Add(time.Minute)
+		savePendingTestTask(t, store, "task-1", &future)
+		savePendingTestTask(t, store, "task-2", &future)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() { done <- em.recoverPendingTasks(ctx) }()
+
+		require.Eventually(t, func() bool {
+			return em.GetRecoveryStats().Recovered == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		cancel()
+
+		require.ErrorIs(t, <-done, context.Canceled)
+		stats := em.GetRecoveryStats()
+		assert.Equal(t, RecoveryStateFailed, stats.State)
+		assert.Equal(t, 1, stats.Recovered)
+		assert.NotEmpty(t, stats.Error)
+	})
+}
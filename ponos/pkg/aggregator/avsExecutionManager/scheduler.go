@@ -0,0 +1,233 @@
+package avsExecutionManager
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+	"go.uber.org/zap"
+)
+
+// DefaultSchedulingWeight is the share of contended task slots an AVS gets unless configured otherwise
+const DefaultSchedulingWeight = 1
+
+// noDeadlinePriority is the priority of tasks without a deadline, after every task that has one
+var noDeadlinePriority = time.Unix(math.MaxInt64/2, 0)
+
+type slotWaiter struct {
+	priority time.Time
+	seq      uint64
+	granted  chan struct{}
+}
+
+// TaskScheduler caps how many tasks are processed at once across every AVS of an
+// aggregator. When slots are contended they are granted earliest priority first,
+// where an AVS's priority for a task is its deadline scaled down by the AVS's
+// weight, so that a heavier AVS is served as if its tasks were more urgent.
+//
+// A scheduler without a limit grants every slot immediately; a nil scheduler
+// behaves the same.
+type TaskScheduler struct {
+	maxConcurrentTasks int
+
+	mu      sync.Mutex
+	running int
+	waiters []*slotWaiter
+	seq     uint64
+}
+
+// NewTaskScheduler returns a scheduler that runs at most maxConcurrentTasks tasks at
+// once, or any number of tasks if maxConcurrentTasks is not positive
+func NewTaskScheduler(maxConcurrentTasks int) *TaskScheduler {
+	return &TaskScheduler{maxConcurrentTasks: maxConcurrentTasks}
+}
+
+// Acquire blocks until a task slot is granted or ctx is done. Every successful
+// Acquire must be paired with a Release.
+func (s *TaskScheduler) Acquire(ctx context.Context, priority time.Time) error {
+	if s == nil || s.maxConcurrentTasks <= 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	if s.running < s.maxConcurrentTasks && len(s.waiters) == 0 {
+		s.running++
+		s.mu.Unlock()
+		return nil
+	}
+	s.seq++
+	w := &slotWaiter{priority: priority, seq: s.seq, granted: make(chan struct{})}
+	s.waiters = append(s.waiters, w)
+	s.mu.Unlock()
+
+	select {
+	case <-w.granted:
+		return nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for i, waiter := range s.waiters {
+			if waiter == w {
+				s.waiters = append(s.waiters[:i], s.waiters[i+1:]...)
+				return ctx.Err()
+			}
+		}
+		// the slot was granted while ctx was being cancelled, hand it on
+		s.releaseLocked()
+		return ctx.Err()
+	}
+}
+
+// Release returns a slot obtained with Acquire
+func (s *TaskScheduler) Release() {
+	if s == nil || s.maxConcurrentTasks <= 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.releaseLocked()
+}
+
+// LOCKING: assumes the caller holds s.mu
+func (s *TaskScheduler) releaseLocked() {
+	if len(s.waiters) == 0 {
+		s.running--
+		return
+	}
+	next := 0
+	for i, w := range s.waiters {
+		if w.priority.Before(s.waiters[next].priority) ||
+			(w.priority.Equal(s.waiters[next].priority) && w.seq < s.waiters[next].seq) {
+			next = i
+		}
+	}
+	w := s.waiters[next]
+	s.waiters = append(s.waiters[:next], s.waiters[next+1:]...)
+	// the slot passes straight to the waiter, so running is unchanged
+	close(w.granted)
+}
+
+// schedulingPriority is the task's deadline with the time left until it divided
+// by the AVS's weight
+func (em *AvsExecutionManager) schedulingPriority(task *types.Task, now time.Time) time.Time {
+	if task.DeadlineUnixSeconds == nil {
+		return noDeadlinePriority
+	}
+	remaining := task.DeadlineUnixSeconds.Sub(now)
+	if remaining <= 0 {
+		return *task.DeadlineUnixSeconds
+	}
+	return now.Add(remaining / time.Duration(em.config.SchedulingWeight))
+}
+
+// enqueueTasks moves tasks from the task queue into the deadline queue, blocking
+// while the deadline queue is full so that ingestion is throttled
+func (em *AvsExecutionManager) enqueueTasks(ctx context.Context) {
+	for {
+		select {
+		case task := <-em.taskQueue:
+			em.logger.Sugar().Infow("Received task from queue",
+				zap.String("taskId", task.TaskId),
+			)
+			metrics.AggregatorTasksQueued.WithLabelValues(em.config.AvsAddress).Set(float64(len(em.taskQueue)))
+			if task.Context == nil {
+				task.Context = ctx
+			}
+			if err := em.pendingTasks.Push(ctx, task); err != nil {
+				em.logger.Sugar().Infow("AvsExecutionManager context done, exiting")
+				return
+			}
+			metrics.AggregatorTasksAwaitingDispatch.WithLabelValues(em.config.AvsAddress).Set(float64(em.pendingTasks.Len()))
+		case <-ctx.Done():
+			em.logger.Sugar().Infow("AvsExecutionManager context done, exiting")
+			return
+		}
+	}
+}
+
+// dispatchTasks hands tasks from the deadline queue to the worker pool, most
+// urgent first, skipping operator sets that are at their concurrency limit.
+// Tasks that can no longer meet their deadline are dropped and recorded as expired.
+func (em *AvsExecutionManager) dispatchTasks(ctx context.Context) {
+	for {
+		next := em.waitForDispatchableTask(ctx)
+		if next == nil {
+			return
+		}
+		if err := em.scheduler.Acquire(ctx, em.schedulingPriority(next, time.Now())); err != nil {
+			return
+		}
+
+		// a more urgent task may have arrived while waiting for a slot
+		em.expireOverdueTasks(ctx)
+		task := em.pendingTasks.Pop(em.workerPool.HasCapacity)
+		if task == nil {
+			em.scheduler.Release()
+			continue
+		}
+		metrics.AggregatorTasksAwaitingDispatch.WithLabelValues(em.config.AvsAddress).Set(float64(em.pendingTasks.Len()))
+
+		// only this goroutine starts tasks, so the capacity seen by Pop is still there
+		if !em.workerPool.TryStart(ctx, task) {
+			em.scheduler.Release()
+			em.logger.Sugar().Errorw("Worker pool rejected a dispatched task",
+				"taskId", task.TaskId,
+			)
+		}
+	}
+}
+
+// waitForDispatchableTask blocks until the deadline queue holds a task whose
+// operator set has room in the worker pool, and returns it without removing it
+func (em *AvsExecutionManager) waitForDispatchableTask(ctx context.Context) *types.Task {
+	for {
+		// grab the signals before looking so that a change in between is not missed
+		queueChanged := em.pendingTasks.Changed()
+		workerReleased := em.workerPool.Released()
+
+		em.expireOverdueTasks(ctx)
+		if task := em.pendingTasks.Peek(em.workerPool.HasCapacity); task != nil {
+			return task
+		}
+
+		select {
+		case <-queueChanged:
+		case <-workerReleased:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// expireOverdueTasks drops every queued task with less than MinTimeToDeadline left
+func (em *AvsExecutionManager) expireOverdueTasks(ctx context.Context) {
+	expired := em.pendingTasks.RemoveExpired(time.Now().Add(em.config.MinTimeToDeadline))
+	for _, task := range expired {
+		em.expireTask(ctx, task, "task cannot meet its deadline, dropped before dispatch")
+	}
+	if len(expired) > 0 {
+		metrics.AggregatorTasksAwaitingDispatch.WithLabelValues(em.config.AvsAddress).Set(float64(em.pendingTasks.Len()))
+	}
+}
+
+// expireTask marks a task that will not be processed because its deadline passed as failed
+func (em *AvsExecutionManager) expireTask(ctx context.Context, task *types.Task, reason string) {
+	em.logger.Sugar().Warnw("Dropping expired task",
+		"taskId", task.TaskId,
+		"reason", reason,
+		"deadline", task.DeadlineUnixSeconds.Unix(),
+		"currentTime", time.Now().Unix())
+
+	if err := em.store.UpdateTaskStatus(ctx, task.TaskId, storage.TaskStatusFailed); err != nil {
+		em.logger.Sugar().Warnw("Failed to mark expired task as failed",
+			"error", err,
+			"taskId", task.TaskId)
+	}
+	em.saveTaskOutcome(ctx, task.TaskId, &storage.TaskOutcome{}, errors.New(reason))
+	metrics.AggregatorTasksExpired.WithLabelValues(em.config.AvsAddress, chainIdLabel(task.ChainId)).Inc()
+}
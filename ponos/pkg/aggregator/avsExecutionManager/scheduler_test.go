@@ -0,0 +1,209 @@
+package avsExecutionManager
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage/memory"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestDeadlineTask(id string, operatorSetId uint32, deadline time.Time) *types.Task {
+	task := newTestTask(id, operatorSetId)
+	task.AVSAddress = testRecoveryAvsAddress
+	task.DeadlineUnixSeconds = &deadline
+	return task
+}
+
+func anyOperatorSet(uint32) bool { return true }
+
+func Test_DeadlineQueue(t *testing.T) {
+	t.Run("pops the earliest deadline first and tasks without one last", func(t *testing.T) {
+		q := newDeadlineQueue(10)
+		now := time.Now()
+		require.NoError(t, q.Push(context.Background(), newTestTask("no-deadline", 1)))
+		require.NoError(t, q.Push(context.Background(), newTestDeadlineTask("late", 1, now.Add(time.Hour))))
+		require.NoError(t, q.Push(context.Background(), newTestDeadlineTask("urgent", 2, now.Add(time.Minute))))
+		require.NoError(t, q.Push(context.Background(), newTestDeadlineTask("soon", 1, now.Add(10*time.Minute))))
+
+		var order []string
+		for q.Len() > 0 {
+			order = append(order, q.Pop(anyOperatorSet).TaskId)
+		}
+		assert.Equal(t, []string{"urgent", "soon", "late", "no-deadline"}, order)
+	})
+
+	t.Run("skips operator sets that are not eligible", func(t *testing.T) {
+		q := newDeadlineQueue(10)
+		now := time.Now()
+		require.NoError(t, q.Push(context.Background(), newTestDeadlineTask("opset1", 1, now.Add(time.Minute))))
+		require.NoError(t, q.Push(context.Background(), newTestDeadlineTask("opset2", 2, now.Add(time.Hour))))
+
+		onlyOpset2 := func(id uint32) bool { return id == 2 }
+		assert.Equal(t, "opset2", q.Peek(onlyOpset2).TaskId)
+		assert.Equal(t, "opset2", q.Pop(onlyOpset2).TaskId)
+		assert.Nil(t, q.Pop(onlyOpset2))
+		assert.Equal(t, 1, q.Len())
+	})
+
+	t.Run("removes tasks due before the cutoff", func(t *testing.T) {
+		q := newDeadlineQueue(10)
+		now := time.Now()
+		require.NoError(t, q.Push(context.Background(), newTestDeadlineTask("past", 1, now.Add(-time.Second))))
+		require.NoError(t, q.Push(context.Background(), newTestDeadlineTask("too-close", 2, now.Add(2*time.Second))))
+		require.NoError(t, q.Push(context.Background(), newTestDeadlineTask("fine", 2, now.Add(time.Minute))))
+		require.NoError(t, q.Push(context.Background(), newTestTask("no-deadline", 1)))
+
+		expired := q.RemoveExpired(now.Add(5 * time.Second))
+		var ids []string
+		for _, task := range expired {
+			ids = append(ids, task.TaskId)
+		}
+		assert.ElementsMatch(t, []string{"past", "too-close"}, ids)
+		assert.Equal(t, 2, q.Len())
+	})
+
+	t.Run("keeps a task pushed twice once", func(t *testing.T) {
+		q := newDeadlineQueue(10)
+		require.NoError(t, q.Push(context.Background(), newTestTask("a", 1)))
+		require.NoError(t, q.Push(context.Background(), newTestTask("a", 1)))
+		assert.Equal(t, 1, q.Len())
+
+		q.Pop(anyOperatorSet)
+		require.NoError(t, q.Push(context.Background(), newTestTask("a", 1)))
+		assert.Equal(t, 1, q.Len())
+	})
+
+	t.Run("blocks pushes while full", func(t *testing.T) {
+		q := newDeadlineQueue(1)
+		require.NoError(t, q.Push(context.Background(), newTestTask("a", 1)))
+
+		pushed := make(chan error, 1)
+		go func() { pushed <- q.Push(context.Background(), newTestTask("b", 1)) }()
+		select {
+		case <-pushed:
+			t.Fatal("push should block while the queue is full")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		q.Pop(anyOperatorSet)
+		select {
+		case err := <-pushed:
+			assert.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("push did not unblock after a task was removed")
+		}
+	})
+}
+
+func Test_TaskScheduler(t *testing.T) {
+	t.Run("grants contended slots to the earliest priority", func(t *testing.T) {
+		s := NewTaskScheduler(1)
+		require.NoError(t, s.Acquire(context.Background(), time.Now()))
+
+		now := time.Now()
+		var mu sync.Mutex
+		var granted []string
+		var wg sync.WaitGroup
+		for _, w := range []struct {
+			name     string
+			priority time.Time
+		}{{"late", now.Add(time.Hour)}, {"urgent", now.Add(time.Minute)}} {
+			wg.Add(1)
+			go func(name string, priority time.Time) {
+				defer wg.Done()
+				require.NoError(t, s.Acquire(context.Background(), priority))
+				mu.Lock()
+				granted = append(granted, name)
+				mu.Unlock()
+				s.Release()
+			}(w.name, w.priority)
+		}
+		require.Eventually(t, func() bool {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			return len(s.waiters) == 2
+		}, time.Second, 5*time.Millisecond)
+
+		s.Release()
+		wg.Wait()
+		assert.Equal(t, []string{"urgent", "late"}, granted)
+		assert.Equal(t, 0, s.running)
+	})
+
+	t.Run("gives up waiting when the context is done", func(t *testing.T) {
+		s := NewTaskScheduler(1)
+		require.NoError(t, s.Acquire(context.Background(), time.Now()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, s.Acquire(ctx, time.Now()), context.DeadlineExceeded)
+		assert.Empty(t, s.waiters)
+	})
+
+	t.Run("weights an AVS's tasks as more urgent", func(t *testing.T) {
+		now := time.Now()
+		task := newTestDeadlineTask("task", 1, now.Add(time.Minute))
+		light := &AvsExecutionManager{config: &AvsExecutionManagerConfig{SchedulingWeight: 1}}
+		heavy := &AvsExecutionManager{config: &AvsExecutionManagerConfig{SchedulingWeight: 4}}
+
+		assert.Equal(t, now.Add(time.Minute), light.schedulingPriority(task, now))
+		assert.Equal(t, now.Add(15*time.Second), heavy.schedulingPriority(task, now))
+	})
+}
+
+func Test_DispatchTasks(t *testing.T) {
+	t.Run("dispatches the most urgent task first and drops tasks that cannot meet their deadline", func(t *testing.T) {
+		store := memory.NewInMemoryAggregatorStore()
+		h := newBlockingHandler()
+		em := &AvsExecutionManager{
+			// the manager's goroutines outlive the test, so they can't log to it
+			logger: zap.NewNop(),
+			config: &AvsExecutionManagerConfig{
+				AvsAddress:        testRecoveryAvsAddress,
+				SchedulingWeight:  1,
+				MinTimeToDeadline: 10 * time.Second,
+			},
+			taskQueue:    make(chan *types.Task, 10),
+			store:        store,
+			pendingTasks: newDeadlineQueue(10),
+			workerPool:   newTaskWorkerPool("0xavs", 1, 1, h.handle),
+			recovery:     newRecoveryTracker(),
+		}
+
+		// occupy the only worker so that the backlog builds up behind it
+		require.True(t, em.workerPool.TryStart(context.Background(), newTestTask("running", 1)))
+
+		now := time.Now()
+		backlog := []*types.Task{
+			newTestDeadlineTask("late", 1, now.Add(time.Hour)),
+			newTestDeadlineTask("doomed", 1, now.Add(time.Second)),
+			newTestDeadlineTask("urgent", 1, now.Add(time.Minute)),
+		}
+		for _, task := range backlog {
+			require.NoError(t, store.SavePendingTask(context.Background(), task))
+			em.taskQueue <- task
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		require.NoError(t, em.Start(ctx))
+		require.Eventually(t, func() bool { return em.pendingTasks.Len() == 2 }, time.Second, 5*time.Millisecond)
+
+		close(h.release)
+		require.Eventually(t, func() bool { return len(h.startedTasks()) == 3 }, time.Second, 5*time.Millisecond)
+		assert.Equal(t, []string{"running", "urgent", "late"}, h.startedTasks())
+
+		record, err := store.GetTaskRecord(context.Background(), "doomed")
+		require.NoError(t, err)
+		assert.Equal(t, storage.TaskStatusFailed, record.Status)
+		require.NotNil(t, record.Outcome)
+		assert.NotEmpty(t, record.Outcome.Error)
+	})
+}
@@ -0,0 +1,223 @@
+package avsExecutionManager
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/util"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// scoreboardLatencySamples is how many recent latencies are kept per operator for percentiles
+const scoreboardLatencySamples = 1000
+
+// OperatorScore is a point-in-time snapshot of how an operator has performed within an operator set
+type OperatorScore struct {
+	OperatorSetId   uint32
+	OperatorAddress string
+
+	// TasksAssigned is the number of tasks broadcast to the operator
+	TasksAssigned uint64
+	// Responses is the number of tasks the operator returned a result for
+	Responses uint64
+	// Late is the number of tasks that reached consensus before the operator answered
+	Late uint64
+	// Misses is the number of tasks the operator failed to answer, either because it
+	// could not be reached or because the task never reached consensus without it
+	Misses uint64
+	// SignatureFailures is the number of results rejected during verification
+	SignatureFailures uint64
+	// DigestDisagreements is the number of results that differed from the certified response
+	DigestDisagreements uint64
+	// ConsecutiveMisses is the number of misses since the operator last responded
+	ConsecutiveMisses uint64
+
+	// ResponseRate is Responses over the tasks the operator was expected to answer,
+	// i.e. excluding Late tasks
+	ResponseRate   float64
+	MedianLatency  time.Duration
+	P99Latency     time.Duration
+	LastResponseAt time.Time
+}
+
+type operatorScoreKey struct {
+	operatorSetId   uint32
+	operatorAddress string
+}
+
+type operatorScore struct {
+	OperatorScore
+
+	// latencies is a ring buffer of the most recent response latencies
+	latencies []time.Duration
+	next      int
+}
+
+// operatorScoreboard tracks the liveness and correctness of each operator that
+// tasks are broadcast to for a single AVS. Scores are kept in memory and start
+// over when the aggregator restarts; the individual responses are persisted in
+// the aggregator store.
+type operatorScoreboard struct {
+	avsAddress string
+
+	mu     sync.Mutex
+	scores map[operatorScoreKey]*operatorScore
+}
+
+func newOperatorScoreboard(avsAddress string) *operatorScoreboard {
+	return &operatorScoreboard{
+		avsAddress: avsAddress,
+		scores:     make(map[operatorScoreKey]*operatorScore),
+	}
+}
+
+// Record folds the responses for a finished task into the scoreboard. certifiedDigest
+// is the output digest of the certified response, or empty if the task did not
+// produce a certificate.
+func (s *operatorScoreboard) Record(operatorSetId uint32, responses []*storage.OperatorResponse, certifiedDigest string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, response := range responses {
+		key := operatorScoreKey{operatorSetId: operatorSetId, operatorAddress: strings.ToLower(response.OperatorAddress)}
+		score, ok := s.scores[key]
+		if !ok {
+			score = &operatorScore{
+				OperatorScore: OperatorScore{
+					OperatorSetId:   operatorSetId,
+					OperatorAddress: response.OperatorAddress,
+				},
+			}
+			s.scores[key] = score
+		}
+		disagreed := score.record(response, certifiedDigest)
+		s.exportMetrics(score, response, disagreed)
+	}
+}
+
+// Scores returns a snapshot of every operator's score, optionally limited to a
+// single operator set, ordered by operator set and operator address
+func (s *operatorScoreboard) Scores(operatorSetId *uint32) []OperatorScore {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	scores := make([]OperatorScore, 0, len(s.scores))
+	for key, score := range s.scores {
+		if operatorSetId != nil && key.operatorSetId != *operatorSetId {
+			continue
+		}
+		scores = append(scores, score.snapshot())
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].OperatorSetId != scores[j].OperatorSetId {
+			return scores[i].OperatorSetId < scores[j].OperatorSetId
+		}
+		return strings.ToLower(scores[i].OperatorAddress) < strings.ToLower(scores[j].OperatorAddress)
+	})
+	return scores
+}
+
+func (s *operatorScoreboard) exportMetrics(score *operatorScore, response *storage.OperatorResponse, disagreed bool) {
+	operatorSetId := strconv.FormatUint(uint64(score.OperatorSetId), 10)
+	operatorAddress := strings.ToLower(score.OperatorAddress)
+
+	metrics.AggregatorOperatorResponses.
+		WithLabelValues(s.avsAddress, operatorSetId, operatorAddress, string(response.Status)).
+		Inc()
+	if disagreed {
+		metrics.AggregatorOperatorDigestDisagreements.
+			WithLabelValues(s.avsAddress, operatorSetId, operatorAddress).
+			Inc()
+	}
+	metrics.AggregatorOperatorConsecutiveMisses.
+		WithLabelValues(s.avsAddress, operatorSetId, operatorAddress).
+		Set(float64(score.ConsecutiveMisses))
+	metrics.AggregatorOperatorResponseRate.
+		WithLabelValues(s.avsAddress, operatorSetId, operatorAddress).
+		Set(score.responseRate())
+}
+
+// record updates the score with a single response and reports whether the
+// response disagreed with the certified digest
+func (score *operatorScore) record(response *storage.OperatorResponse, certifiedDigest string) bool {
+	score.TasksAssigned++
+	disagreed := false
+
+	responded := response.Result != nil || response.Status == storage.OperatorResponseRejected
+	switch {
+	case responded:
+		score.Responses++
+		score.ConsecutiveMisses = 0
+		if !response.ReceivedAt.IsZero() {
+			score.LastResponseAt = response.ReceivedAt
+		}
+		if response.Status == storage.OperatorResponseRejected {
+			score.SignatureFailures++
+		}
+		if response.Result != nil && response.Latency > 0 {
+			score.addLatency(response.Latency)
+		}
+		if certifiedDigest != "" && response.OutputDigest != "" && response.OutputDigest != certifiedDigest {
+			score.DigestDisagreements++
+			disagreed = true
+		}
+	case response.Status == storage.OperatorResponseLate && certifiedDigest != "":
+		// consensus was reached without this operator, which is not held against it
+		score.Late++
+	default:
+		score.Misses++
+		score.ConsecutiveMisses++
+	}
+	return disagreed
+}
+
+func (score *operatorScore) addLatency(latency time.Duration) {
+	if len(score.latencies) < scoreboardLatencySamples {
+		score.latencies = append(score.latencies, latency)
+		return
+	}
+	score.latencies[score.next] = latency
+	score.next = (score.next + 1) % scoreboardLatencySamples
+}
+
+func (score *operatorScore) responseRate() float64 {
+	expected := score.TasksAssigned - score.Late
+	if expected == 0 {
+		return 0
+	}
+	return float64(score.Responses) / float64(expected)
+}
+
+func (score *operatorScore) snapshot() OperatorScore {
+	snapshot := score.OperatorScore
+	snapshot.ResponseRate = score.responseRate()
+
+	if len(score.latencies) > 0 {
+		sorted := append([]time.Duration{}, score.latencies...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		snapshot.MedianLatency = percentile(sorted, 0.5)
+		snapshot.P99Latency = percentile(sorted, 0.99)
+	}
+	return snapshot
+}
+
+// percentile returns the nearest-rank percentile of an ascending slice
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+// outputDigest returns the hex encoded digest used to compare operator outputs
+func outputDigest(output []byte) string {
+	digest := util.GetKeccak256Digest(output)
+	return hexutil.Encode(digest[:])
+}
@@ -0,0 +1,123 @@
+package avsExecutionManager
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestResponse(operator string, status storage.OperatorResponseStatus, output string, latency time.Duration) *storage.OperatorResponse {
+	response := &storage.OperatorResponse{
+		OperatorAddress: operator,
+		Status:          status,
+	}
+	if output != "" {
+		response.Result = &types.TaskResult{OperatorAddress: operator, Output: []byte(output)}
+		response.OutputDigest = outputDigest([]byte(output))
+		response.ReceivedAt = time.Now()
+		response.Latency = latency
+	}
+	return response
+}
+
+func Test_OperatorScoreboard(t *testing.T) {
+	t.Run("tracks responses, misses and disagreements per operator", func(t *testing.T) {
+		sb := newOperatorScoreboard("0xavs")
+		certified := outputDigest([]byte("good"))
+
+		// task 1: operator a and b agree, c disagrees, d is unreachable
+		sb.Record(1, []*storage.OperatorResponse{
+			newTestResponse("0xA", storage.OperatorResponseAccepted, "good", 100*time.Millisecond),
+			newTestResponse("0xb", storage.OperatorResponseAccepted, "good", 200*time.Millisecond),
+			newTestResponse("0xc", storage.OperatorResponseAccepted, "bad", 300*time.Millisecond),
+			newTestResponse("0xd", storage.OperatorResponseFailed, "", 0),
+		}, certified)
+
+		// task 2: consensus is reached before b answers, c's signature fails, d is still unreachable
+		sb.Record(1, []*storage.OperatorResponse{
+			newTestResponse("0xa", storage.OperatorResponseAccepted, "good", 300*time.Millisecond),
+			newTestResponse("0xb", storage.OperatorResponseLate, "", 0),
+			newTestResponse("0xc", storage.OperatorResponseRejected, "good", 100*time.Millisecond),
+			newTestResponse("0xd", storage.OperatorResponseFailed, "", 0),
+		}, certified)
+
+		// task 3: no certificate, so operators that did not answer count as missed
+		sb.Record(1, []*storage.OperatorResponse{
+			newTestResponse("0xa", storage.OperatorResponseLate, "", 0),
+			newTestResponse("0xb", storage.OperatorResponseLate, "", 0),
+		}, "")
+
+		scores := sb.Scores(nil)
+		require.Len(t, scores, 4)
+		byOperator := map[string]OperatorScore{}
+		for _, score := range scores {
+			byOperator[score.OperatorAddress] = score
+		}
+
+		a := byOperator["0xA"]
+		assert.Equal(t, uint64(3), a.TasksAssigned)
+		assert.Equal(t, uint64(2), a.Responses)
+		assert.Equal(t, uint64(1), a.Misses)
+		assert.Equal(t, uint64(1), a.ConsecutiveMisses)
+		assert.InDelta(t, 2.0/3.0, a.ResponseRate, 0.001)
+		assert.Equal(t, 100*time.Millisecond, a.MedianLatency)
+		assert.Equal(t, 300*time.Millisecond, a.P99Latency)
+		assert.False(t, a.LastResponseAt.IsZero())
+
+		b := byOperator["0xb"]
+		assert.Equal(t, uint64(1), b.Late)
+		assert.Equal(t, uint64(1), b.Misses)
+		assert.InDelta(t, 0.5, b.ResponseRate, 0.001)
+
+		c := byOperator["0xc"]
+		assert.Equal(t, uint64(2), c.Responses)
+		assert.Equal(t, uint64(1), c.DigestDisagreements)
+		assert.Equal(t, uint64(1), c.SignatureFailures)
+		assert.Equal(t, uint64(0), c.ConsecutiveMisses)
+
+		d := byOperator["0xd"]
+		assert.Equal(t, uint64(0), d.Responses)
+		assert.Equal(t, uint64(2), d.ConsecutiveMisses)
+		assert.Equal(t, float64(0), d.ResponseRate)
+		assert.True(t, d.LastResponseAt.IsZero())
+	})
+
+	t.Run("keeps operator sets separate and filters by operator set", func(t *testing.T) {
+		sb := newOperatorScoreboard("0xavs")
+		sb.Record(1, []*storage.OperatorResponse{newTestResponse("0xa", storage.OperatorResponseFailed, "", 0)}, "")
+		sb.Record(2, []*storage.OperatorResponse{newTestResponse("0xa", storage.OperatorResponseAccepted, "out", time.Second)}, "")
+
+		scores := sb.Scores(nil)
+		require.Len(t, scores, 2)
+		assert.Equal(t, uint32(1), scores[0].OperatorSetId)
+		assert.Equal(t, uint64(1), scores[0].ConsecutiveMisses)
+		assert.Equal(t, uint32(2), scores[1].OperatorSetId)
+		assert.Equal(t, uint64(0), scores[1].ConsecutiveMisses)
+
+		opsetId := uint32(2)
+		scores = sb.Scores(&opsetId)
+		require.Len(t, scores, 1)
+		assert.Equal(t, uint64(1), scores[0].Responses)
+	})
+
+	t.Run("bounds the latency samples used for percentiles", func(t *testing.T) {
+		sb := newOperatorScoreboard("0xavs")
+		for i := 0; i < scoreboardLatencySamples+100; i++ {
+			sb.Record(1, []*storage.OperatorResponse{
+				newTestResponse("0xa", storage.OperatorResponseAccepted, fmt.Sprintf("out-%d", i), time.Duration(i+1)*time.Millisecond),
+			}, "")
+		}
+
+		scores := sb.Scores(nil)
+		require.Len(t, scores, 1)
+		assert.Equal(t, uint64(scoreboardLatencySamples+100), scores[0].Responses)
+		// the oldest 100 samples have been evicted
+		assert.Equal(t, time.Duration(600)*time.Millisecond, scores[0].MedianLatency)
+		assert.Equal(t, time.Duration(1090)*time.Millisecond, scores[0].P99Latency)
+	})
+}
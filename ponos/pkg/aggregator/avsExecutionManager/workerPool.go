@@ -0,0 +1,106 @@
+package avsExecutionManager
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+)
+
+// taskWorkerPool runs tasks concurrently, bounded by a global worker limit and a
+// per-operator-set limit. Which task runs next is decided by the caller; the pool
+// only reports whether an operator set has room for another task.
+type taskWorkerPool struct {
+	avsAddress        string
+	maxWorkers        int
+	maxPerOperatorSet int
+	handler           func(ctx context.Context, task *types.Task)
+
+	mu             sync.Mutex
+	running        int
+	runningByOpset map[uint32]int
+
+	// released is closed and replaced every time capacity is freed
+	released chan struct{}
+}
+
+func newTaskWorkerPool(avsAddress string, maxWorkers, maxPerOperatorSet int, handler func(ctx context.Context, task *types.Task)) *taskWorkerPool {
+	if maxWorkers <= 0 {
+		maxWorkers = 1
+	}
+	if maxPerOperatorSet <= 0 || maxPerOperatorSet > maxWorkers {
+		maxPerOperatorSet = maxWorkers
+	}
+	metrics.AggregatorTaskWorkers.WithLabelValues(avsAddress).Set(float64(maxWorkers))
+	return &taskWorkerPool{
+		avsAddress:        avsAddress,
+		maxWorkers:        maxWorkers,
+		maxPerOperatorSet: maxPerOperatorSet,
+		handler:           handler,
+		runningByOpset:    make(map[uint32]int),
+		released:          make(chan struct{}),
+	}
+}
+
+// HasCapacity reports whether a task for the operator set would start right away
+func (p *taskWorkerPool) HasCapacity(operatorSetId uint32) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.hasCapacityLocked(operatorSetId)
+}
+
+// TryStart runs the task if the pool has room for its operator set and reports
+// whether it did. ctx is passed through to the task handler.
+func (p *taskWorkerPool) TryStart(ctx context.Context, task *types.Task) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.hasCapacityLocked(task.OperatorSetId) {
+		return false
+	}
+	p.running++
+	p.runningByOpset[task.OperatorSetId]++
+	p.exportOperatorSetMetrics(task.OperatorSetId)
+
+	go func() {
+		defer p.release(task)
+		p.handler(ctx, task)
+	}()
+	return true
+}
+
+// Released returns a channel that is closed the next time a running task finishes
+func (p *taskWorkerPool) Released() <-chan struct{} {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.released
+}
+
+func (p *taskWorkerPool) hasCapacityLocked(operatorSetId uint32) bool {
+	return p.running < p.maxWorkers && p.runningByOpset[operatorSetId] < p.maxPerOperatorSet
+}
+
+func (p *taskWorkerPool) release(task *types.Task) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.running--
+	p.runningByOpset[task.OperatorSetId]--
+	if p.runningByOpset[task.OperatorSetId] <= 0 {
+		delete(p.runningByOpset, task.OperatorSetId)
+	}
+	p.exportOperatorSetMetrics(task.OperatorSetId)
+
+	close(p.released)
+	p.released = make(chan struct{})
+}
+
+// exportOperatorSetMetrics publishes how many tasks of the operator set are running. It must
+// be called with mu held.
+func (p *taskWorkerPool) exportOperatorSetMetrics(operatorSetId uint32) {
+	metrics.AggregatorOperatorSetTasksInFlight.
+		WithLabelValues(p.avsAddress, strconv.FormatUint(uint64(operatorSetId), 10)).
+		Set(float64(p.runningByOpset[operatorSetId]))
+}
@@ -0,0 +1,83 @@
+package avsExecutionManager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type blockingHandler struct {
+	mu      sync.Mutex
+	started []string
+	release chan struct{}
+}
+
+func newBlockingHandler() *blockingHandler {
+	return &blockingHandler{release: make(chan struct{})}
+}
+
+func (h *blockingHandler) handle(ctx context.Context, task *types.Task) {
+	h.mu.Lock()
+	h.started = append(h.started, task.TaskId)
+	h.mu.Unlock()
+	<-h.release
+}
+
+func (h *blockingHandler) startedTasks() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]string{}, h.started...)
+}
+
+func newTestTask(id string, operatorSetId uint32) *types.Task {
+	return &types.Task{TaskId: id, OperatorSetId: operatorSetId}
+}
+
+func Test_TaskWorkerPool(t *testing.T) {
+	t.Run("runs tasks concurrently up to the worker limit", func(t *testing.T) {
+		h := newBlockingHandler()
+		pool := newTaskWorkerPool("0xavs", 3, 3, h.handle)
+
+		for i := 0; i < 3; i++ {
+			require.True(t, pool.TryStart(context.Background(), newTestTask(fmt.Sprintf("task-%d", i), uint32(i))))
+		}
+		assert.Eventually(t, func() bool { return len(h.startedTasks()) == 3 }, time.Second, 10*time.Millisecond)
+
+		assert.False(t, pool.HasCapacity(3))
+		assert.False(t, pool.TryStart(context.Background(), newTestTask("task-3", 3)))
+
+		released := pool.Released()
+		close(h.release)
+		select {
+		case <-released:
+		case <-time.After(time.Second):
+			t.Fatal("released should be closed once a task finishes")
+		}
+		assert.Eventually(t, func() bool { return pool.HasCapacity(3) }, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("limits tasks per operator set without blocking others", func(t *testing.T) {
+		h := newBlockingHandler()
+		pool := newTaskWorkerPool("0xavs", 4, 1, h.handle)
+
+		require.True(t, pool.TryStart(context.Background(), newTestTask("opset1-a", 1)))
+		assert.False(t, pool.TryStart(context.Background(), newTestTask("opset1-b", 1)))
+		require.True(t, pool.TryStart(context.Background(), newTestTask("opset2-a", 2)))
+
+		assert.Eventually(t, func() bool { return len(h.startedTasks()) == 2 }, time.Second, 10*time.Millisecond)
+		assert.ElementsMatch(t, []string{"opset1-a", "opset2-a"}, h.startedTasks())
+
+		assert.False(t, pool.HasCapacity(1))
+		assert.False(t, pool.HasCapacity(2))
+		assert.True(t, pool.HasCapacity(3))
+
+		close(h.release)
+		assert.Eventually(t, func() bool { return pool.HasCapacity(1) }, time.Second, 10*time.Millisecond)
+	})
+}
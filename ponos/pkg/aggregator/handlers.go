@@ -2,10 +2,21 @@ package aggregator
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	aggregatorV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/aggregator"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/aggregatorConfig"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/avsExecutionManager"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/auth"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/chainPoller/EVMChainPoller"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/taskSource"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/util"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -79,3 +90,564 @@ func (a *Aggregator) GetChallengeToken(ctx context.Context, request *aggregatorV
 		ExpiresAt:      entry.ExpiresAt.Unix(),
 	}, nil
 }
+
+// GetTask returns a single task along with its outcome
+func (a *Aggregator) GetTask(ctx context.Context, request *aggregatorV1.GetTaskRequest) (*aggregatorV1.GetTaskResponse, error) {
+	a.logger.Sugar().Infow("GetTask called",
+		zap.String("taskId", request.TaskId),
+	)
+
+	// Verify authentication
+	if err := auth.HandleAuthError(a.verifyAuth(request.Auth)); err != nil {
+		return nil, err
+	}
+
+	if request.TaskId == "" {
+		return nil, status.Error(codes.InvalidArgument, "task ID is required")
+	}
+
+	record, err := a.store.GetTaskRecord(ctx, request.TaskId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "task %s not found", request.TaskId)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get task: %v", err)
+	}
+
+	task := taskRecordToProto(record)
+
+	cert, err := a.store.GetTaskCertificate(ctx, request.TaskId)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, status.Errorf(codes.Internal, "failed to get task certificate: %v", err)
+	}
+	if cert != nil {
+		task.Certificate = taskCertificateToProto(cert)
+	}
+
+	responses, err := a.store.ListOperatorResponses(ctx, request.TaskId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list operator responses: %v", err)
+	}
+
+	return &aggregatorV1.GetTaskResponse{
+		Task: task,
+		Responses: util.Map(responses, func(response *storage.OperatorResponse, i uint64) *aggregatorV1.AggregatorOperatorResponse {
+			return operatorResponseToProto(response)
+		}),
+	}, nil
+}
+
+// ListTasks returns a page of tasks matching the request filters
+func (a *Aggregator) ListTasks(ctx context.Context, request *aggregatorV1.ListTasksRequest) (*aggregatorV1.ListTasksResponse, error) {
+	a.logger.Sugar().Infow("ListTasks called",
+		zap.String("avsAddress", request.AvsAddress),
+		zap.Strings("statuses", request.Statuses),
+		zap.Uint32("chainId", request.ChainId),
+	)
+
+	// Verify authentication
+	if err := auth.HandleAuthError(a.verifyAuth(request.Auth)); err != nil {
+		return nil, err
+	}
+
+	filter, err := taskFilterFromRequest(request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := a.store.ListTasks(ctx, filter)
+	if err != nil {
+		if errors.Is(err, storage.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list tasks: %v", err)
+	}
+
+	return &aggregatorV1.ListTasksResponse{
+		Tasks: util.Map(page.Tasks, func(record *storage.TaskRecord, i uint64) *aggregatorV1.AggregatorTask {
+			return taskRecordToProto(record)
+		}),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+// GetOperatorScoreboard returns liveness and performance scores for operators tasks were broadcast to
+func (a *Aggregator) GetOperatorScoreboard(ctx context.Context, request *aggregatorV1.GetOperatorScoreboardRequest) (*aggregatorV1.GetOperatorScoreboardResponse, error) {
+	a.logger.Sugar().Infow("GetOperatorScoreboard called",
+		zap.String("avsAddress", request.AvsAddress),
+	)
+
+	// Verify authentication
+	if err := auth.HandleAuthError(a.verifyAuth(request.Auth)); err != nil {
+		return nil, err
+	}
+
+	managers, err := a.selectAvsManagers(request.AvsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &aggregatorV1.GetOperatorScoreboardResponse{}
+	for _, info := range managers {
+		for _, score := range info.ExecutionManager.GetOperatorScores(request.OperatorSetId) {
+			response.Scores = append(response.Scores, operatorScoreToProto(info.Address, score))
+		}
+	}
+	return response, nil
+}
+
+// GetRecoveryStatus returns the progress of pending task recovery after a restart
+func (a *Aggregator) GetRecoveryStatus(ctx context.Context, request *aggregatorV1.GetRecoveryStatusRequest) (*aggregatorV1.GetRecoveryStatusResponse, error) {
+	a.logger.Sugar().Infow("GetRecoveryStatus called",
+		zap.String("avsAddress", request.AvsAddress),
+	)
+
+	// Verify authentication
+	if err := auth.HandleAuthError(a.verifyAuth(request.Auth)); err != nil {
+		return nil, err
+	}
+
+	managers, err := a.selectAvsManagers(request.AvsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &aggregatorV1.GetRecoveryStatusResponse{}
+	for _, info := range managers {
+		response.Statuses = append(response.Statuses, recoveryStatsToProto(info.Address, info.ExecutionManager.GetRecoveryStats()))
+	}
+	return response, nil
+}
+
+// ReplayTasks re-ingests the TaskCreated events of a block range, skipping tasks that already completed
+func (a *Aggregator) ReplayTasks(ctx context.Context, request *aggregatorV1.ReplayTasksRequest) (*aggregatorV1.ReplayTasksResponse, error) {
+	a.logger.Sugar().Infow("ReplayTasks called",
+		zap.String("avsAddress", request.AvsAddress),
+		zap.Uint32("chainId", request.ChainId),
+		zap.Uint64("fromBlock", request.FromBlock),
+		zap.Uint64("toBlock", request.ToBlock),
+		zap.Bool("dryRun", request.DryRun),
+	)
+
+	// Verify authentication
+	if err := auth.HandleAuthError(a.verifyAuth(request.Auth)); err != nil {
+		return nil, err
+	}
+
+	if request.AvsAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "AVS address is required")
+	}
+	if request.FromBlock > request.ToBlock {
+		return nil, status.Errorf(codes.InvalidArgument, "from block %d is after to block %d", request.FromBlock, request.ToBlock)
+	}
+	if request.IgnoreDeadlines && !request.DryRun {
+		return nil, status.Error(codes.InvalidArgument, "deadlines can only be ignored on a dry run")
+	}
+
+	poller, err := a.selectChainPoller(request.AvsAddress, request.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	replayed, err := poller.Replay(ctx, &EVMChainPoller.ReplayOptions{
+		FromBlock:       request.FromBlock,
+		ToBlock:         request.ToBlock,
+		DryRun:          request.DryRun,
+		IgnoreDeadlines: request.IgnoreDeadlines,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to replay tasks: %v", err)
+	}
+
+	response := &aggregatorV1.ReplayTasksResponse{}
+	for _, task := range replayed {
+		response.Tasks = append(response.Tasks, &aggregatorV1.AggregatorReplayedTask{
+			TaskId:      task.TaskId,
+			BlockNumber: task.BlockNumber,
+			Outcome:     string(task.Outcome),
+		})
+		switch task.Outcome {
+		case EVMChainPoller.ReplayOutcomeReplayed:
+			response.Replayed++
+		case EVMChainPoller.ReplayOutcomeCompleted:
+			response.SkippedCompleted++
+		case EVMChainPoller.ReplayOutcomeInProgress:
+			response.SkippedInProgress++
+		case EVMChainPoller.ReplayOutcomeExpired:
+			response.SkippedExpired++
+		}
+	}
+	return response, nil
+}
+
+// SubmitTask queues an off-chain task for an AVS that accepts submitted tasks
+func (a *Aggregator) SubmitTask(ctx context.Context, request *aggregatorV1.SubmitTaskRequest) (*aggregatorV1.SubmitTaskResponse, error) {
+	a.logger.Sugar().Infow("SubmitTask called",
+		zap.String("avsAddress", request.AvsAddress),
+		zap.Uint32("operatorSetId", request.OperatorSetId),
+		zap.Uint32("chainId", request.ChainId),
+	)
+
+	// Verify authentication
+	if err := auth.HandleAuthError(a.verifyAuth(request.Auth)); err != nil {
+		return nil, err
+	}
+
+	if request.AvsAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "AVS address is required")
+	}
+
+	managers, err := a.selectAvsManagers(request.AvsAddress)
+	if err != nil {
+		return nil, err
+	}
+	submitSource := managers[0].SubmitSource
+	if submitSource == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "AVS %s does not accept submitted tasks", request.AvsAddress)
+	}
+
+	taskRequest := &taskSource.TaskRequest{
+		OperatorSetId:        request.OperatorSetId,
+		Payload:              request.Payload,
+		ChainId:              config.ChainId(request.ChainId),
+		ReferenceBlockNumber: request.ReferenceBlockNumber,
+		IdempotencyKey:       request.IdempotencyKey,
+	}
+	if request.Deadline != 0 {
+		taskRequest.Deadline = time.Unix(request.Deadline, 0)
+	}
+
+	task, err := submitSource.Submit(taskRequest)
+	switch {
+	case errors.Is(err, taskSource.ErrInvalidTask):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, taskSource.ErrNoReferenceBlock):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, taskSource.ErrQueueFull):
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, taskSource.ErrNotStarted):
+		return nil, status.Error(codes.Unavailable, err.Error())
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed to submit task: %v", err)
+	}
+
+	response := &aggregatorV1.SubmitTaskResponse{
+		TaskId:               task.TaskId,
+		ChainId:              uint32(task.ChainId),
+		ReferenceBlockNumber: task.SourceBlockNumber,
+	}
+	if task.DeadlineUnixSeconds != nil {
+		response.Deadline = task.DeadlineUnixSeconds.Unix()
+	}
+	return response, nil
+}
+
+// GetCheckpoints returns the last block each chain poller processed
+func (a *Aggregator) GetCheckpoints(ctx context.Context, request *aggregatorV1.GetCheckpointsRequest) (*aggregatorV1.GetCheckpointsResponse, error) {
+	a.logger.Sugar().Infow("GetCheckpoints called",
+		zap.String("avsAddress", request.AvsAddress),
+		zap.Uint32("chainId", request.ChainId),
+	)
+
+	// Verify authentication
+	if err := auth.HandleAuthError(a.verifyAuth(request.Auth)); err != nil {
+		return nil, err
+	}
+
+	managers, err := a.selectAvsManagers(request.AvsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &aggregatorV1.GetCheckpointsResponse{}
+	for _, info := range managers {
+		chainIds := make([]config.ChainId, 0, len(info.ChainPollers))
+		for chainId := range info.ChainPollers {
+			if request.ChainId == 0 || chainId == config.ChainId(request.ChainId) {
+				chainIds = append(chainIds, chainId)
+			}
+		}
+		sort.Slice(chainIds, func(i, j int) bool { return chainIds[i] < chainIds[j] })
+
+		for _, chainId := range chainIds {
+			checkpoint, err := info.ChainPollers[chainId].GetCheckpoint(ctx)
+			if errors.Is(err, storage.ErrNotFound) {
+				// the poller has not processed a block yet
+				continue
+			}
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get checkpoint of AVS %s on chain %d: %v", info.Address, chainId, err)
+			}
+			response.Checkpoints = append(response.Checkpoints, checkpointToProto(info.Address, checkpoint.LastProcessedBlock, checkpoint.IngestionHead))
+		}
+	}
+	return response, nil
+}
+
+// RewindCheckpoint moves a chain poller back so that it processes the blocks after the requested block again
+func (a *Aggregator) RewindCheckpoint(ctx context.Context, request *aggregatorV1.RewindCheckpointRequest) (*aggregatorV1.MoveCheckpointResponse, error) {
+	a.logger.Sugar().Infow("RewindCheckpoint called",
+		zap.String("avsAddress", request.AvsAddress),
+		zap.Uint32("chainId", request.ChainId),
+		zap.Uint64("blockNumber", request.BlockNumber),
+	)
+
+	// Verify authentication
+	if err := auth.HandleAuthError(a.verifyAuth(request.Auth)); err != nil {
+		return nil, err
+	}
+
+	if request.AvsAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "AVS address is required")
+	}
+	if request.BlockNumber == 0 {
+		return nil, status.Error(codes.InvalidArgument, "block number is required")
+	}
+
+	poller, err := a.selectChainPoller(request.AvsAddress, request.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	move, err := poller.Rewind(ctx, request.BlockNumber)
+	if err != nil {
+		return nil, checkpointMoveError(err)
+	}
+	return checkpointMoveToProto(request.AvsAddress, move), nil
+}
+
+// FastForwardCheckpoint moves a chain poller to the chain head, skipping the blocks in between
+func (a *Aggregator) FastForwardCheckpoint(ctx context.Context, request *aggregatorV1.FastForwardCheckpointRequest) (*aggregatorV1.MoveCheckpointResponse, error) {
+	a.logger.Sugar().Infow("FastForwardCheckpoint called",
+		zap.String("avsAddress", request.AvsAddress),
+		zap.Uint32("chainId", request.ChainId),
+	)
+
+	// Verify authentication
+	if err := auth.HandleAuthError(a.verifyAuth(request.Auth)); err != nil {
+		return nil, err
+	}
+
+	if request.AvsAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "AVS address is required")
+	}
+
+	poller, err := a.selectChainPoller(request.AvsAddress, request.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	move, err := poller.FastForward(ctx)
+	if err != nil {
+		return nil, checkpointMoveError(err)
+	}
+	return checkpointMoveToProto(request.AvsAddress, move), nil
+}
+
+func checkpointMoveError(err error) error {
+	switch {
+	case errors.Is(err, EVMChainPoller.ErrInvalidCheckpoint):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, storage.ErrNotFound):
+		return status.Errorf(codes.FailedPrecondition, "the poller has not processed a block yet: %v", err)
+	default:
+		return status.Errorf(codes.Internal, "failed to move checkpoint: %v", err)
+	}
+}
+
+// selectChainPoller returns the poller of the registered AVS on the chain
+func (a *Aggregator) selectChainPoller(avsAddress string, chainId uint32) (*EVMChainPoller.EVMChainPoller, error) {
+	managers, err := a.selectAvsManagers(avsAddress)
+	if err != nil {
+		return nil, err
+	}
+	poller, ok := managers[0].ChainPollers[config.ChainId(chainId)]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "AVS %s does not use chain %d", avsAddress, chainId)
+	}
+	return poller, nil
+}
+
+// selectAvsManagers returns the managers of the registered AVS matching avsAddress,
+// or of every registered AVS if it is empty, sorted by address
+func (a *Aggregator) selectAvsManagers(avsAddress string) ([]*AvsExecutionManagerInfo, error) {
+	a.avsMutex.RLock()
+	managers := make([]*AvsExecutionManagerInfo, 0, len(a.avsManagers))
+	for _, info := range a.avsManagers {
+		if avsAddress == "" || strings.EqualFold(info.Address, avsAddress) {
+			managers = append(managers, info)
+		}
+	}
+	a.avsMutex.RUnlock()
+
+	if avsAddress != "" && len(managers) == 0 {
+		return nil, status.Errorf(codes.NotFound, "AVS %s is not registered", avsAddress)
+	}
+	sort.Slice(managers, func(i, j int) bool {
+		return managers[i].Address < managers[j].Address
+	})
+	return managers, nil
+}
+
+func taskFilterFromRequest(request *aggregatorV1.ListTasksRequest) (*storage.TaskFilter, error) {
+	filter := &storage.TaskFilter{
+		AvsAddress:    request.AvsAddress,
+		OperatorSetId: request.OperatorSetId,
+		ChainId:       config.ChainId(request.ChainId),
+		PageSize:      int(request.PageSize),
+		PageToken:     request.PageToken,
+	}
+	for _, s := range request.Statuses {
+		taskStatus := storage.TaskStatus(strings.ToLower(s))
+		switch taskStatus {
+		case storage.TaskStatusPending, storage.TaskStatusProcessing, storage.TaskStatusCompleted, storage.TaskStatusFailed:
+			filter.Statuses = append(filter.Statuses, taskStatus)
+		default:
+			return nil, fmt.Errorf("unknown task status: %s", s)
+		}
+	}
+	if request.CreatedAfter > 0 {
+		filter.CreatedAfter = time.Unix(request.CreatedAfter, 0)
+	}
+	if request.CreatedBefore > 0 {
+		filter.CreatedBefore = time.Unix(request.CreatedBefore, 0)
+	}
+	if !filter.CreatedAfter.IsZero() && !filter.CreatedBefore.IsZero() && !filter.CreatedAfter.Before(filter.CreatedBefore) {
+		return nil, fmt.Errorf("createdAfter must be before createdBefore")
+	}
+	return filter, nil
+}
+
+func taskRecordToProto(record *storage.TaskRecord) *aggregatorV1.AggregatorTask {
+	task := record.Task
+	pt := &aggregatorV1.AggregatorTask{
+		TaskId:            task.TaskId,
+		AvsAddress:        task.AVSAddress,
+		OperatorSetId:     task.OperatorSetId,
+		ChainId:           uint32(task.ChainId),
+		Status:            string(record.Status),
+		SourceBlockNumber: task.SourceBlockNumber,
+		BlockHash:         task.BlockHash,
+		Payload:           task.Payload,
+		CreatedAt:         record.CreatedAt.Unix(),
+		UpdatedAt:         record.UpdatedAt.Unix(),
+		Source:            task.Source,
+	}
+	if pt.Source == "" {
+		pt.Source = types.TaskSourceChain
+	}
+	if task.DeadlineUnixSeconds != nil {
+		pt.Deadline = task.DeadlineUnixSeconds.Unix()
+	}
+	if outcome := record.Outcome; outcome != nil {
+		pt.RespondingOperators = outcome.RespondingOperators
+		pt.TaskResponseDigest = outcome.TaskResponseDigest
+		pt.SubmissionTxHash = outcome.SubmissionTxHash
+		pt.Error = outcome.Error
+	}
+	return pt
+}
+
+func checkpointToProto(avsAddress string, block *storage.BlockRecord, ingestionHead uint64) *aggregatorV1.AggregatorCheckpoint {
+	pc := &aggregatorV1.AggregatorCheckpoint{
+		AvsAddress:     avsAddress,
+		ChainId:        uint32(block.ChainId),
+		BlockNumber:    block.Number,
+		BlockHash:      block.Hash,
+		BlockTimestamp: int64(block.Timestamp),
+		IngestionHead:  ingestionHead,
+	}
+	if ingestionHead > block.Number {
+		pc.LagBlocks = ingestionHead - block.Number
+	}
+	return pc
+}
+
+func checkpointMoveToProto(avsAddress string, move *EVMChainPoller.CheckpointMove) *aggregatorV1.MoveCheckpointResponse {
+	return &aggregatorV1.MoveCheckpointResponse{
+		Previous: checkpointToProto(avsAddress, move.Previous, move.IngestionHead),
+		Current:  checkpointToProto(avsAddress, move.Current, move.IngestionHead),
+	}
+}
+
+func taskCertificateToProto(cert *storage.TaskCertificate) *aggregatorV1.AggregatorTaskCertificate {
+	pc := &aggregatorV1.AggregatorTaskCertificate{
+		CurveType:          cert.CurveType.String(),
+		TaskResponse:       cert.TaskResponse,
+		TaskResponseDigest: cert.TaskResponseDigest,
+		SignedAt:           unixOrZero(cert.SignedAt),
+		ReceiptHash:        cert.ReceiptHash,
+		SubmittedAt:        unixOrZero(cert.SubmittedAt),
+	}
+	if cert.BN254 != nil {
+		pc.SignersSignature = cert.BN254.SignersSignature
+		pc.SignersPublicKey = cert.BN254.SignersPublicKey
+		pc.NonSignerOperators = util.Map(cert.BN254.NonSignerOperators, func(op storage.CertificateOperator, i uint64) *aggregatorV1.AggregatorCertificateOperator {
+			return &aggregatorV1.AggregatorCertificateOperator{
+				OperatorAddress: op.OperatorAddress,
+				OperatorIndex:   op.OperatorIndex,
+				PublicKey:       op.PublicKey,
+			}
+		})
+	}
+	if cert.ECDSA != nil {
+		pc.SignersSignatures = cert.ECDSA.SignersSignatures
+	}
+	return pc
+}
+
+func operatorResponseToProto(response *storage.OperatorResponse) *aggregatorV1.AggregatorOperatorResponse {
+	pr := &aggregatorV1.AggregatorOperatorResponse{
+		OperatorAddress: response.OperatorAddress,
+		Status:          string(response.Status),
+		OutputDigest:    response.OutputDigest,
+		ReceivedAt:      unixOrZero(response.ReceivedAt),
+		LatencyMs:       response.Latency.Milliseconds(),
+		Error:           response.Error,
+	}
+	if result := response.Result; result != nil {
+		pr.Output = result.Output
+		pr.ResultSignature = result.ResultSignature
+		pr.AuthSignature = result.AuthSignature
+	}
+	return pr
+}
+
+// unixOrZero converts a time to a unix timestamp, leaving unset times as zero
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func operatorScoreToProto(avsAddress string, score avsExecutionManager.OperatorScore) *aggregatorV1.AggregatorOperatorScore {
+	return &aggregatorV1.AggregatorOperatorScore{
+		AvsAddress:          avsAddress,
+		OperatorSetId:       score.OperatorSetId,
+		OperatorAddress:     score.OperatorAddress,
+		TasksAssigned:       score.TasksAssigned,
+		Responses:           score.Responses,
+		Late:                score.Late,
+		Misses:              score.Misses,
+		SignatureFailures:   score.SignatureFailures,
+		DigestDisagreements: score.DigestDisagreements,
+		ConsecutiveMisses:   score.ConsecutiveMisses,
+		ResponseRate:        score.ResponseRate,
+		MedianLatencyMs:     score.MedianLatency.Milliseconds(),
+		P99LatencyMs:        score.P99Latency.Milliseconds(),
+		LastResponseAt:      unixOrZero(score.LastResponseAt),
+	}
+}
+
+func recoveryStatsToProto(avsAddress string, stats avsExecutionManager.RecoveryStats) *aggregatorV1.AggregatorRecoveryStatus {
+	return &aggregatorV1.AggregatorRecoveryStatus{
+		AvsAddress:  avsAddress,
+		State:       string(stats.State),
+		StartedAt:   unixOrZero(stats.StartedAt),
+		CompletedAt: unixOrZero(stats.CompletedAt),
+		Pending:     uint64(stats.Pending),
+		Recovered:   uint64(stats.Recovered),
+		Skipped:     uint64(stats.Skipped),
+		Expired:     uint64(stats.Expired),
+		Error:       stats.Error,
+	}
+}
@@ -0,0 +1,517 @@
+package aggregator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	cryptoLibsEcdsa "github.com/Layr-Labs/crypto-libs/pkg/ecdsa"
+	aggregatorV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/aggregator"
+	commonV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/common"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage/memory"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/auth"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/chainPoller/EVMChainPoller"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/logger"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/signer/inMemorySigner"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/taskSource"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testHandlersAvsAddress       = "0x0000000000000000000000000000000000000a11"
+	testHandlersClosedAvsAddress = "0x0000000000000000000000000000000000000b22"
+	testHandlersAggregator       = "0xTestAggregator"
+)
+
+// newTestHandlersAggregator returns an aggregator with an in-memory store and two registered
+// AVSs: one that accepts submitted tasks into a queue with room for one task, and one that
+// does not accept submitted tasks. Neither has chain pollers.
+func newTestHandlersAggregator(t *testing.T) *Aggregator {
+	l, _ := logger.NewLogger(&logger.LoggerConfig{Debug: false})
+	store := memory.NewInMemoryAggregatorStore()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	sink := taskSource.NewTaskSink(&taskSource.TaskSinkConfig{
+		AvsAddress:     testHandlersAvsAddress,
+		ChainIds:       []config.ChainId{config.ChainId_EthereumAnvil},
+		DefaultChainId: config.ChainId_EthereumAnvil,
+	}, store, make(chan *types.Task, 1), l)
+	submitSource := taskSource.NewSubmitSource(sink)
+	require.NoError(t, submitSource.Start(ctx))
+
+	return &Aggregator{
+		logger: l,
+		store:  store,
+		avsManagers: map[string]*AvsExecutionManagerInfo{
+			testHandlersAvsAddress: {
+				Address:      testHandlersAvsAddress,
+				ChainPollers: map[config.ChainId]*EVMChainPoller.EVMChainPoller{},
+				SubmitSource: submitSource,
+			},
+			testHandlersClosedAvsAddress: {
+				Address:      testHandlersClosedAvsAddress,
+				ChainPollers: map[config.ChainId]*EVMChainPoller.EVMChainPoller{},
+			},
+		},
+	}
+}
+
+func requireStatusCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if code == codes.OK {
+		require.NoError(t, err)
+		return
+	}
+	require.Error(t, err)
+	statusErr, ok := status.FromError(err)
+	require.True(t, ok, "expected a gRPC status error, got %v", err)
+	assert.Equal(t, code, statusErr.Code(), statusErr.Message())
+}
+
+func TestHandlers_GetTask(t *testing.T) {
+	agg := newTestHandlersAggregator(t)
+	deadline := time.Now().Add(time.Hour)
+	require.NoError(t, agg.store.SavePendingTask(context.Background(), &types.Task{
+		TaskId:              "0xtask",
+		AVSAddress:          testHandlersAvsAddress,
+		ChainId:             config.ChainId_EthereumAnvil,
+		DeadlineUnixSeconds: &deadline,
+	}))
+
+	tests := []struct {
+		name   string
+		taskId string
+		code   codes.Code
+	}{
+		{name: "task ID is required", taskId: "", code: codes.InvalidArgument},
+		{name: "unknown task", taskId: "0xunknown", code: codes.NotFound},
+		{name: "stored task", taskId: "0xtask", code: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := agg.GetTask(context.Background(), &aggregatorV1.GetTaskRequest{TaskId: tt.taskId})
+			requireStatusCode(t, err, tt.code)
+			if tt.code == codes.OK {
+				assert.Equal(t, tt.taskId, res.Task.TaskId)
+				assert.Equal(t, string(storage.TaskStatusPending), res.Task.Status)
+				assert.Equal(t, types.TaskSourceChain, res.Task.Source)
+			}
+		})
+	}
+}
+
+func TestHandlers_ListTasks(t *testing.T) {
+	agg := newTestHandlersAggregator(t)
+
+	tests := []struct {
+		name    string
+		request *aggregatorV1.ListTasksRequest
+		code    codes.Code
+	}{
+		{name: "no filters", request: &aggregatorV1.ListTasksRequest{}, code: codes.OK},
+		{name: "unknown status", request: &aggregatorV1.ListTasksRequest{Statuses: []string{"pending", "stuck"}}, code: codes.InvalidArgument},
+		{name: "reversed time range", request: &aggregatorV1.ListTasksRequest{CreatedAfter: 200, CreatedBefore: 100}, code: codes.InvalidArgument},
+		{name: "invalid page token", request: &aggregatorV1.ListTasksRequest{PageToken: "not-a-token"}, code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := agg.ListTasks(context.Background(), tt.request)
+			requireStatusCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestTaskFilterFromRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *aggregatorV1.ListTasksRequest
+		want    *storage.TaskFilter
+		wantErr string
+	}{
+		{
+			name: "statuses are matched case-insensitively",
+			request: &aggregatorV1.ListTasksRequest{
+				AvsAddress: testHandlersAvsAddress,
+				Statuses:   []string{"Pending", "FAILED"},
+				PageSize:   10,
+			},
+			want: &storage.TaskFilter{
+				AvsAddress: testHandlersAvsAddress,
+				Statuses:   []storage.TaskStatus{storage.TaskStatusPending, storage.TaskStatusFailed},
+				PageSize:   10,
+			},
+		},
+		{
+			name:    "unknown status",
+			request: &aggregatorV1.ListTasksRequest{Statuses: []string{"stuck"}},
+			wantErr: "unknown task status: stuck",
+		},
+		{
+			name:    "reversed time range",
+			request: &aggregatorV1.ListTasksRequest{CreatedAfter: 200, CreatedBefore: 100},
+			wantErr: "createdAfter must be before createdBefore",
+		},
+		{
+			name:    "empty time range",
+			request: &aggregatorV1.ListTasksRequest{CreatedAfter: 100, CreatedBefore: 100},
+			wantErr: "createdAfter must be before createdBefore",
+		},
+		{
+			name:    "open time range",
+			request: &aggregatorV1.ListTasksRequest{CreatedAfter: 100},
+			want:    &storage.TaskFilter{CreatedAfter: time.Unix(100, 0)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := taskFilterFromRequest(tt.request)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, filter)
+		})
+	}
+}
+
+func TestHandlers_SelectAvs(t *testing.T) {
+	agg := newTestHandlersAggregator(t)
+	ctx := context.Background()
+	unknownAvs := "0x0000000000000000000000000000000000000c33"
+
+	tests := []struct {
+		name string
+		call func() error
+		code codes.Code
+	}{
+		{
+			name: "scoreboard of an unregistered AVS",
+			call: func() error {
+				_, err := agg.GetOperatorScoreboard(ctx, &aggregatorV1.GetOperatorScoreboardRequest{AvsAddress: unknownAvs})
+				return err
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "recovery status of an unregistered AVS",
+			call: func() error {
+				_, err := agg.GetRecoveryStatus(ctx, &aggregatorV1.GetRecoveryStatusRequest{AvsAddress: unknownAvs})
+				return err
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "checkpoints of an unregistered AVS",
+			call: func() error {
+				_, err := agg.GetCheckpoints(ctx, &aggregatorV1.GetCheckpointsRequest{AvsAddress: unknownAvs})
+				return err
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "checkpoints of an AVS without pollers",
+			call: func() error {
+				_, err := agg.GetCheckpoints(ctx, &aggregatorV1.GetCheckpointsRequest{AvsAddress: testHandlersAvsAddress})
+				return err
+			},
+			code: codes.OK,
+		},
+		{
+			name: "replay without an AVS",
+			call: func() error {
+				_, err := agg.ReplayTasks(ctx, &aggregatorV1.ReplayTasksRequest{FromBlock: 1, ToBlock: 2})
+				return err
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "replay of a reversed block range",
+			call: func() error {
+				_, err := agg.ReplayTasks(ctx, &aggregatorV1.ReplayTasksRequest{AvsAddress: testHandlersAvsAddress, FromBlock: 2, ToBlock: 1})
+				return err
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "replay ignoring deadlines outside a dry run",
+			call: func() error {
+				_, err := agg.ReplayTasks(ctx, &aggregatorV1.ReplayTasksRequest{AvsAddress: testHandlersAvsAddress, FromBlock: 1, ToBlock: 2, IgnoreDeadlines: true})
+				return err
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "replay of an unregistered AVS",
+			call: func() error {
+				_, err := agg.ReplayTasks(ctx, &aggregatorV1.ReplayTasksRequest{AvsAddress: unknownAvs, FromBlock: 1, ToBlock: 2})
+				return err
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "replay on a chain the AVS does not use",
+			call: func() error {
+				_, err := agg.ReplayTasks(ctx, &aggregatorV1.ReplayTasksRequest{AvsAddress: testHandlersAvsAddress, ChainId: 1, FromBlock: 1, ToBlock: 2})
+				return err
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "rewind without a block",
+			call: func() error {
+				_, err := agg.RewindCheckpoint(ctx, &aggregatorV1.RewindCheckpointRequest{AvsAddress: testHandlersAvsAddress, ChainId: 1})
+				return err
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "rewind on a chain the AVS does not use",
+			call: func() error {
+				_, err := agg.RewindCheckpoint(ctx, &aggregatorV1.RewindCheckpointRequest{AvsAddress: testHandlersAvsAddress, ChainId: 1, BlockNumber: 10})
+				return err
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "fast forward without an AVS",
+			call: func() error {
+				_, err := agg.FastForwardCheckpoint(ctx, &aggregatorV1.FastForwardCheckpointRequest{ChainId: 1})
+				return err
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "fast forward of an unregistered AVS",
+			call: func() error {
+				_, err := agg.FastForwardCheckpoint(ctx, &aggregatorV1.FastForwardCheckpointRequest{AvsAddress: unknownAvs, ChainId: 1})
+				return err
+			},
+			code: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireStatusCode(t, tt.call(), tt.code)
+		})
+	}
+}
+
+func TestCheckpointMoveError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{name: "invalid checkpoint", err: fmt.Errorf("block 10 is ahead: %w", EVMChainPoller.ErrInvalidCheckpoint), code: codes.FailedPrecondition},
+		{name: "no checkpoint yet", err: storage.ErrNotFound, code: codes.FailedPrecondition},
+		{name: "other error", err: errors.New("connection refused"), code: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireStatusCode(t, checkpointMoveError(tt.err), tt.code)
+		})
+	}
+}
+
+func TestHandlers_SubmitTask(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *aggregatorV1.SubmitTaskRequest
+		code    codes.Code
+	}{
+		{
+			name:    "AVS address is required",
+			request: &aggregatorV1.SubmitTaskRequest{Payload: []byte("payload"), ReferenceBlockNumber: 10},
+			code:    codes.InvalidArgument,
+		},
+		{
+			name:    "unregistered AVS",
+			request: &aggregatorV1.SubmitTaskRequest{AvsAddress: "0x0000000000000000000000000000000000000c33", Payload: []byte("payload")},
+			code:    codes.NotFound,
+		},
+		{
+			name:    "AVS that does not accept submitted tasks",
+			request: &aggregatorV1.SubmitTaskRequest{AvsAddress: testHandlersClosedAvsAddress, Payload: []byte("payload")},
+			code:    codes.FailedPrecondition,
+		},
+		{
+			name:    "task without a payload",
+			request: &aggregatorV1.SubmitTaskRequest{AvsAddress: testHandlersAvsAddress, ReferenceBlockNumber: 10},
+			code:    codes.InvalidArgument,
+		},
+		{
+			name:    "deadline in the past",
+			request: &aggregatorV1.SubmitTaskRequest{AvsAddress: testHandlersAvsAddress, Payload: []byte("payload"), ReferenceBlockNumber: 10, Deadline: time.Now().Add(-time.Minute).Unix()},
+			code:    codes.InvalidArgument,
+		},
+		{
+			name:    "no block processed to reference",
+			request: &aggregatorV1.SubmitTaskRequest{AvsAddress: testHandlersAvsAddress, Payload: []byte("payload")},
+			code:    codes.FailedPrecondition,
+		},
+		{
+			name:    "accepted task",
+			request: &aggregatorV1.SubmitTaskRequest{AvsAddress: testHandlersAvsAddress, Payload: []byte("payload"), ReferenceBlockNumber: 10},
+			code:    codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agg := newTestHandlersAggregator(t)
+			res, err := agg.SubmitTask(context.Background(), tt.request)
+			requireStatusCode(t, err, tt.code)
+			if tt.code == codes.OK {
+				assert.NotEmpty(t, res.TaskId)
+				assert.Equal(t, uint32(config.ChainId_EthereumAnvil), res.ChainId)
+				assert.Equal(t, uint64(10), res.ReferenceBlockNumber)
+			}
+		})
+	}
+
+	t.Run("full task queue", func(t *testing.T) {
+		agg := newTestHandlersAggregator(t)
+		request := &aggregatorV1.SubmitTaskRequest{AvsAddress: testHandlersAvsAddress, Payload: []byte("payload"), ReferenceBlockNumber: 10}
+
+		_, err := agg.SubmitTask(context.Background(), request)
+		require.NoError(t, err)
+		_, err = agg.SubmitTask(context.Background(), request)
+		requireStatusCode(t, err, codes.ResourceExhausted)
+	})
+
+	t.Run("stopped submit source", func(t *testing.T) {
+		agg := newTestHandlersAggregator(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.NoError(t, agg.avsManagers[testHandlersAvsAddress].SubmitSource.Start(ctx))
+
+		_, err := agg.SubmitTask(context.Background(), &aggregatorV1.SubmitTaskRequest{AvsAddress: testHandlersAvsAddress, Payload: []byte("payload"), ReferenceBlockNumber: 10})
+		requireStatusCode(t, err, codes.Unavailable)
+	})
+}
+
+func TestHandlers_RequireAuth(t *testing.T) {
+	agg := newTestHandlersAggregator(t)
+
+	privateKey, err := cryptoLibsEcdsa.NewPrivateKeyFromHexString("0x1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef")
+	require.NoError(t, err)
+	authSigner := inMemorySigner.NewInMemorySigner(privateKey, config.CurveTypeECDSA)
+	agg.authVerifier = auth.NewVerifier(auth.NewChallengeTokenManager(testHandlersAggregator, 5*time.Minute), authSigner)
+
+	// validAuth returns a signed challenge token, which can be used for a single request
+	validAuth := func(t *testing.T) *commonV1.AuthSignature {
+		entry, err := agg.authVerifier.GenerateChallengeToken(testHandlersAggregator)
+		require.NoError(t, err)
+		signature, err := authSigner.SignMessage(auth.ConstructSignedMessage(entry.Token))
+		require.NoError(t, err)
+		return &commonV1.AuthSignature{ChallengeToken: entry.Token, Signature: signature}
+	}
+
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		call func(auth *commonV1.AuthSignature) error
+		// authorizedCode is the code of the request once authenticated
+		authorizedCode codes.Code
+	}{
+		{
+			name: "GetTask",
+			call: func(auth *commonV1.AuthSignature) error {
+				_, err := agg.GetTask(ctx, &aggregatorV1.GetTaskRequest{TaskId: "0xunknown", Auth: auth})
+				return err
+			},
+			authorizedCode: codes.NotFound,
+		},
+		{
+			name: "ListTasks",
+			call: func(auth *commonV1.AuthSignature) error {
+				_, err := agg.ListTasks(ctx, &aggregatorV1.ListTasksRequest{Auth: auth})
+				return err
+			},
+			authorizedCode: codes.OK,
+		},
+		{
+			name: "GetOperatorScoreboard",
+			call: func(auth *commonV1.AuthSignature) error {
+				_, err := agg.GetOperatorScoreboard(ctx, &aggregatorV1.GetOperatorScoreboardRequest{AvsAddress: "0xunknown", Auth: auth})
+				return err
+			},
+			authorizedCode: codes.NotFound,
+		},
+		{
+			name: "GetRecoveryStatus",
+			call: func(auth *commonV1.AuthSignature) error {
+				_, err := agg.GetRecoveryStatus(ctx, &aggregatorV1.GetRecoveryStatusRequest{AvsAddress: "0xunknown", Auth: auth})
+				return err
+			},
+			authorizedCode: codes.NotFound,
+		},
+		{
+			name: "ReplayTasks",
+			call: func(auth *commonV1.AuthSignature) error {
+				_, err := agg.ReplayTasks(ctx, &aggregatorV1.ReplayTasksRequest{AvsAddress: testHandlersAvsAddress, ChainId: 1, FromBlock: 1, ToBlock: 2, Auth: auth})
+				return err
+			},
+			authorizedCode: codes.NotFound,
+		},
+		{
+			name: "SubmitTask",
+			call: func(auth *commonV1.AuthSignature) error {
+				_, err := agg.SubmitTask(ctx, &aggregatorV1.SubmitTaskRequest{AvsAddress: testHandlersClosedAvsAddress, Payload: []byte("payload"), Auth: auth})
+				return err
+			},
+			authorizedCode: codes.FailedPrecondition,
+		},
+		{
+			name: "GetCheckpoints",
+			call: func(auth *commonV1.AuthSignature) error {
+				_, err := agg.GetCheckpoints(ctx, &aggregatorV1.GetCheckpointsRequest{Auth: auth})
+				return err
+			},
+			authorizedCode: codes.OK,
+		},
+		{
+			name: "RewindCheckpoint",
+			call: func(auth *commonV1.AuthSignature) error {
+				_, err := agg.RewindCheckpoint(ctx, &aggregatorV1.RewindCheckpointRequest{AvsAddress: testHandlersAvsAddress, ChainId: 1, BlockNumber: 10, Auth: auth})
+				return err
+			},
+			authorizedCode: codes.NotFound,
+		},
+		{
+			name: "FastForwardCheckpoint",
+			call: func(auth *commonV1.AuthSignature) error {
+				_, err := agg.FastForwardCheckpoint(ctx, &aggregatorV1.FastForwardCheckpointRequest{AvsAddress: testHandlersAvsAddress, ChainId: 1, Auth: auth})
+				return err
+			},
+			authorizedCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireStatusCode(t, tt.call(nil), codes.Unauthenticated)
+			requireStatusCode(t, tt.call(&commonV1.AuthSignature{ChallengeToken: "unknown-token", Signature: []byte("signature")}), codes.Unauthenticated)
+
+			auth := validAuth(t)
+			requireStatusCode(t, tt.call(auth), tt.authorizedCode)
+			// challenge tokens are single use
+			requireStatusCode(t, tt.call(auth), codes.Unauthenticated)
+		})
+	}
+}
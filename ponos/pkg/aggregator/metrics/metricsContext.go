@@ -1,5 +0,0 @@
-package metrics
-
-type MetricsContext interface {
-	Emit(name string, value int)
-}
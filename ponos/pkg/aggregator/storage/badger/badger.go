@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -22,6 +23,11 @@ const (
 	prefixTaskByStatus = "taskstatus:%s:%s" // status:taskId
 	prefixBlock        = "block:%s:%d:%d"   // avsAddress:chainId:blockNumber
 	prefixLatestBlock  = "block:%s:%d"      // avsAddress:chainId
+
+	prefixTaskResponse    = "taskresponse:%s:%s" // taskId:operatorAddress
+	prefixTaskCertificate = "taskcert:%s"        // taskId
+	prefixReorgEvents     = "reorg:%s:%d:"       // avsAddress:chainId:
+	prefixReorgEvent      = "reorg:%s:%d:%020d"  // avsAddress:chainId:detectedAtUnixNano
 )
 
 // BadgerAggregatorStore implements the AggregatorStore interface using BadgerDB
@@ -160,9 +166,12 @@ func (s *BadgerAggregatorStore) SavePendingTask(ctx context.Context, task *types
 	}
 
 	// Create task record with status
+	now := time.Now()
 	record := &storage.TaskRecord{
-		Task:   task,
-		Status: storage.TaskStatusPending,
+		Task:      task,
+		Status:    storage.TaskStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
 	}
 
 	value, err := json.Marshal(record)
@@ -380,6 +389,7 @@ func (s *BadgerAggregatorStore) UpdateTaskStatus(ctx context.Context, taskId str
 
 		// Update status
 		record.Status = status
+		record.UpdatedAt = time.Now()
 		value, err := json.Marshal(record)
 		if err != nil {
 			return err
@@ -396,6 +406,33 @@ func (s *BadgerAggregatorStore) UpdateTaskStatus(ctx context.Context, taskId str
 	})
 }
 
+// UpdateTaskBlock records the block a task was included in after a reorg moved it
+func (s *BadgerAggregatorStore) UpdateTaskBlock(ctx context.Context, taskId string, blockNumber uint64, blockHash string) error {
+	s.mu.RLock()
+	if s.closed {
+		s.mu.RUnlock()
+		return storage.ErrStoreClosed
+	}
+	s.mu.RUnlock()
+
+	return s.db.Update(func(txn *badgerv3.Txn) error {
+		record, err := getTaskRecord(txn, taskId)
+		if err != nil {
+			return err
+		}
+
+		record.Task.SourceBlockNumber = blockNumber
+		record.Task.BlockHash = blockHash
+		record.UpdatedAt = time.Now()
+		value, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+
+		return txn.Set([]byte(fmt.Sprintf(prefixTask, taskId)), value)
+	})
+}
+
 // DeleteTask removes a task
 func (s *BadgerAggregatorStore) DeleteTask(ctx context.Context, taskId string) error {
 	s.mu.RLock()
@@ -431,8 +468,341 @@ func (s *BadgerAggregatorStore) DeleteTask(ctx context.Context, taskId string) e
 
 		// Delete status index
 		statusKey := fmt.Sprintf(prefixTaskByStatus, record.Status, taskId)
-		return txn.Delete([]byte(statusKey))
+		if err := txn.Delete([]byte(statusKey)); err != nil {
+			return err
+		}
+
+		// Delete responses and certificate
+		opts := badgerv3.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		opts.Prefix = []byte(fmt.Sprintf(prefixTaskResponse, taskId, ""))
+		it := txn.NewIterator(opts)
+		var responseKeys [][]byte
+		for it.Rewind(); it.Valid(); it.Next() {
+			responseKeys = append(responseKeys, it.Item().KeyCopy(nil))
+		}
+		it.Close()
+		for _, key := range responseKeys {
+			if err := txn.Delete(key); err != nil {
+				return err
+			}
+		}
+		return txn.Delete([]byte(fmt.Sprintf(prefixTaskCertificate, taskId)))
+	})
+}
+
+// GetTaskRecord retrieves a task along with its status and outcome
+func (s *BadgerAggregatorStore) GetTaskRecord(ctx context.Context, taskId string) (*storage.TaskRecord, error) {
+	s.mu.RLock()
+	if s.closed {
+		s.mu.RUnlock()
+		return nil, storage.ErrStoreClosed
+	}
+	s.mu.RUnlock()
+
+	var record *storage.TaskRecord
+	err := s.db.View(func(txn *badgerv3.Txn) error {
+		var err error
+		record, err = getTaskRecord(txn, taskId)
+		return err
+	})
+
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get task record: %w", err)
+	}
+
+	return record, nil
+}
+
+// ListTasks returns a page of tasks matching the filter, newest first
+func (s *BadgerAggregatorStore) ListTasks(ctx context.Context, filter *storage.TaskFilter) (*storage.TaskPage, error) {
+	s.mu.RLock()
+	if s.closed {
+		s.mu.RUnlock()
+		return nil, storage.ErrStoreClosed
+	}
+	s.mu.RUnlock()
+
+	if filter == nil {
+		filter = &storage.TaskFilter{}
+	}
+
+	var matches []*storage.TaskRecord
+	prefix := fmt.Sprintf(prefixTask, "")
+
+	err := s.db.View(func(txn *badgerv3.Txn) error {
+		opts := badgerv3.DefaultIteratorOptions
+		opts.Prefix = []byte(prefix)
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			var record storage.TaskRecord
+			err := it.Item().Value(func(val []byte) error {
+				return json.Unmarshal(val, &record)
+			})
+			if err != nil {
+				continue // Skip on unmarshal error
+			}
+
+			if filter.Matches(&record) {
+				matches = append(matches, &record)
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	return storage.PaginateTaskRecords(matches, filter)
+}
+
+// SaveTaskOutcome records how a task was resolved
+func (s *BadgerAggregatorStore) SaveTaskOutcome(ctx context.Context, taskId string, outcome *storage.TaskOutcome) error {
+	s.mu.RLock()
+	if s.closed {
+		s.mu.RUnlock()
+		return storage.ErrStoreClosed
+	}
+	s.mu.RUnlock()
+
+	if outcome == nil {
+		return errors.New("outcome is nil")
+	}
+
+	return s.db.Update(func(txn *badgerv3.Txn) error {
+		record, err := getTaskRecord(txn, taskId)
+		if err != nil {
+			return err
+		}
+
+		record.Outcome = outcome
+		record.UpdatedAt = time.Now()
+		value, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+
+		return txn.Set([]byte(fmt.Sprintf(prefixTask, taskId)), value)
+	})
+}
+
+// SaveOperatorResponse records an operator's response to a task, replacing any
+// previous response from the same operator
+func (s *BadgerAggregatorStore) SaveOperatorResponse(ctx context.Context, response *storage.OperatorResponse) error {
+	s.mu.RLock()
+	if s.closed {
+		s.mu.RUnlock()
+		return storage.ErrStoreClosed
+	}
+	s.mu.RUnlock()
+
+	if response == nil || response.OperatorAddress == "" {
+		return errors.New("invalid response: response or operator address is empty")
+	}
+
+	value, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("failed to marshal operator response: %w", err)
+	}
+
+	return s.db.Update(func(txn *badgerv3.Txn) error {
+		if _, err := getTaskRecord(txn, response.TaskId); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf(prefixTaskResponse, response.TaskId, strings.ToLower(response.OperatorAddress))
+		return txn.Set([]byte(key), value)
+	})
+}
+
+// ListOperatorResponses returns every recorded response for a task, ordered by operator address
+func (s *BadgerAggregatorStore) ListOperatorResponses(ctx context.Context, taskId string) ([]*storage.OperatorResponse, error) {
+	s.mu.RLock()
+	if s.closed {
+		s.mu.RUnlock()
+		return nil, storage.ErrStoreClosed
+	}
+	s.mu.RUnlock()
+
+	responses := make([]*storage.OperatorResponse, 0)
+	err := s.db.View(func(txn *badgerv3.Txn) error {
+		if _, err := getTaskRecord(txn, taskId); err != nil {
+			return err
+		}
+
+		opts := badgerv3.DefaultIteratorOptions
+		opts.Prefix = []byte(fmt.Sprintf(prefixTaskResponse, taskId, ""))
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			var response storage.OperatorResponse
+			err := it.Item().Value(func(val []byte) error {
+				return json.Unmarshal(val, &response)
+			})
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal operator response: %w", err)
+			}
+			responses = append(responses, &response)
+		}
+		return nil
 	})
+
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to list operator responses: %w", err)
+	}
+
+	return responses, nil
+}
+
+// SaveTaskCertificate records the aggregated certificate for a task, replacing any previous one
+func (s *BadgerAggregatorStore) SaveTaskCertificate(ctx context.Context, certificate *storage.TaskCertificate) error {
+	s.mu.RLock()
+	if s.closed {
+		s.mu.RUnlock()
+		return storage.ErrStoreClosed
+	}
+	s.mu.RUnlock()
+
+	if certificate == nil {
+		return errors.New("certificate is nil")
+	}
+
+	value, err := json.Marshal(certificate)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task certificate: %w", err)
+	}
+
+	return s.db.Update(func(txn *badgerv3.Txn) error {
+		if _, err := getTaskRecord(txn, certificate.TaskId); err != nil {
+			return err
+		}
+
+		return txn.Set([]byte(fmt.Sprintf(prefixTaskCertificate, certificate.TaskId)), value)
+	})
+}
+
+// GetTaskCertificate retrieves the aggregated certificate for a task
+func (s *BadgerAggregatorStore) GetTaskCertificate(ctx context.Context, taskId string) (*storage.TaskCertificate, error) {
+	s.mu.RLock()
+	if s.closed {
+		s.mu.RUnlock()
+		return nil, storage.ErrStoreClosed
+	}
+	s.mu.RUnlock()
+
+	var certificate storage.TaskCertificate
+	err := s.db.View(func(txn *badgerv3.Txn) error {
+		item, err := txn.Get([]byte(fmt.Sprintf(prefixTaskCertificate, taskId)))
+		if err != nil {
+			if errors.Is(err, badgerv3.ErrKeyNotFound) {
+				return storage.ErrNotFound
+			}
+			return err
+		}
+
+		return item.Value(func(val []byte) error {
+			return json.Unmarshal(val, &certificate)
+		})
+	})
+
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get task certificate: %w", err)
+	}
+
+	return &certificate, nil
+}
+
+// SaveReorgEvent records a handled chain reorganization
+func (s *BadgerAggregatorStore) SaveReorgEvent(ctx context.Context, event *storage.ReorgEvent) error {
+	s.mu.RLock()
+	if s.closed {
+		s.mu.RUnlock()
+		return storage.ErrStoreClosed
+	}
+	s.mu.RUnlock()
+
+	if event == nil {
+		return errors.New("reorg event is nil")
+	}
+
+	value, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal reorg event: %w", err)
+	}
+
+	key := fmt.Sprintf(prefixReorgEvent, event.AvsAddress, event.ChainId, event.DetectedAt.UnixNano())
+	return s.db.Update(func(txn *badgerv3.Txn) error {
+		return txn.Set([]byte(key), value)
+	})
+}
+
+// ListReorgEvents returns the reorgs handled for an AVS on a chain, newest first
+func (s *BadgerAggregatorStore) ListReorgEvents(ctx context.Context, avsAddress string, chainId config.ChainId) ([]*storage.ReorgEvent, error) {
+	s.mu.RLock()
+	if s.closed {
+		s.mu.RUnlock()
+		return nil, storage.ErrStoreClosed
+	}
+	s.mu.RUnlock()
+
+	events := make([]*storage.ReorgEvent, 0)
+	err := s.db.View(func(txn *badgerv3.Txn) error {
+		opts := badgerv3.DefaultIteratorOptions
+		opts.Prefix = []byte(fmt.Sprintf(prefixReorgEvents, avsAddress, chainId))
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		// keys end in the zero padded detection time, so they iterate oldest first
+		for it.Rewind(); it.Valid(); it.Next() {
+			var event storage.ReorgEvent
+			err := it.Item().Value(func(val []byte) error {
+				return json.Unmarshal(val, &event)
+			})
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal reorg event: %w", err)
+			}
+			events = append(events, &event)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reorg events: %w", err)
+	}
+
+	slices.Reverse(events)
+	return events, nil
+}
+
+// getTaskRecord loads and decodes a task record within an existing transaction
+func getTaskRecord(txn *badgerv3.Txn, taskId string) (*storage.TaskRecord, error) {
+	item, err := txn.Get([]byte(fmt.Sprintf(prefixTask, taskId)))
+	if err != nil {
+		if errors.Is(err, badgerv3.ErrKeyNotFound) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+
+	var record storage.TaskRecord
+	if err := item.Value(func(val []byte) error {
+		return json.Unmarshal(val, &record)
+	}); err != nil {
+		return nil, err
+	}
+	return &record, nil
 }
 
 // SaveBlock saves block information for reorg detection
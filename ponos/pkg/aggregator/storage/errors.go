@@ -17,4 +17,7 @@ var (
 
 	// ErrInvalidChainId is returned when an invalid chain ID is provided
 	ErrInvalidChainId = errors.New("invalid chain ID")
+
+	// ErrInvalidPageToken is returned when a page token cannot be decoded
+	ErrInvalidPageToken = errors.New("invalid page token")
 )
@@ -3,6 +3,7 @@ package memory
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -21,6 +22,9 @@ type InMemoryAggregatorStore struct {
 	operatorSetConfigs  map[string]*storage.OperatorSetTaskConfig
 	avsConfigs          map[string]*storage.AvsConfig
 	blocks              map[string]*storage.BlockRecord
+	responses           map[string]map[string]*storage.OperatorResponse // taskId -> operatorAddress -> response
+	certificates        map[string]*storage.TaskCertificate
+	reorgEvents         map[string][]*storage.ReorgEvent
 }
 
 // NewInMemoryAggregatorStore creates a new in-memory aggregator store
@@ -31,6 +35,9 @@ func NewInMemoryAggregatorStore() *InMemoryAggregatorStore {
 		operatorSetConfigs:  make(map[string]*storage.OperatorSetTaskConfig),
 		avsConfigs:          make(map[string]*storage.AvsConfig),
 		blocks:              make(map[string]*storage.BlockRecord),
+		responses:           make(map[string]map[string]*storage.OperatorResponse),
+		certificates:        make(map[string]*storage.TaskCertificate),
+		reorgEvents:         make(map[string][]*storage.ReorgEvent),
 	}
 }
 
@@ -173,6 +180,29 @@ func (s *InMemoryAggregatorStore) UpdateTaskStatus(ctx context.Context, taskId s
 	return nil
 }
 
+// UpdateTaskBlock records the block a task was included in after a reorg moved it
+func (s *InMemoryAggregatorStore) UpdateTaskBlock(ctx context.Context, taskId string, blockNumber uint64, blockHash string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return storage.ErrStoreClosed
+	}
+
+	record, exists := s.tasks[taskId]
+	if !exists {
+		return storage.ErrNotFound
+	}
+
+	// the stored task may be the one being processed, so it is replaced rather than modified
+	taskCopy := *record.Task
+	taskCopy.SourceBlockNumber = blockNumber
+	taskCopy.BlockHash = blockHash
+	record.Task = &taskCopy
+	record.UpdatedAt = time.Now()
+	return nil
+}
+
 // DeleteTask removes a task from storage
 func (s *InMemoryAggregatorStore) DeleteTask(ctx context.Context, taskId string) error {
 	s.mu.Lock()
@@ -187,9 +217,209 @@ func (s *InMemoryAggregatorStore) DeleteTask(ctx context.Context, taskId string)
 	}
 
 	delete(s.tasks, taskId)
+	delete(s.responses, taskId)
+	delete(s.certificates, taskId)
+	return nil
+}
+
+// GetTaskRecord retrieves a task along with its status and outcome
+func (s *InMemoryAggregatorStore) GetTaskRecord(ctx context.Context, taskId string) (*storage.TaskRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return nil, storage.ErrStoreClosed
+	}
+
+	record, exists := s.tasks[taskId]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+
+	recordCopy := *record
+	return &recordCopy, nil
+}
+
+// ListTasks returns a page of tasks matching the filter, newest first
+func (s *InMemoryAggregatorStore) ListTasks(ctx context.Context, filter *storage.TaskFilter) (*storage.TaskPage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return nil, storage.ErrStoreClosed
+	}
+
+	if filter == nil {
+		filter = &storage.TaskFilter{}
+	}
+
+	var matches []*storage.TaskRecord
+	for _, record := range s.tasks {
+		if filter.Matches(record) {
+			recordCopy := *record
+			matches = append(matches, &recordCopy)
+		}
+	}
+
+	return storage.PaginateTaskRecords(matches, filter)
+}
+
+// SaveTaskOutcome records how a task was resolved
+func (s *InMemoryAggregatorStore) SaveTaskOutcome(ctx context.Context, taskId string, outcome *storage.TaskOutcome) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return storage.ErrStoreClosed
+	}
+
+	if outcome == nil {
+		return fmt.Errorf("outcome cannot be nil")
+	}
+
+	record, exists := s.tasks[taskId]
+	if !exists {
+		return storage.ErrNotFound
+	}
+
+	record.Outcome = outcome
+	record.UpdatedAt = time.Now()
+	return nil
+}
+
+// SaveOperatorResponse records an operator's response to a task, replacing any
+// previous response from the same operator
+func (s *InMemoryAggregatorStore) SaveOperatorResponse(ctx context.Context, response *storage.OperatorResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return storage.ErrStoreClosed
+	}
+
+	if response == nil || response.OperatorAddress == "" {
+		return fmt.Errorf("invalid response: response or operator address is empty")
+	}
+
+	if _, exists := s.tasks[response.TaskId]; !exists {
+		return storage.ErrNotFound
+	}
+
+	byOperator, exists := s.responses[response.TaskId]
+	if !exists {
+		byOperator = make(map[string]*storage.OperatorResponse)
+		s.responses[response.TaskId] = byOperator
+	}
+	responseCopy := *response
+	byOperator[strings.ToLower(response.OperatorAddress)] = &responseCopy
 	return nil
 }
 
+// ListOperatorResponses returns every recorded response for a task, ordered by operator address
+func (s *InMemoryAggregatorStore) ListOperatorResponses(ctx context.Context, taskId string) ([]*storage.OperatorResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return nil, storage.ErrStoreClosed
+	}
+
+	if _, exists := s.tasks[taskId]; !exists {
+		return nil, storage.ErrNotFound
+	}
+
+	byOperator := s.responses[taskId]
+	operators := make([]string, 0, len(byOperator))
+	for operator := range byOperator {
+		operators = append(operators, operator)
+	}
+	sort.Strings(operators)
+
+	responses := make([]*storage.OperatorResponse, 0, len(operators))
+	for _, operator := range operators {
+		responseCopy := *byOperator[operator]
+		responses = append(responses, &responseCopy)
+	}
+	return responses, nil
+}
+
+// SaveTaskCertificate records the aggregated certificate for a task, replacing any previous one
+func (s *InMemoryAggregatorStore) SaveTaskCertificate(ctx context.Context, certificate *storage.TaskCertificate) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return storage.ErrStoreClosed
+	}
+
+	if certificate == nil {
+		return fmt.Errorf("certificate cannot be nil")
+	}
+
+	if _, exists := s.tasks[certificate.TaskId]; !exists {
+		return storage.ErrNotFound
+	}
+
+	certificateCopy := *certificate
+	s.certificates[certificate.TaskId] = &certificateCopy
+	return nil
+}
+
+// GetTaskCertificate retrieves the aggregated certificate for a task
+func (s *InMemoryAggregatorStore) GetTaskCertificate(ctx context.Context, taskId string) (*storage.TaskCertificate, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return nil, storage.ErrStoreClosed
+	}
+
+	certificate, exists := s.certificates[taskId]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+
+	certificateCopy := *certificate
+	return &certificateCopy, nil
+}
+
+// SaveReorgEvent records a handled chain reorganization
+func (s *InMemoryAggregatorStore) SaveReorgEvent(ctx context.Context, event *storage.ReorgEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return storage.ErrStoreClosed
+	}
+
+	if event == nil {
+		return fmt.Errorf("reorg event cannot be nil")
+	}
+
+	key := makeBlockKey(event.AvsAddress, event.ChainId)
+	eventCopy := *event
+	s.reorgEvents[key] = append(s.reorgEvents[key], &eventCopy)
+	return nil
+}
+
+// ListReorgEvents returns the reorgs handled for an AVS on a chain, newest first
+func (s *InMemoryAggregatorStore) ListReorgEvents(ctx context.Context, avsAddress string, chainId config.ChainId) ([]*storage.ReorgEvent, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return nil, storage.ErrStoreClosed
+	}
+
+	stored := s.reorgEvents[makeBlockKey(avsAddress, chainId)]
+	events := make([]*storage.ReorgEvent, 0, len(stored))
+	for i := len(stored) - 1; i >= 0; i-- {
+		eventCopy := *stored[i]
+		events = append(events, &eventCopy)
+	}
+	return events, nil
+}
+
 // SaveBlock saves block information for reorg detection
 func (s *InMemoryAggregatorStore) SaveBlock(ctx context.Context, avsAddress string, block *storage.BlockRecord) error {
 	s.mu.Lock()
@@ -264,6 +494,8 @@ func (s *InMemoryAggregatorStore) Close() error {
 	s.operatorSetConfigs = nil
 	s.avsConfigs = nil
 	s.blocks = nil
+	s.responses = nil
+	s.certificates = nil
 
 	return nil
 }
@@ -0,0 +1,135 @@
+package storage
+
+import (
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+)
+
+const (
+	DefaultTaskPageSize = 50
+	MaxTaskPageSize     = 500
+)
+
+// TaskFilter narrows the tasks returned by ListTasks. Zero values match everything.
+type TaskFilter struct {
+	AvsAddress    string
+	Statuses      []TaskStatus
+	OperatorSetId *uint32
+	ChainId       config.ChainId
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	// BlockHashes matches tasks created in any of the blocks
+	BlockHashes []string
+
+	PageSize  int
+	PageToken string
+}
+
+// TaskPage is a single page of ListTasks results, newest first
+type TaskPage struct {
+	Tasks         []*TaskRecord
+	NextPageToken string
+}
+
+// Matches reports whether the record satisfies every criterion of the filter
+func (f *TaskFilter) Matches(record *TaskRecord) bool {
+	if record == nil || record.Task == nil {
+		return false
+	}
+	if f.AvsAddress != "" && !strings.EqualFold(f.AvsAddress, record.Task.AVSAddress) {
+		return false
+	}
+	if len(f.Statuses) > 0 && !slices.Contains(f.Statuses, record.Status) {
+		return false
+	}
+	if f.OperatorSetId != nil && *f.OperatorSetId != record.Task.OperatorSetId {
+		return false
+	}
+	if f.ChainId != 0 && f.ChainId != record.Task.ChainId {
+		return false
+	}
+	if !f.CreatedAfter.IsZero() && record.CreatedAt.Before(f.CreatedAfter) {
+		return false
+	}
+	if !f.CreatedBefore.IsZero() && !record.CreatedAt.Before(f.CreatedBefore) {
+		return false
+	}
+	if len(f.BlockHashes) > 0 && !slices.ContainsFunc(f.BlockHashes, func(hash string) bool {
+		return strings.EqualFold(hash, record.Task.BlockHash)
+	}) {
+		return false
+	}
+	return true
+}
+
+// PaginateTaskRecords sorts matching records newest first and returns the page
+// selected by the filter's page token and size. Store implementations share it so
+// that pagination behaves identically regardless of the backend.
+func PaginateTaskRecords(records []*TaskRecord, filter *TaskFilter) (*TaskPage, error) {
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultTaskPageSize
+	}
+	if pageSize > MaxTaskPageSize {
+		pageSize = MaxTaskPageSize
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return isBefore(records[i].CreatedAt, records[i].Task.TaskId, records[j].CreatedAt, records[j].Task.TaskId)
+	})
+
+	start := 0
+	if filter.PageToken != "" {
+		cursorTime, cursorId, err := decodePageToken(filter.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		start = sort.Search(len(records), func(i int) bool {
+			return isBefore(cursorTime, cursorId, records[i].CreatedAt, records[i].Task.TaskId)
+		})
+	}
+
+	end := min(start+pageSize, len(records))
+	page := &TaskPage{Tasks: records[start:end]}
+	if end < len(records) {
+		last := records[end-1]
+		page.NextPageToken = encodePageToken(last.CreatedAt, last.Task.TaskId)
+	}
+	return page, nil
+}
+
+// isBefore orders records newest first, breaking ties on the task ID
+func isBefore(aTime time.Time, aId string, bTime time.Time, bId string) bool {
+	if !aTime.Equal(bTime) {
+		return aTime.After(bTime)
+	}
+	return aId < bId
+}
+
+func encodePageToken(createdAt time.Time, taskId string) string {
+	raw := fmt.Sprintf("%d:%s", createdAt.UnixNano(), taskId)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodePageToken(token string) (time.Time, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, "", ErrInvalidPageToken
+	}
+	nanos, taskId, found := strings.Cut(string(raw), ":")
+	if !found || taskId == "" {
+		return time.Time{}, "", ErrInvalidPageToken
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return time.Time{}, "", ErrInvalidPageToken
+	}
+	return time.Unix(0, n), taskId, nil
+}
@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+)
+
+// OperatorResponseStatus describes how the aggregator handled an operator's response
+type OperatorResponseStatus string
+
+const (
+	// OperatorResponseAccepted means the result was verified and included in aggregation
+	OperatorResponseAccepted OperatorResponseStatus = "accepted"
+	// OperatorResponseRejected means the result was received but failed validation
+	OperatorResponseRejected OperatorResponseStatus = "rejected"
+	// OperatorResponseLate means no result was aggregated before the task session ended
+	OperatorResponseLate OperatorResponseStatus = "late"
+	// OperatorResponseFailed means the executor could not be reached or returned an error
+	OperatorResponseFailed OperatorResponseStatus = "failed"
+)
+
+// OperatorResponse records a single operator's response to a task
+type OperatorResponse struct {
+	TaskId          string
+	OperatorAddress string
+	Status          OperatorResponseStatus
+	// Result is the signed result returned by the executor, if one was received
+	Result *types.TaskResult `json:",omitempty"`
+	// OutputDigest is the hex encoded keccak256 digest of the result output
+	OutputDigest string
+	// ReceivedAt is when the result was received, zero if none was
+	ReceivedAt time.Time
+	// Latency is the time between submitting the task to the executor and receiving its result
+	Latency time.Duration
+	// Error describes why the response was rejected or failed
+	Error string
+}
+
+// TaskCertificate is the final aggregated certificate for a task and, once
+// submitted, the receipt of the submission transaction
+type TaskCertificate struct {
+	TaskId             string
+	CurveType          config.CurveType
+	TaskResponse       []byte
+	TaskResponseDigest string
+	SignedAt           time.Time
+
+	BN254 *BN254Certificate `json:",omitempty"`
+	ECDSA *ECDSACertificate `json:",omitempty"`
+
+	// ReceiptHash is the hash of the transaction that submitted the certificate
+	ReceiptHash string
+	SubmittedAt time.Time
+}
+
+// BN254Certificate holds the BN254 specific parts of an aggregated certificate
+type BN254Certificate struct {
+	SignersSignature   []byte
+	SignersPublicKey   []byte
+	NonSignerOperators []CertificateOperator
+}
+
+// CertificateOperator identifies an operator referenced by a certificate
+type CertificateOperator struct {
+	OperatorAddress string
+	OperatorIndex   uint32
+	PublicKey       []byte
+}
+
+// ECDSACertificate holds the ECDSA specific parts of an aggregated certificate
+type ECDSACertificate struct {
+	// SignersSignatures maps operator address to signature
+	SignersSignatures map[string][]byte
+}
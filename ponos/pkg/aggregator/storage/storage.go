@@ -21,8 +21,23 @@ type AggregatorStore interface {
 	ListPendingTasks(ctx context.Context) ([]*types.Task, error)
 	ListPendingTasksForAVS(ctx context.Context, avsAddress string) ([]*types.Task, error)
 	UpdateTaskStatus(ctx context.Context, taskId string, status TaskStatus) error
+	// UpdateTaskBlock records the block a task was included in after a reorg moved it
+	UpdateTaskBlock(ctx context.Context, taskId string, blockNumber uint64, blockHash string) error
 	DeleteTask(ctx context.Context, taskId string) error
 
+	GetTaskRecord(ctx context.Context, taskId string) (*TaskRecord, error)
+	ListTasks(ctx context.Context, filter *TaskFilter) (*TaskPage, error)
+	SaveTaskOutcome(ctx context.Context, taskId string, outcome *TaskOutcome) error
+
+	SaveOperatorResponse(ctx context.Context, response *OperatorResponse) error
+	ListOperatorResponses(ctx context.Context, taskId string) ([]*OperatorResponse, error)
+	SaveTaskCertificate(ctx context.Context, certificate *TaskCertificate) error
+	GetTaskCertificate(ctx context.Context, taskId string) (*TaskCertificate, error)
+
+	SaveReorgEvent(ctx context.Context, event *ReorgEvent) error
+	// ListReorgEvents returns the reorgs handled for an AVS on a chain, newest first
+	ListReorgEvents(ctx context.Context, avsAddress string, chainId config.ChainId) ([]*ReorgEvent, error)
+
 	Close() error
 }
 
@@ -42,6 +57,19 @@ type TaskRecord struct {
 	Status    TaskStatus
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	Outcome   *TaskOutcome `json:",omitempty"`
+}
+
+// TaskOutcome captures how a task was resolved once it leaves the processing state
+type TaskOutcome struct {
+	// RespondingOperators are the operators whose results were aggregated
+	RespondingOperators []string
+	// TaskResponseDigest is the hex encoded digest of the winning response
+	TaskResponseDigest string
+	// SubmissionTxHash is the hash of the transaction that submitted the certificate
+	SubmissionTxHash string
+	// Error describes why the task failed, if it did
+	Error string
 }
 
 // BlockRecord stores essential block information for reorg detection
@@ -53,6 +81,26 @@ type BlockRecord struct {
 	ChainId    config.ChainId
 }
 
+// ReorgEvent records a chain reorganization handled by a poller and what happened
+// to the tasks of the blocks it replaced
+type ReorgEvent struct {
+	AvsAddress string
+	ChainId    config.ChainId
+	DetectedAt time.Time
+	// CommonAncestor is the newest block shared by the orphaned and canonical branches
+	CommonAncestor uint64
+	// CanonicalHead is the block of the canonical branch that exposed the reorg
+	CanonicalHead uint64
+	// OrphanedBlocks are the processed blocks that are no longer canonical
+	OrphanedBlocks []*BlockRecord
+	// CancelledTaskIds are tasks of the orphaned blocks missing from the canonical branch
+	CancelledTaskIds []string
+	// RetainedTaskIds are tasks of the orphaned blocks included again in the canonical branch
+	RetainedTaskIds []string
+	// NewTaskIds are tasks that only exist in the canonical branch
+	NewTaskIds []string
+}
+
 // ConsensusType represents the type of consensus mechanism
 type ConsensusType uint8
 
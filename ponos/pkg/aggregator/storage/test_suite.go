@@ -21,6 +21,9 @@ type TestSuite struct {
 func (s *TestSuite) Run(t *testing.T) {
 	t.Run("ChainPollingState", s.testChainPollingState)
 	t.Run("TaskManagement", s.testTaskManagement)
+	t.Run("TaskQueries", s.testTaskQueries)
+	t.Run("TaskResults", s.testTaskResults)
+	t.Run("Reorgs", s.testReorgs)
 	t.Run("Lifecycle", s.testLifecycle)
 	t.Run("ConcurrentAccess", s.testConcurrentAccess)
 }
@@ -240,6 +243,317 @@ func (s *TestSuite) testTaskManagement(t *testing.T) {
 	assert.ErrorIs(t, err, ErrNotFound)
 }
 
+func (s *TestSuite) testTaskQueries(t *testing.T) {
+	store, err := s.NewStore()
+	require.NoError(t, err)
+	defer store.Close()
+
+	ctx := context.Background()
+	deadline := time.Now().Add(time.Hour)
+	// Stores may be backed by state left over from other tests, so every query is
+	// bounded to tasks created by this test
+	start := time.Now()
+
+	// Save tasks spread over two AVSs, two chains and two operator sets
+	var createdAt []time.Time
+	for i := 0; i < 6; i++ {
+		task := &types.Task{
+			TaskId:              fmt.Sprintf("query-task-%d", i),
+			AVSAddress:          fmt.Sprintf("0xavs%d", i%2),
+			ChainId:             config.ChainId(1 + i%3/2),
+			OperatorSetId:       uint32(i % 3),
+			DeadlineUnixSeconds: &deadline,
+		}
+		require.NoError(t, store.SavePendingTask(ctx, task))
+
+		record, err := store.GetTaskRecord(ctx, task.TaskId)
+		require.NoError(t, err)
+		assert.Equal(t, TaskStatusPending, record.Status)
+		assert.False(t, record.CreatedAt.IsZero())
+		createdAt = append(createdAt, record.CreatedAt)
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	_, err = store.GetTaskRecord(ctx, "non-existent")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// Unfiltered listing returns everything newest first
+	page, err := store.ListTasks(ctx, &TaskFilter{CreatedAfter: start})
+	require.NoError(t, err)
+	require.Len(t, page.Tasks, 6)
+	assert.Equal(t, "query-task-5", page.Tasks[0].Task.TaskId)
+	assert.Equal(t, "query-task-0", page.Tasks[5].Task.TaskId)
+	assert.Empty(t, page.NextPageToken)
+
+	// Filter by AVS, case insensitive
+	page, err = store.ListTasks(ctx, &TaskFilter{CreatedAfter: start, AvsAddress: "0xAVS1"})
+	require.NoError(t, err)
+	assert.Len(t, page.Tasks, 3)
+
+	// Filter by chain and operator set
+	opsetId := uint32(1)
+	page, err = store.ListTasks(ctx, &TaskFilter{CreatedAfter: start, OperatorSetId: &opsetId})
+	require.NoError(t, err)
+	assert.Len(t, page.Tasks, 2)
+
+	page, err = store.ListTasks(ctx, &TaskFilter{CreatedAfter: start, ChainId: config.ChainId(2)})
+	require.NoError(t, err)
+	for _, record := range page.Tasks {
+		assert.Equal(t, config.ChainId(2), record.Task.ChainId)
+	}
+
+	// Filter by status
+	require.NoError(t, store.UpdateTaskStatus(ctx, "query-task-2", TaskStatusProcessing))
+	require.NoError(t, store.UpdateTaskStatus(ctx, "query-task-2", TaskStatusCompleted))
+	require.NoError(t, store.UpdateTaskStatus(ctx, "query-task-3", TaskStatusFailed))
+	page, err = store.ListTasks(ctx, &TaskFilter{CreatedAfter: start, Statuses: []TaskStatus{TaskStatusCompleted, TaskStatusFailed}})
+	require.NoError(t, err)
+	require.Len(t, page.Tasks, 2)
+	assert.Equal(t, "query-task-3", page.Tasks[0].Task.TaskId)
+	assert.Equal(t, "query-task-2", page.Tasks[1].Task.TaskId)
+
+	// Filter by time range, the upper bound is exclusive
+	page, err = store.ListTasks(ctx, &TaskFilter{CreatedAfter: createdAt[1], CreatedBefore: createdAt[4]})
+	require.NoError(t, err)
+	require.Len(t, page.Tasks, 3)
+	assert.Equal(t, "query-task-3", page.Tasks[0].Task.TaskId)
+	assert.Equal(t, "query-task-1", page.Tasks[2].Task.TaskId)
+
+	// Paginate through all tasks
+	var seen []string
+	filter := &TaskFilter{CreatedAfter: start, PageSize: 4}
+	for {
+		page, err = store.ListTasks(ctx, filter)
+		require.NoError(t, err)
+		for _, record := range page.Tasks {
+			seen = append(seen, record.Task.TaskId)
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		filter.PageToken = page.NextPageToken
+	}
+	assert.Equal(t, []string{"query-task-5", "query-task-4", "query-task-3", "query-task-2", "query-task-1", "query-task-0"}, seen)
+
+	_, err = store.ListTasks(ctx, &TaskFilter{PageToken: "not-a-token"})
+	assert.ErrorIs(t, err, ErrInvalidPageToken)
+
+	// Outcomes are returned with the task record
+	outcome := &TaskOutcome{
+		RespondingOperators: []string{"0xoperator1", "0xoperator2"},
+		TaskResponseDigest:  "0xdigest",
+		SubmissionTxHash:    "0xtxhash",
+	}
+	require.NoError(t, store.SaveTaskOutcome(ctx, "query-task-2", outcome))
+
+	record, err := store.GetTaskRecord(ctx, "query-task-2")
+	require.NoError(t, err)
+	assert.Equal(t, TaskStatusCompleted, record.Status)
+	require.NotNil(t, record.Outcome)
+	assert.Equal(t, outcome.RespondingOperators, record.Outcome.RespondingOperators)
+	assert.Equal(t, outcome.TaskResponseDigest, record.Outcome.TaskResponseDigest)
+	assert.Equal(t, outcome.SubmissionTxHash, record.Outcome.SubmissionTxHash)
+
+	err = store.SaveTaskOutcome(ctx, "non-existent", outcome)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func (s *TestSuite) testTaskResults(t *testing.T) {
+	store, err := s.NewStore()
+	require.NoError(t, err)
+	defer store.Close()
+
+	ctx := context.Background()
+	deadline := time.Now().Add(time.Hour)
+	task := &types.Task{
+		TaskId:              "results-task-1",
+		AVSAddress:          "0xavs",
+		ChainId:             config.ChainId(1),
+		DeadlineUnixSeconds: &deadline,
+	}
+	require.NoError(t, store.SavePendingTask(ctx, task))
+
+	// Responses can only be recorded for known tasks
+	err = store.SaveOperatorResponse(ctx, &OperatorResponse{TaskId: "non-existent", OperatorAddress: "0xoperator1"})
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = store.ListOperatorResponses(ctx, "non-existent")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	responses, err := store.ListOperatorResponses(ctx, task.TaskId)
+	require.NoError(t, err)
+	assert.Empty(t, responses)
+
+	receivedAt := time.Now().Truncate(time.Millisecond)
+	accepted := &OperatorResponse{
+		TaskId:          task.TaskId,
+		OperatorAddress: "0xOperator2",
+		Status:          OperatorResponseAccepted,
+		Result: &types.TaskResult{
+			TaskId:          task.TaskId,
+			OperatorAddress: "0xOperator2",
+			Output:          []byte("output"),
+			ResultSignature: []byte("signature"),
+		},
+		OutputDigest: "0xdigest",
+		ReceivedAt:   receivedAt,
+		Latency:      150 * time.Millisecond,
+	}
+	require.NoError(t, store.SaveOperatorResponse(ctx, &OperatorResponse{
+		TaskId:          task.TaskId,
+		OperatorAddress: "0xoperator1",
+		Status:          OperatorResponseFailed,
+		Error:           "connection refused",
+	}))
+	require.NoError(t, store.SaveOperatorResponse(ctx, &OperatorResponse{
+		TaskId:          task.TaskId,
+		OperatorAddress: "0xOperator2",
+		Status:          OperatorResponseLate,
+	}))
+	// A later response from the same operator replaces the earlier one
+	require.NoError(t, store.SaveOperatorResponse(ctx, accepted))
+
+	responses, err = store.ListOperatorResponses(ctx, task.TaskId)
+	require.NoError(t, err)
+	require.Len(t, responses, 2)
+	assert.Equal(t, "0xoperator1", responses[0].OperatorAddress)
+	assert.Equal(t, OperatorResponseFailed, responses[0].Status)
+	assert.Equal(t, "connection refused", responses[0].Error)
+	assert.Equal(t, OperatorResponseAccepted, responses[1].Status)
+	assert.Equal(t, accepted.Result, responses[1].Result)
+	assert.Equal(t, accepted.OutputDigest, responses[1].OutputDigest)
+	assert.True(t, accepted.ReceivedAt.Equal(responses[1].ReceivedAt))
+	assert.Equal(t, accepted.Latency, responses[1].Latency)
+
+	// Certificates
+	_, err = store.GetTaskCertificate(ctx, task.TaskId)
+	assert.ErrorIs(t, err, ErrNotFound)
+	err = store.SaveTaskCertificate(ctx, &TaskCertificate{TaskId: "non-existent"})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	certificate := &TaskCertificate{
+		TaskId:             task.TaskId,
+		CurveType:          config.CurveTypeBN254,
+		TaskResponse:       []byte("output"),
+		TaskResponseDigest: "0xdigest",
+		SignedAt:           receivedAt,
+		BN254: &BN254Certificate{
+			SignersSignature: []byte("aggregate-signature"),
+			SignersPublicKey: []byte("aggregate-public-key"),
+			NonSignerOperators: []CertificateOperator{
+				{OperatorAddress: "0xoperator1", OperatorIndex: 1, PublicKey: []byte("public-key")},
+			},
+		},
+	}
+	require.NoError(t, store.SaveTaskCertificate(ctx, certificate))
+
+	saved, err := store.GetTaskCertificate(ctx, task.TaskId)
+	require.NoError(t, err)
+	assert.Equal(t, config.CurveTypeBN254, saved.CurveType)
+	assert.Equal(t, certificate.BN254, saved.BN254)
+	assert.Nil(t, saved.ECDSA)
+	assert.Empty(t, saved.ReceiptHash)
+
+	// Recording the receipt replaces the stored certificate
+	certificate.ReceiptHash = "0xreceipt"
+	certificate.SubmittedAt = time.Now().Truncate(time.Millisecond)
+	require.NoError(t, store.SaveTaskCertificate(ctx, certificate))
+	saved, err = store.GetTaskCertificate(ctx, task.TaskId)
+	require.NoError(t, err)
+	assert.Equal(t, "0xreceipt", saved.ReceiptHash)
+	assert.True(t, certificate.SubmittedAt.Equal(saved.SubmittedAt))
+
+	ecdsaTask := &types.Task{TaskId: "results-task-2", AVSAddress: "0xavs", DeadlineUnixSeconds: &deadline}
+	require.NoError(t, store.SavePendingTask(ctx, ecdsaTask))
+	require.NoError(t, store.SaveTaskCertificate(ctx, &TaskCertificate{
+		TaskId:    ecdsaTask.TaskId,
+		CurveType: config.CurveTypeECDSA,
+		ECDSA: &ECDSACertificate{
+			SignersSignatures: map[string][]byte{"0xoperator1": []byte("signature")},
+		},
+	}))
+	saved, err = store.GetTaskCertificate(ctx, ecdsaTask.TaskId)
+	require.NoError(t, err)
+	require.NotNil(t, saved.ECDSA)
+	assert.Equal(t, []byte("signature"), saved.ECDSA.SignersSignatures["0xoperator1"])
+
+	// Deleting the task removes its responses and certificate
+	require.NoError(t, store.DeleteTask(ctx, task.TaskId))
+	_, err = store.GetTaskCertificate(ctx, task.TaskId)
+	assert.ErrorIs(t, err, ErrNotFound)
+	require.NoError(t, store.SavePendingTask(ctx, task))
+	responses, err = store.ListOperatorResponses(ctx, task.TaskId)
+	require.NoError(t, err)
+	assert.Empty(t, responses)
+}
+
+func (s *TestSuite) testReorgs(t *testing.T) {
+	store, err := s.NewStore()
+	require.NoError(t, err)
+	defer store.Close()
+
+	ctx := context.Background()
+	deadline := time.Now().Add(time.Hour)
+	start := time.Now()
+
+	for i, blockHash := range []string{"0xorphaned", "0xcanonical"} {
+		require.NoError(t, store.SavePendingTask(ctx, &types.Task{
+			TaskId:              fmt.Sprintf("reorg-task-%d", i),
+			AVSAddress:          "0xreorgavs",
+			ChainId:             config.ChainId(1),
+			SourceBlockNumber:   100,
+			BlockHash:           blockHash,
+			DeadlineUnixSeconds: &deadline,
+		}))
+	}
+
+	// Filter by block
+	page, err := store.ListTasks(ctx, &TaskFilter{CreatedAfter: start, BlockHashes: []string{"0xORPHANED"}})
+	require.NoError(t, err)
+	require.Len(t, page.Tasks, 1)
+	assert.Equal(t, "reorg-task-0", page.Tasks[0].Task.TaskId)
+
+	// Move a task to the block it was included in again
+	require.NoError(t, store.UpdateTaskBlock(ctx, "reorg-task-0", 101, "0xcanonical2"))
+	task, err := store.GetTask(ctx, "reorg-task-0")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(101), task.SourceBlockNumber)
+	assert.Equal(t, "0xcanonical2", task.BlockHash)
+	page, err = store.ListTasks(ctx, &TaskFilter{CreatedAfter: start, BlockHashes: []string{"0xorphaned"}})
+	require.NoError(t, err)
+	assert.Empty(t, page.Tasks)
+	assert.ErrorIs(t, store.UpdateTaskBlock(ctx, "non-existent", 1, "0x1"), ErrNotFound)
+
+	// Reorg events are listed newest first, per AVS and chain
+	events, err := store.ListReorgEvents(ctx, "0xreorgavs", config.ChainId(1))
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, store.SaveReorgEvent(ctx, &ReorgEvent{
+			AvsAddress:       "0xreorgavs",
+			ChainId:          config.ChainId(1),
+			DetectedAt:       start.Add(time.Duration(i) * time.Second),
+			CommonAncestor:   uint64(99 + i),
+			CanonicalHead:    uint64(101 + i),
+			OrphanedBlocks:   []*BlockRecord{{Number: uint64(100 + i), Hash: "0xorphaned", ChainId: config.ChainId(1)}},
+			CancelledTaskIds: []string{fmt.Sprintf("cancelled-%d", i)},
+		}))
+	}
+	require.NoError(t, store.SaveReorgEvent(ctx, &ReorgEvent{
+		AvsAddress: "0xreorgavs",
+		ChainId:    config.ChainId(2),
+		DetectedAt: start,
+	}))
+
+	events, err = store.ListReorgEvents(ctx, "0xreorgavs", config.ChainId(1))
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, uint64(100), events[0].CommonAncestor)
+	assert.Equal(t, []string{"cancelled-1"}, events[0].CancelledTaskIds)
+	assert.Equal(t, uint64(101), events[0].OrphanedBlocks[0].Number)
+	assert.Equal(t, uint64(99), events[1].CommonAncestor)
+}
+
 func (s *TestSuite) testLifecycle(t *testing.T) {
 	store, err := s.NewStore()
 	require.NoError(t, err)
@@ -0,0 +1,376 @@
+package EVMChainPoller
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/ethereum"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultBlockFeedCacheSize is the number of recent blocks a BlockFeed keeps unless configured otherwise
+const DefaultBlockFeedCacheSize = 128
+
+// blockSubscriber is implemented by block sources that notify the poller when a new block is ready
+type blockSubscriber interface {
+	SubscribeToBlocks(ctx context.Context, handler func(header *ethTypes.Header) error) error
+}
+
+type BlockFeedConfig struct {
+	ChainId              config.ChainId
+	PollingInterval      time.Duration
+	InterestingContracts []string
+
+	// WebsocketURL enables subscription mode, see EVMChainPollerConfig
+	WebsocketURL string
+
+	// BlockCacheSize is the number of recent blocks, with their logs, kept for the pollers reading from the feed
+	BlockCacheSize int
+
+	// LogRequestTimeout bounds each eth_getLogs request. Defaults to 30s
+	LogRequestTimeout time.Duration
+}
+
+type taggedHead struct {
+	number    uint64
+	checkedAt time.Time
+}
+
+type cachedBlock struct {
+	block *ethereum.EthereumBlock
+	// logs are the logs of the interesting contracts in the block, by lowercase contract address
+	logs map[string][]*ethereum.EthereumEventLog
+}
+
+// BlockFeed is the block ingestion shared by every AVS on a chain. It follows the
+// chain head, fetches each new block and the logs of the interesting contracts
+// once, and notifies the subscribed pollers.
+//
+// BlockFeed implements ethereum.Client so that each AVS keeps its own EVMChainPoller,
+// with its own checkpoint and reorg handling, reading from the feed instead of the
+// chain. Reads of blocks the feed has not cached, e.g. while a poller catches up or
+// walks back through a reorg, go to the chain.
+type BlockFeed struct {
+	ethClient ethereum.Client
+	config    *BlockFeedConfig
+	logger    *zap.Logger
+	watcher   *blockWatcher
+	contracts []string
+	chainId   string
+
+	mu sync.Mutex
+	// head is the highest block in the cache
+	head uint64
+	// headCheckedAt is when the chain head was last checked
+	headCheckedAt time.Time
+	blocks        map[uint64]*cachedBlock
+	// taggedHeads are the recently resolved safe and finalized blocks
+	taggedHeads map[ethereum.BlockType]taggedHead
+
+	subscribers      map[uint64]chan struct{}
+	nextSubscriberId uint64
+}
+
+func NewBlockFeed(ethClient ethereum.Client, config *BlockFeedConfig, logger *zap.Logger) *BlockFeed {
+	if config.BlockCacheSize <= 0 {
+		config.BlockCacheSize = DefaultBlockFeedCacheSize
+	}
+	if config.LogRequestTimeout <= 0 {
+		config.LogRequestTimeout = DefaultLogRequestTimeout
+	}
+
+	feedLogger := logger.With(zap.Uint("chainId", uint(config.ChainId)))
+	contracts := make([]string, 0, len(config.InterestingContracts))
+	for _, contract := range config.InterestingContracts {
+		if contract != "" {
+			contracts = append(contracts, strings.ToLower(contract))
+		}
+	}
+
+	feed := &BlockFeed{
+		ethClient:   ethClient,
+		config:      config,
+		logger:      feedLogger,
+		contracts:   contracts,
+		chainId:     fmt.Sprintf("%d", config.ChainId),
+		blocks:      make(map[uint64]*cachedBlock),
+		taggedHeads: make(map[ethereum.BlockType]taggedHead),
+		subscribers: make(map[uint64]chan struct{}),
+	}
+	feed.watcher = &blockWatcher{
+		pollingInterval: config.PollingInterval,
+		logger:          feedLogger,
+	}
+	if config.WebsocketURL != "" {
+		feed.watcher.listenForNewHeads = websocketHeadsListener(ethClient, config.WebsocketURL)
+		feed.watcher.onSubscribedChange = func(subscribed bool) {
+			value := 0.0
+			if subscribed {
+				value = 1
+			}
+			metrics.AggregatorChainBlockFeedSubscribed.WithLabelValues(feed.chainId).Set(value)
+		}
+		if feed.watcher.listenForNewHeads == nil {
+			feedLogger.Sugar().Warnw("Ethereum client does not support new heads subscriptions, polling instead")
+		}
+	}
+	return feed
+}
+
+// Start follows the chain head in the background until ctx is done
+func (f *BlockFeed) Start(ctx context.Context) {
+	f.logger.Sugar().Infow("Starting block feed",
+		zap.Duration("pollingInterval", f.config.PollingInterval),
+		zap.Int("blockCacheSize", f.config.BlockCacheSize),
+	)
+	go f.watcher.watch(ctx, f.fetchNewBlocks)
+}
+
+// SubscribeToBlocks calls the handler with the header of the latest cached block
+// every time the feed caches new blocks, until ctx is done
+func (f *BlockFeed) SubscribeToBlocks(ctx context.Context, handler func(header *ethTypes.Header) error) error {
+	ready := make(chan struct{}, 1)
+
+	f.mu.Lock()
+	id := f.nextSubscriberId
+	f.nextSubscriberId++
+	f.subscribers[id] = ready
+	f.mu.Unlock()
+
+	defer func() {
+		f.mu.Lock()
+		delete(f.subscribers, id)
+		f.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ready:
+			f.mu.Lock()
+			head := f.head
+			f.mu.Unlock()
+			if err := handler(&ethTypes.Header{Number: new(big.Int).SetUint64(head)}); err != nil {
+				f.logger.Sugar().Warnw("Block feed subscriber failed to handle block",
+					zap.Uint64("blockNumber", head),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+}
+
+// GetLatestBlock returns the highest cached block while the feed is keeping up
+// with the chain, and the chain head otherwise
+func (f *BlockFeed) GetLatestBlock(ctx context.Context) (uint64, error) {
+	f.mu.Lock()
+	head := f.head
+	fresh := head > 0 && time.Since(f.headCheckedAt) < 2*f.config.PollingInterval
+	f.mu.Unlock()
+
+	if fresh {
+		f.recordRequest("block_number", true)
+		return head, nil
+	}
+	f.recordRequest("block_number", false)
+	return f.ethClient.GetLatestBlock(ctx)
+}
+
+// GetBlockNumberByTag resolves a block tag, such as safe or finalized, once per
+// polling interval for every poller on the chain
+func (f *BlockFeed) GetBlockNumberByTag(ctx context.Context, tag ethereum.BlockType) (uint64, error) {
+	if tag == ethereum.BlockType_Latest {
+		return f.GetLatestBlock(ctx)
+	}
+
+	f.mu.Lock()
+	cached, ok := f.taggedHeads[tag]
+	f.mu.Unlock()
+	if ok && time.Since(cached.checkedAt) < f.config.PollingInterval {
+		f.recordRequest("block_number_by_tag", true)
+		return cached.number, nil
+	}
+
+	reader, ok := f.ethClient.(ethereum.TaggedBlockReader)
+	if !ok {
+		return 0, fmt.Errorf("ethereum client does not support block tags")
+	}
+	f.recordRequest("block_number_by_tag", false)
+	number, err := reader.GetBlockNumberByTag(ctx, tag)
+	if err != nil {
+		return 0, err
+	}
+
+	f.mu.Lock()
+	f.taggedHeads[tag] = taggedHead{number: number, checkedAt: time.Now()}
+	f.mu.Unlock()
+	return number, nil
+}
+
+func (f *BlockFeed) GetBlockByNumber(ctx context.Context, blockNumber uint64) (*ethereum.EthereumBlock, error) {
+	f.mu.Lock()
+	cached, ok := f.blocks[blockNumber]
+	f.mu.Unlock()
+
+	if ok {
+		f.recordRequest("block", true)
+		// pollers set the chain ID on the block they receive, so each gets its own copy
+		block := *cached.block
+		return &block, nil
+	}
+	f.recordRequest("block", false)
+	return f.ethClient.GetBlockByNumber(ctx, blockNumber)
+}
+
+func (f *BlockFeed) GetLogs(ctx context.Context, address string, fromBlock uint64, toBlock uint64) ([]*ethereum.EthereumEventLog, error) {
+	if fromBlock == toBlock {
+		f.mu.Lock()
+		cached, ok := f.blocks[fromBlock]
+		var logs []*ethereum.EthereumEventLog
+		if ok {
+			logs, ok = cached.logs[strings.ToLower(address)]
+		}
+		f.mu.Unlock()
+
+		if ok {
+			f.recordRequest("logs", true)
+			return logs, nil
+		}
+	}
+	f.recordRequest("logs", false)
+	return f.ethClient.GetLogs(ctx, address, fromBlock, toBlock)
+}
+
+// fetchNewBlocks caches every block between the last cached block and the chain
+// head, and notifies subscribers as each one is ready. Nothing is fetched while no
+// poller is subscribed.
+func (f *BlockFeed) fetchNewBlocks(ctx context.Context) {
+	f.mu.Lock()
+	subscribers := len(f.subscribers)
+	f.mu.Unlock()
+	if subscribers == 0 {
+		return
+	}
+
+	latestBlockNum, err := f.ethClient.GetLatestBlock(ctx)
+	if err != nil {
+		f.logger.Sugar().Errorw("Error getting latest block number", "error", err)
+		return
+	}
+
+	f.mu.Lock()
+	f.headCheckedAt = time.Now()
+	fromBlock := f.head + 1
+	if f.head == 0 {
+		// pollers that are further behind read the blocks they need from the chain
+		fromBlock = latestBlockNum
+	} else if latestBlockNum > f.head && latestBlockNum-f.head > uint64(f.config.BlockCacheSize) {
+		fromBlock = latestBlockNum - uint64(f.config.BlockCacheSize) + 1
+	}
+	f.mu.Unlock()
+
+	for blockNum := fromBlock; blockNum <= latestBlockNum; blockNum++ {
+		block, err := f.ethClient.GetBlockByNumber(ctx, blockNum)
+		if err != nil {
+			f.logger.Sugar().Errorw("Failed to fetch block",
+				zap.Uint64("blockNumber", blockNum),
+				zap.Error(err),
+			)
+			return
+		}
+		logs, err := f.fetchLogs(ctx, blockNum)
+		if err != nil {
+			f.logger.Sugar().Errorw("Failed to fetch logs for block",
+				zap.Uint64("blockNumber", blockNum),
+				zap.Error(err),
+			)
+			return
+		}
+		f.cacheBlock(&cachedBlock{block: block, logs: logs})
+		f.notifySubscribers()
+	}
+}
+
+// fetchLogs fetches the logs of every interesting contract in the block
+func (f *BlockFeed) fetchLogs(ctx context.Context, blockNumber uint64) (map[string][]*ethereum.EthereumEventLog, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, f.config.LogRequestTimeout)
+	defer cancel()
+
+	var mu sync.Mutex
+	logs := make(map[string][]*ethereum.EthereumEventLog, len(f.contracts))
+
+	g, gCtx := errgroup.WithContext(ctxWithTimeout)
+	for _, contract := range f.contracts {
+		g.Go(func() error {
+			contractLogs, err := f.ethClient.GetLogs(gCtx, contract, blockNumber, blockNumber)
+			if err != nil {
+				return fmt.Errorf("failed to fetch logs for contract %s: %w", contract, err)
+			}
+			if contractLogs == nil {
+				contractLogs = []*ethereum.EthereumEventLog{}
+			}
+			mu.Lock()
+			logs[contract] = contractLogs
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+func (f *BlockFeed) cacheBlock(cached *cachedBlock) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	blockNum := cached.block.Number.Value()
+	if parent, ok := f.blocks[blockNum-1]; ok && parent.block.Hash.Value() != cached.block.ParentHash.Value() {
+		// pollers detect the reorg themselves and read the new canonical blocks from the chain
+		f.logger.Sugar().Warnw("Blockchain reorganization detected, dropping cached blocks",
+			"blockNumber", blockNum,
+			"expectedParent", parent.block.Hash.Value(),
+			"actualParent", cached.block.ParentHash.Value(),
+		)
+		f.blocks = make(map[uint64]*cachedBlock)
+	}
+
+	f.blocks[blockNum] = cached
+	f.head = blockNum
+	for number := range f.blocks {
+		if number+uint64(f.config.BlockCacheSize) <= blockNum {
+			delete(f.blocks, number)
+		}
+	}
+}
+
+func (f *BlockFeed) notifySubscribers() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, ready := range f.subscribers {
+		// a pending notification already covers this block
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (f *BlockFeed) recordRequest(method string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	metrics.AggregatorChainBlockFeedRequests.WithLabelValues(f.chainId, method, result).Inc()
+}
@@ -0,0 +1,166 @@
+package EVMChainPoller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/mocks"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage/memory"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/ethereum"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+const testFeedContract = "0xmailbox"
+
+func newTestBlockFeed(t *testing.T) (*BlockFeed, *mocks.MockClient) {
+	ctrl := gomock.NewController(t)
+	mockClient := mocks.NewMockClient(ctrl)
+	feed := NewBlockFeed(mockClient, &BlockFeedConfig{
+		ChainId:              config.ChainId(1),
+		PollingInterval:      time.Hour,
+		InterestingContracts: []string{"0xMAILBOX"},
+	}, zap.NewNop())
+	return feed, mockClient
+}
+
+func newTestFeedBlock(number uint64, hash, parentHash string) *ethereum.EthereumBlock {
+	return &ethereum.EthereumBlock{
+		Number:     ethereum.EthereumQuantity(number),
+		Hash:       ethereum.EthereumHexString(hash),
+		ParentHash: ethereum.EthereumHexString(parentHash),
+		ChainId:    config.ChainId(1),
+	}
+}
+
+// subscribe registers a subscriber with the feed and returns a channel that
+// receives the block number of every notification
+func subscribe(t *testing.T, ctx context.Context, feed *BlockFeed) <-chan uint64 {
+	notified := make(chan uint64, 10)
+	go func() {
+		_ = feed.SubscribeToBlocks(ctx, func(header *ethTypes.Header) error {
+			notified <- header.Number.Uint64()
+			return nil
+		})
+	}()
+	require.Eventually(t, func() bool {
+		feed.mu.Lock()
+		defer feed.mu.Unlock()
+		return len(feed.subscribers) > 0
+	}, time.Second, time.Millisecond)
+	return notified
+}
+
+func Test_BlockFeed(t *testing.T) {
+	t.Run("fetches a block and its logs once for every reader", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		feed, mockClient := newTestBlockFeed(t)
+
+		logs := []*ethereum.EthereumEventLog{{Address: testFeedContract}}
+		mockClient.EXPECT().GetLatestBlock(gomock.Any()).Return(uint64(100), nil).Times(1)
+		mockClient.EXPECT().GetBlockByNumber(gomock.Any(), uint64(100)).Return(newTestFeedBlock(100, "0x100", "0x99"), nil).Times(1)
+		mockClient.EXPECT().GetLogs(gomock.Any(), testFeedContract, uint64(100), uint64(100)).Return(logs, nil).Times(1)
+
+		notified := subscribe(t, ctx, feed)
+		feed.fetchNewBlocks(ctx)
+		assert.Equal(t, uint64(100), <-notified)
+
+		for i := 0; i < 3; i++ {
+			head, err := feed.GetLatestBlock(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(100), head)
+
+			block, err := feed.GetBlockByNumber(ctx, 100)
+			require.NoError(t, err)
+			assert.Equal(t, "0x100", block.Hash.Value())
+
+			blockLogs, err := feed.GetLogs(ctx, "0xMailbox", 100, 100)
+			require.NoError(t, err)
+			assert.Equal(t, logs, blockLogs)
+		}
+	})
+
+	t.Run("reads blocks it has not cached from the chain", func(t *testing.T) {
+		feed, mockClient := newTestBlockFeed(t)
+
+		mockClient.EXPECT().GetLatestBlock(gomock.Any()).Return(uint64(100), nil).Times(1)
+		mockClient.EXPECT().GetBlockByNumber(gomock.Any(), uint64(50)).Return(newTestFeedBlock(50, "0x50", "0x49"), nil).Times(1)
+		mockClient.EXPECT().GetLogs(gomock.Any(), testFeedContract, uint64(50), uint64(60)).Return(nil, nil).Times(1)
+
+		head, err := feed.GetLatestBlock(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(100), head)
+		_, err = feed.GetBlockByNumber(context.Background(), 50)
+		require.NoError(t, err)
+		_, err = feed.GetLogs(context.Background(), testFeedContract, 50, 60)
+		require.NoError(t, err)
+	})
+
+	t.Run("drops cached blocks on a reorg", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		feed, mockClient := newTestBlockFeed(t)
+
+		gomock.InOrder(
+			mockClient.EXPECT().GetLatestBlock(gomock.Any()).Return(uint64(100), nil),
+			mockClient.EXPECT().GetBlockByNumber(gomock.Any(), uint64(100)).Return(newTestFeedBlock(100, "0x100", "0x99"), nil),
+			mockClient.EXPECT().GetLatestBlock(gomock.Any()).Return(uint64(101), nil),
+			mockClient.EXPECT().GetBlockByNumber(gomock.Any(), uint64(101)).Return(newTestFeedBlock(101, "0x101", "0x100b"), nil),
+			// the orphaned block is read from the chain again
+			mockClient.EXPECT().GetBlockByNumber(gomock.Any(), uint64(100)).Return(newTestFeedBlock(100, "0x100b", "0x99"), nil),
+		)
+		mockClient.EXPECT().GetLogs(gomock.Any(), testFeedContract, gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+
+		subscribe(t, ctx, feed)
+		feed.fetchNewBlocks(ctx)
+		feed.fetchNewBlocks(ctx)
+
+		block, err := feed.GetBlockByNumber(ctx, 100)
+		require.NoError(t, err)
+		assert.Equal(t, "0x100b", block.Hash.Value())
+	})
+
+	t.Run("fetches nothing while no poller is subscribed", func(t *testing.T) {
+		feed, _ := newTestBlockFeed(t)
+		feed.fetchNewBlocks(context.Background())
+		assert.Empty(t, feed.blocks)
+	})
+
+	t.Run("keeps a checkpoint per AVS", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		feed, mockClient := newTestBlockFeed(t)
+		store := memory.NewInMemoryAggregatorStore()
+
+		mockClient.EXPECT().GetLatestBlock(gomock.Any()).Return(uint64(100), nil).Times(1)
+		mockClient.EXPECT().GetBlockByNumber(gomock.Any(), uint64(100)).Return(newTestFeedBlock(100, "0x100", "0x99"), nil).Times(1)
+		mockClient.EXPECT().GetLogs(gomock.Any(), testFeedContract, uint64(100), uint64(100)).Return(nil, nil).Times(1)
+
+		subscribe(t, ctx, feed)
+		feed.fetchNewBlocks(ctx)
+
+		for _, avsAddress := range []string{"0xavs1", "0xavs2"} {
+			require.NoError(t, store.SaveBlock(ctx, avsAddress, &storage.BlockRecord{
+				Number:  99,
+				Hash:    "0x99",
+				ChainId: config.ChainId(1),
+			}))
+
+			poller := createTestPoller(feed, store)
+			poller.config.AvsAddress = avsAddress
+			poller.config.InterestingContracts = []string{testFeedContract}
+			poller.processNextBlock(ctx)
+
+			checkpoint, err := store.GetLastProcessedBlock(ctx, avsAddress, config.ChainId(1))
+			require.NoError(t, err)
+			assert.Equal(t, uint64(100), checkpoint.Number)
+		}
+	})
+}
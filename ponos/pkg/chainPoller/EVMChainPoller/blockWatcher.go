@@ -0,0 +1,135 @@
+package EVMChainPoller
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/ethereum"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"go.uber.org/zap"
+)
+
+// newHeadsListener is implemented by clients that can stream new block headers
+type newHeadsListener interface {
+	GetWebsocketConnection(wsUrl string) (*ethclient.Client, error)
+	ListenForNewBlocks(ctx context.Context, wsc ethereum.HeadSubscriber, recvBlockHandler func(block *ethTypes.Header) error) error
+}
+
+// websocketHeadsListener returns a function that streams new block headers from
+// the websocket endpoint, or nil if the client cannot subscribe to new heads
+func websocketHeadsListener(ethClient ethereum.Client, wsURL string) func(ctx context.Context, handler func(header *ethTypes.Header) error) error {
+	listener, ok := ethClient.(newHeadsListener)
+	if !ok {
+		return nil
+	}
+	return func(ctx context.Context, handler func(header *ethTypes.Header) error) error {
+		wsc, err := listener.GetWebsocketConnection(wsURL)
+		if err != nil {
+			return fmt.Errorf("failed to connect to websocket: %w", err)
+		}
+		defer wsc.Close()
+		return listener.ListenForNewBlocks(ctx, wsc, handler)
+	}
+}
+
+// blockWatcher decides when to look for new blocks: as soon as a header arrives
+// while a new heads subscription is up, and on every polling interval otherwise
+type blockWatcher struct {
+	pollingInterval time.Duration
+	logger          *zap.Logger
+
+	// listenForNewHeads calls the handler for every new block header until ctx is
+	// done or the subscription drops. It is nil unless subscription mode is enabled.
+	listenForNewHeads func(ctx context.Context, handler func(header *ethTypes.Header) error) error
+	// onSubscribedChange, if set, is called when the subscription comes up or goes down
+	onSubscribedChange func(subscribed bool)
+
+	// subscribed is set while new heads are arriving over the subscription
+	subscribed atomic.Bool
+	// lastHeadAt is when the last header arrived, in Unix nanoseconds
+	lastHeadAt atomic.Int64
+}
+
+// watch calls onBlock every time a new block may be available, until ctx is done
+func (w *blockWatcher) watch(ctx context.Context, onBlock func(ctx context.Context)) {
+	ticker := time.NewTicker(w.pollingInterval)
+	defer ticker.Stop()
+
+	var newHeads <-chan struct{}
+	if w.listenForNewHeads != nil {
+		heads := make(chan struct{}, 1)
+		go w.subscribeToNewHeads(ctx, heads)
+		newHeads = heads
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Sugar().Infow("Polling loop context cancelled, stopping")
+			return
+		case <-newHeads:
+			onBlock(ctx)
+		case <-ticker.C:
+			if w.headsArriving(time.Now()) {
+				continue
+			}
+			onBlock(ctx)
+		}
+	}
+}
+
+// subscribeToNewHeads signals heads every time a new block header arrives, and
+// resubscribes after the polling interval whenever the subscription drops
+func (w *blockWatcher) subscribeToNewHeads(ctx context.Context, heads chan<- struct{}) {
+	for {
+		err := w.listenForNewHeads(ctx, func(header *ethTypes.Header) error {
+			w.lastHeadAt.Store(time.Now().UnixNano())
+			if !w.subscribed.Swap(true) {
+				w.logger.Sugar().Infow("Receiving new heads over subscription, processing blocks as they arrive",
+					zap.Uint64("blockNumber", header.Number.Uint64()),
+				)
+				w.setSubscribed(true)
+			}
+			// a pending signal already covers this block, the next check catches up to the head
+			select {
+			case heads <- struct{}{}:
+			default:
+			}
+			return nil
+		})
+		w.subscribed.Store(false)
+		w.setSubscribed(false)
+		if ctx.Err() != nil {
+			return
+		}
+
+		w.logger.Sugar().Warnw("New heads subscription dropped, falling back to polling",
+			zap.Error(err),
+			zap.Duration("resubscribeIn", w.pollingInterval),
+		)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.pollingInterval):
+		}
+	}
+}
+
+func (w *blockWatcher) setSubscribed(subscribed bool) {
+	if w.onSubscribedChange != nil {
+		w.onSubscribedChange(subscribed)
+	}
+}
+
+// headsArriving reports whether blocks are being picked up from the subscription,
+// i.e. it is up and a header arrived within the last polling interval, so that a
+// ticker poll is not needed
+func (w *blockWatcher) headsArriving(now time.Time) bool {
+	if !w.subscribed.Load() {
+		return false
+	}
+	return now.Sub(time.Unix(0, w.lastHeadAt.Load())) < w.pollingInterval
+}
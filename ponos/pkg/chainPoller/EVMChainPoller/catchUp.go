@@ -0,0 +1,246 @@
+package EVMChainPoller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/chainPoller"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/ethereum"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+	"go.uber.org/zap"
+)
+
+// errReorgWhileCatchingUp is returned when the first block of a catch-up window
+// does not extend the last processed block
+var errReorgWhileCatchingUp = errors.New("blockchain reorganization detected while catching up")
+
+// catchUp processes the blocks between the last processed block and the unsettled
+// blocks at the head (see unsettledDepth) in windows of CatchUpWindowSize blocks,
+// with one ranged eth_getLogs request per contract per window. Blocks that deep
+// are settled, so only the start of each window is checked against the last
+// processed block; the most recent blocks are left to block by block processing
+// with full reorg checks.
+//
+// When the poller is more than MaxCatchUpDepth blocks behind, the blocks before
+// that depth are skipped. It returns the last processed block.
+func (ecp *EVMChainPoller) catchUp(ctx context.Context, lastBlock *storage.BlockRecord, headBlockNum uint64) (*storage.BlockRecord, error) {
+	var err error
+	if ecp.config.MaxCatchUpDepth > 0 && headBlockNum-lastBlock.Number > ecp.config.MaxCatchUpDepth {
+		lastBlock, err = ecp.skipTo(ctx, lastBlock, headBlockNum-ecp.config.MaxCatchUpDepth)
+		if err != nil {
+			return lastBlock, err
+		}
+	}
+
+	unsettledDepth := ecp.unsettledDepth()
+	if headBlockNum <= unsettledDepth {
+		return lastBlock, nil
+	}
+	settledBlockNum := headBlockNum - unsettledDepth
+	if lastBlock.Number >= settledBlockNum {
+		return lastBlock, nil
+	}
+
+	ecp.logger.Sugar().Infow("Catching up to the chain head",
+		zap.Uint64("lastProcessedBlock", lastBlock.Number),
+		zap.Uint64("latestBlock", headBlockNum),
+		zap.Uint64("windowSize", ecp.config.CatchUpWindowSize),
+	)
+
+	for lastBlock.Number < settledBlockNum {
+		if err := ctx.Err(); err != nil {
+			return lastBlock, err
+		}
+		if ecp.pauseRequested.Load() {
+			return lastBlock, errPaused
+		}
+		fromBlock := lastBlock.Number + 1
+		toBlock := min(fromBlock+ecp.config.CatchUpWindowSize-1, settledBlockNum)
+
+		lastBlock, err = ecp.processBlockRange(ctx, lastBlock, fromBlock, toBlock)
+		if err != nil {
+			return lastBlock, err
+		}
+		ecp.recordLag(headBlockNum, lastBlock.Number)
+	}
+
+	ecp.logger.Sugar().Infow("Caught up to the chain head",
+		zap.Uint64("lastProcessedBlock", lastBlock.Number),
+		zap.Uint64("latestBlock", headBlockNum),
+	)
+	return lastBlock, nil
+}
+
+// skipTo moves the last processed block forward to blockNum without processing
+// the blocks in between
+func (ecp *EVMChainPoller) skipTo(ctx context.Context, lastBlock *storage.BlockRecord, blockNum uint64) (*storage.BlockRecord, error) {
+	block, err := ecp.ethClient.GetBlockByNumber(ctx, blockNum)
+	if err != nil {
+		return lastBlock, fmt.Errorf("failed to fetch block %d: %w", blockNum, err)
+	}
+
+	blockRecord := ecp.newBlockRecord(block)
+	if err := ecp.store.SaveBlock(ctx, ecp.config.AvsAddress, blockRecord); err != nil {
+		return lastBlock, fmt.Errorf("failed to save block %d: %w", blockNum, err)
+	}
+
+	skipped := blockNum - lastBlock.Number
+	ecp.logger.Sugar().Warnw("Poller is further behind the chain head than the maximum catch-up depth, skipping blocks",
+		zap.Uint64("lastProcessedBlock", lastBlock.Number),
+		zap.Uint64("resumeFromBlock", blockNum+1),
+		zap.Uint64("skippedBlocks", skipped),
+		zap.Uint64("maxCatchUpDepth", ecp.config.MaxCatchUpDepth),
+	)
+	metrics.AggregatorChainPollerBlocksSkipped.WithLabelValues(ecp.config.AvsAddress, ecp.chainIdLabel()).Add(float64(skipped))
+	return blockRecord, nil
+}
+
+// processBlockRange handles the logs of every block from fromBlock to toBlock and
+// records toBlock as the last processed block
+func (ecp *EVMChainPoller) processBlockRange(ctx context.Context, lastBlock *storage.BlockRecord, fromBlock, toBlock uint64) (*storage.BlockRecord, error) {
+	firstBlock, err := ecp.ethClient.GetBlockByNumber(ctx, fromBlock)
+	if err != nil {
+		return lastBlock, fmt.Errorf("failed to fetch block %d: %w", fromBlock, err)
+	}
+	if firstBlock.ParentHash.Value() != lastBlock.Hash {
+		ecp.logger.Sugar().Warnw("Blockchain reorganization detected",
+			"blockNumber", fromBlock,
+			"expectedParent", lastBlock.Hash,
+			"actualParent", firstBlock.ParentHash.Value(),
+			"chainId", ecp.config.ChainId)
+
+		if err := ecp.reconcileReorg(ctx, firstBlock); err != nil {
+			ecp.logger.Sugar().Errorw("Failed to reconcile reorg", "error", err)
+		}
+		return lastBlock, errReorgWhileCatchingUp
+	}
+
+	lastBlockInRange := firstBlock
+	if toBlock != fromBlock {
+		lastBlockInRange, err = ecp.ethClient.GetBlockByNumber(ctx, toBlock)
+		if err != nil {
+			return lastBlock, fmt.Errorf("failed to fetch block %d: %w", toBlock, err)
+		}
+	}
+
+	logs, err := ecp.fetchLogsForInterestingContracts(ctx, fromBlock, toBlock)
+	if err != nil {
+		return lastBlock, err
+	}
+	sort.SliceStable(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].LogIndex < logs[j].LogIndex
+	})
+
+	for _, log := range logs {
+		if log.Removed {
+			continue
+		}
+		decodedLog, err := ecp.logParser.DecodeLog(nil, log)
+		if err != nil {
+			ecp.logger.Sugar().Errorw("Failed to decode log",
+				zap.String("transactionHash", log.TransactionHash.Value()),
+				zap.String("logAddress", log.Address.Value()),
+				zap.Uint64("logIndex", log.LogIndex.Value()),
+				zap.Error(err),
+			)
+			return lastBlock, err
+		}
+
+		// tasks only need the number and hash of their block, which the log carries
+		lwb := &chainPoller.LogWithBlock{
+			Block: &ethereum.EthereumBlock{
+				Number:  log.BlockNumber,
+				Hash:    log.BlockHash,
+				ChainId: ecp.config.ChainId,
+			},
+			RawLog: log,
+			Log:    decodedLog,
+		}
+		if err := ecp.handleLog(ctx, lwb); err != nil {
+			return lastBlock, err
+		}
+	}
+
+	blockRecord := ecp.newBlockRecord(lastBlockInRange)
+	if err := ecp.store.SaveBlock(ctx, ecp.config.AvsAddress, blockRecord); err != nil {
+		ecp.logger.Sugar().Warnw("Failed to save block info",
+			"error", err,
+			"blockNumber", blockRecord.Number)
+	}
+	if ecp.config.BlockHistorySize > 0 && lastBlock.Number+uint64(ecp.config.BlockHistorySize) <= toBlock {
+		if err := ecp.store.DeleteBlock(ctx, ecp.config.AvsAddress, ecp.config.ChainId, lastBlock.Number); err != nil {
+			ecp.logger.Sugar().Debugw("Failed to prune old block",
+				"blockNumber", lastBlock.Number,
+				"error", err)
+		}
+	}
+
+	ecp.logger.Sugar().Infow("Processed block range",
+		zap.Uint64("fromBlock", fromBlock),
+		zap.Uint64("toBlock", toBlock),
+		zap.Int("logCount", len(logs)),
+	)
+	metrics.AggregatorChainPollerCatchUpBlocks.WithLabelValues(ecp.config.AvsAddress, ecp.chainIdLabel()).Add(float64(toBlock - fromBlock + 1))
+	return blockRecord, nil
+}
+
+// handleExpiredTask applies the expired task policy to a task whose deadline
+// passed before it was ingested, typically one found while catching up. It is
+// never queued for processing.
+func (ecp *EVMChainPoller) handleExpiredTask(ctx context.Context, task *types.Task) {
+	ecp.logger.Sugar().Warnw("Task deadline passed before it was ingested",
+		"taskId", task.TaskId,
+		"deadline", task.DeadlineUnixSeconds.Unix(),
+		"sourceBlockNumber", task.SourceBlockNumber,
+		"skipped", ecp.config.SkipExpiredTasks)
+	metrics.AggregatorTasksExpired.WithLabelValues(ecp.config.AvsAddress, ecp.chainIdLabel()).Inc()
+
+	if ecp.config.SkipExpiredTasks {
+		return
+	}
+
+	if err := ecp.store.SavePendingTask(ctx, task); err != nil {
+		ecp.logger.Sugar().Errorw("Failed to save expired task to storage",
+			"error", err,
+			"taskId", task.TaskId)
+		return
+	}
+	if err := ecp.store.UpdateTaskStatus(ctx, task.TaskId, storage.TaskStatusFailed); err != nil {
+		ecp.logger.Sugar().Warnw("Failed to mark expired task as failed",
+			"error", err,
+			"taskId", task.TaskId)
+	}
+	outcome := &storage.TaskOutcome{Error: "deadline passed before the task was ingested"}
+	if err := ecp.store.SaveTaskOutcome(ctx, task.TaskId, outcome); err != nil {
+		ecp.logger.Sugar().Warnw("Failed to save task outcome",
+			"error", err,
+			"taskId", task.TaskId)
+	}
+}
+
+// taskExpired reports whether the task's deadline has passed
+func taskExpired(task *types.Task, now time.Time) bool {
+	return task.DeadlineUnixSeconds != nil && now.After(*task.DeadlineUnixSeconds)
+}
+
+func (ecp *EVMChainPoller) newBlockRecord(block *ethereum.EthereumBlock) *storage.BlockRecord {
+	return &storage.BlockRecord{
+		Number:     block.Number.Value(),
+		Hash:       block.Hash.Value(),
+		ParentHash: block.ParentHash.Value(),
+		Timestamp:  block.Timestamp.Value(),
+		ChainId:    ecp.config.ChainId,
+	}
+}
+
+func (ecp *EVMChainPoller) chainIdLabel() string {
+	return fmt.Sprintf("%d", ecp.config.ChainId)
+}
@@ -0,0 +1,205 @@
+package EVMChainPoller
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/mocks"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage/memory"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/chainPoller"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/ethereum"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contracts"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/transactionLogParser/log"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+const catchUpAvsAddress = "0x0000000000000000000000000000000000000001"
+
+type catchUpTest struct {
+	poller              *EVMChainPoller
+	client              *mocks.MockClient
+	logParser           *mocks.MockLogParser
+	contractStore       *mocks.MockIContractStore
+	blockContextManager *mocks.MockIBlockContextManager
+	store               storage.AggregatorStore
+	taskQueue           chan *types.Task
+	checkpoint          *storage.BlockRecord
+}
+
+func newCatchUpTest(t *testing.T, pollerConfig *EVMChainPollerConfig) *catchUpTest {
+	ctrl := gomock.NewController(t)
+	ct := &catchUpTest{
+		client:              mocks.NewMockClient(ctrl),
+		logParser:           mocks.NewMockLogParser(ctrl),
+		contractStore:       mocks.NewMockIContractStore(ctrl),
+		blockContextManager: mocks.NewMockIBlockContextManager(ctrl),
+		store:               memory.NewInMemoryAggregatorStore(),
+		taskQueue:           make(chan *types.Task, 10),
+		checkpoint: &storage.BlockRecord{
+			Number:  100,
+			Hash:    "0x100",
+			ChainId: config.ChainId(1),
+		},
+	}
+	pollerConfig.AvsAddress = catchUpAvsAddress
+	pollerConfig.ChainId = config.ChainId(1)
+	pollerConfig.InterestingContracts = []string{"0xmailbox"}
+	ct.poller = NewEVMChainPoller(ct.client, ct.taskQueue, ct.logParser, pollerConfig, ct.contractStore, ct.store, ct.blockContextManager, zap.NewNop())
+	require.NoError(t, ct.store.SaveBlock(context.Background(), catchUpAvsAddress, ct.checkpoint))
+	return ct
+}
+
+// expectBlock serves a block whose hash is derived from its number, so that
+// consecutive blocks chain together
+func (ct *catchUpTest) expectBlock(number uint64) {
+	ct.client.EXPECT().GetBlockByNumber(gomock.Any(), number).Return(&ethereum.EthereumBlock{
+		Number:     ethereum.EthereumQuantity(number),
+		Hash:       ethereum.EthereumHexString(blockHash(number)),
+		ParentHash: ethereum.EthereumHexString(blockHash(number - 1)),
+		ChainId:    config.ChainId(1),
+	}, nil)
+}
+
+func blockHash(number uint64) string {
+	return fmt.Sprintf("0x%d", number)
+}
+
+func newTaskCreatedLog(taskId string, deadline time.Time) *log.DecodedLog {
+	return &log.DecodedLog{
+		EventName: "TaskCreated",
+		Address:   "0xmailbox",
+		Arguments: []log.Argument{
+			{Name: "creator", Value: "0xmailbox", Indexed: true, Type: "address"},
+			{Name: "taskHash", Value: taskId, Indexed: true, Type: "bytes32"},
+			{Name: "avs", Value: common.HexToAddress(catchUpAvsAddress), Indexed: true, Type: "address"},
+		},
+		OutputData: map[string]interface{}{
+			"ExecutorOperatorSetId":           uint32(1),
+			"OperatorTableReferenceTimestamp": uint32(1234567890),
+			"TaskDeadline":                    big.NewInt(deadline.Unix()),
+			"Payload":                         []byte("test-payload"),
+		},
+	}
+}
+
+func Test_CatchUp(t *testing.T) {
+	t.Run("fetches logs in windows up to the reorg depth", func(t *testing.T) {
+		ct := newCatchUpTest(t, &EVMChainPollerConfig{MaxReorgDepth: 10, CatchUpWindowSize: 500})
+		ctx := context.Background()
+
+		ct.expectBlock(101)
+		ct.expectBlock(600)
+		ct.expectBlock(601)
+		ct.expectBlock(1090)
+
+		taskLog := &ethereum.EthereumEventLog{
+			Address:     "0xmailbox",
+			BlockNumber: 250,
+			BlockHash:   ethereum.EthereumHexString(blockHash(250)),
+		}
+		ct.client.EXPECT().GetLogs(gomock.Any(), "0xmailbox", uint64(101), uint64(600)).Return([]*ethereum.EthereumEventLog{taskLog}, nil)
+		ct.client.EXPECT().GetLogs(gomock.Any(), "0xmailbox", uint64(601), uint64(1090)).Return(nil, nil)
+
+		ct.logParser.EXPECT().DecodeLog(nil, taskLog).Return(newTaskCreatedLog("0xtask1", time.Now().Add(time.Hour)), nil)
+		ct.contractStore.EXPECT().GetContractByNameForChainId(config.ContractName_TaskMailbox, config.ChainId(1)).
+			Return(&contracts.Contract{Address: "0xmailbox"}, nil)
+		ct.blockContextManager.EXPECT().GetContext(uint64(250), gomock.Any()).Return(ctx)
+
+		lastBlock, err := ct.poller.catchUp(ctx, ct.checkpoint, 1100)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1090), lastBlock.Number)
+
+		checkpoint, err := ct.store.GetLastProcessedBlock(ctx, catchUpAvsAddress, config.ChainId(1))
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1090), checkpoint.Number)
+
+		select {
+		case task := <-ct.taskQueue:
+			assert.Equal(t, "0xtask1", task.TaskId)
+			assert.Equal(t, uint64(250), task.SourceBlockNumber)
+			assert.Equal(t, blockHash(250), task.BlockHash)
+		default:
+			t.Fatal("task found while catching up was not queued")
+		}
+	})
+
+	t.Run("leaves blocks within the reorg depth to block by block processing", func(t *testing.T) {
+		ct := newCatchUpTest(t, &EVMChainPollerConfig{MaxReorgDepth: 10})
+
+		lastBlock, err := ct.poller.catchUp(context.Background(), ct.checkpoint, 110)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(100), lastBlock.Number)
+	})
+
+	t.Run("skips blocks beyond the maximum catch-up depth", func(t *testing.T) {
+		ct := newCatchUpTest(t, &EVMChainPollerConfig{MaxReorgDepth: 10, CatchUpWindowSize: 500, MaxCatchUpDepth: 200})
+
+		ct.expectBlock(9800)
+		ct.expectBlock(9801)
+		ct.expectBlock(9990)
+		ct.client.EXPECT().GetLogs(gomock.Any(), "0xmailbox", uint64(9801), uint64(9990)).Return(nil, nil)
+
+		lastBlock, err := ct.poller.catchUp(context.Background(), ct.checkpoint, 10_000)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(9990), lastBlock.Number)
+	})
+
+	t.Run("stops at a reorg on the window boundary", func(t *testing.T) {
+		ct := newCatchUpTest(t, &EVMChainPollerConfig{MaxReorgDepth: 10, CatchUpWindowSize: 500})
+
+		ct.client.EXPECT().GetBlockByNumber(gomock.Any(), uint64(101)).Return(&ethereum.EthereumBlock{
+			Number:     101,
+			Hash:       "0x101b",
+			ParentHash: "0x100b",
+			ChainId:    config.ChainId(1),
+		}, nil)
+		ct.client.EXPECT().GetBlockByNumber(gomock.Any(), gomock.Any()).Return(&ethereum.EthereumBlock{
+			Number:  100,
+			Hash:    "0x100b",
+			ChainId: config.ChainId(1),
+		}, nil).AnyTimes()
+		ct.client.EXPECT().GetLogs(gomock.Any(), "0xmailbox", gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+		lastBlock, err := ct.poller.catchUp(context.Background(), ct.checkpoint, 1100)
+		require.ErrorIs(t, err, errReorgWhileCatchingUp)
+		assert.Equal(t, uint64(100), lastBlock.Number)
+	})
+}
+
+func Test_ExpiredTaskPolicy(t *testing.T) {
+	for _, skip := range []bool{false, true} {
+		ct := newCatchUpTest(t, &EVMChainPollerConfig{SkipExpiredTasks: skip})
+		ctx := context.Background()
+
+		ct.blockContextManager.EXPECT().GetContext(uint64(100), gomock.Any()).Return(ctx)
+		lwb := &chainPoller.LogWithBlock{
+			Block: &ethereum.EthereumBlock{
+				Number:  100,
+				Hash:    "0x100",
+				ChainId: config.ChainId(1),
+			},
+			RawLog: &ethereum.EthereumEventLog{Address: "0xmailbox"},
+			Log:    newTaskCreatedLog("0xexpired", time.Now().Add(-time.Minute)),
+		}
+		require.NoError(t, ct.poller.processTask(ctx, lwb))
+		assert.Empty(t, ct.taskQueue)
+
+		record, err := ct.store.GetTaskRecord(ctx, "0xexpired")
+		if skip {
+			assert.ErrorIs(t, err, storage.ErrNotFound)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, storage.TaskStatusFailed, record.Status)
+	}
+}
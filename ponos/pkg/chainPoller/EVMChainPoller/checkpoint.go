@@ -0,0 +1,155 @@
+package EVMChainPoller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrInvalidCheckpoint is returned when the checkpoint cannot be moved to the requested block
+	ErrInvalidCheckpoint = errors.New("invalid checkpoint")
+
+	// errPaused is returned by block processing that stopped early so the checkpoint can be moved
+	errPaused = errors.New("poller paused")
+)
+
+// Checkpoint is where the poller is on its chain
+type Checkpoint struct {
+	// LastProcessedBlock is the block the poller resumes after
+	LastProcessedBlock *storage.BlockRecord
+	// IngestionHead is the newest block the poller may process under its finality policy
+	IngestionHead uint64
+}
+
+// CheckpointMove is the result of rewinding or fast-forwarding the poller
+type CheckpointMove struct {
+	Previous      *storage.BlockRecord
+	Current       *storage.BlockRecord
+	IngestionHead uint64
+}
+
+// GetCheckpoint returns the last block the poller processed and the chain's ingestion head
+func (ecp *EVMChainPoller) GetCheckpoint(ctx context.Context) (*Checkpoint, error) {
+	lastBlock, err := ecp.store.GetLastProcessedBlock(ctx, ecp.config.AvsAddress, ecp.config.ChainId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last processed block: %w", err)
+	}
+	head, err := ecp.ingestionHead(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the chain head: %w", err)
+	}
+	return &Checkpoint{LastProcessedBlock: lastBlock, IngestionHead: head}, nil
+}
+
+// Rewind moves the checkpoint back so that the poller processes the blocks after
+// blockNumber again. Tasks of those blocks that are already in storage are skipped
+// as duplicates; use Replay to process them again.
+func (ecp *EVMChainPoller) Rewind(ctx context.Context, blockNumber uint64) (*CheckpointMove, error) {
+	return ecp.moveCheckpoint(ctx, func(lastBlock *storage.BlockRecord, head uint64) (uint64, error) {
+		if blockNumber >= lastBlock.Number {
+			return 0, fmt.Errorf("%w: block %d is not before the last processed block %d", ErrInvalidCheckpoint, blockNumber, lastBlock.Number)
+		}
+		if ecp.config.MaxCatchUpDepth > 0 && blockNumber < head && head-blockNumber > ecp.config.MaxCatchUpDepth {
+			return 0, fmt.Errorf("%w: block %d is more than the maximum catch-up depth of %d blocks behind the head %d",
+				ErrInvalidCheckpoint, blockNumber, ecp.config.MaxCatchUpDepth, head)
+		}
+		return blockNumber, nil
+	})
+}
+
+// FastForward moves the checkpoint to the ingestion head, skipping every block in
+// between along with the tasks created in them
+func (ecp *EVMChainPoller) FastForward(ctx context.Context) (*CheckpointMove, error) {
+	return ecp.moveCheckpoint(ctx, func(lastBlock *storage.BlockRecord, head uint64) (uint64, error) {
+		if head <= lastBlock.Number {
+			return 0, fmt.Errorf("%w: last processed block %d is already at the head %d", ErrInvalidCheckpoint, lastBlock.Number, head)
+		}
+		return head, nil
+	})
+}
+
+// moveCheckpoint pauses block processing, saves the block chosen by target as the
+// last processed block and resumes processing from it. The stored block history
+// that no longer precedes the checkpoint is dropped; reorg checks read the chain
+// for blocks missing from the history.
+func (ecp *EVMChainPoller) moveCheckpoint(
+	ctx context.Context,
+	target func(lastBlock *storage.BlockRecord, head uint64) (uint64, error),
+) (*CheckpointMove, error) {
+	ecp.pause()
+	defer ecp.resume()
+
+	lastBlock, err := ecp.store.GetLastProcessedBlock(ctx, ecp.config.AvsAddress, ecp.config.ChainId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last processed block: %w", err)
+	}
+	head, err := ecp.ingestionHead(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the chain head: %w", err)
+	}
+
+	blockNumber, err := target(lastBlock, head)
+	if err != nil {
+		return nil, err
+	}
+	if blockNumber > head {
+		return nil, fmt.Errorf("%w: block %d is after the %s head %d", ErrInvalidCheckpoint, blockNumber, ecp.config.Finality, head)
+	}
+
+	block, err := ecp.ethClient.GetBlockByNumber(ctx, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block %d: %w", blockNumber, err)
+	}
+
+	checkpoint := ecp.newBlockRecord(block)
+	if err := ecp.store.SaveBlock(ctx, ecp.config.AvsAddress, checkpoint); err != nil {
+		return nil, fmt.Errorf("failed to save block %d: %w", blockNumber, err)
+	}
+	ecp.dropBlockHistory(ctx, lastBlock.Number, blockNumber)
+	ecp.recordLag(head, checkpoint.Number)
+
+	ecp.logger.Sugar().Infow("Moved chain poller checkpoint",
+		zap.String("avsAddress", ecp.config.AvsAddress),
+		zap.Uint64("previousBlock", lastBlock.Number),
+		zap.Uint64("block", checkpoint.Number),
+		zap.String("blockHash", checkpoint.Hash),
+		zap.Uint64("ingestionHead", head),
+	)
+	return &CheckpointMove{Previous: lastBlock, Current: checkpoint, IngestionHead: head}, nil
+}
+
+// dropBlockHistory deletes the stored blocks after blockNumber up to lastBlockNum,
+// or, when moving forward, every stored block up to lastBlockNum
+func (ecp *EVMChainPoller) dropBlockHistory(ctx context.Context, lastBlockNum, blockNumber uint64) {
+	var oldest uint64
+	if lastBlockNum > uint64(ecp.config.BlockHistorySize) {
+		oldest = lastBlockNum - uint64(ecp.config.BlockHistorySize) + 1
+	}
+	if blockNumber < lastBlockNum {
+		oldest = max(oldest, blockNumber+1)
+	}
+	for number := oldest; number <= lastBlockNum; number++ {
+		err := ecp.store.DeleteBlock(ctx, ecp.config.AvsAddress, ecp.config.ChainId, number)
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			ecp.logger.Sugar().Debugw("Failed to delete block history",
+				"blockNumber", number,
+				"error", err)
+		}
+	}
+}
+
+// pause stops block processing once the block in progress, or the catch-up window
+// in progress, is done. It returns once processing has stopped.
+func (ecp *EVMChainPoller) pause() {
+	ecp.pauseRequested.Store(true)
+	ecp.processingMu.Lock()
+}
+
+func (ecp *EVMChainPoller) resume() {
+	ecp.pauseRequested.Store(false)
+	ecp.processingMu.Unlock()
+}
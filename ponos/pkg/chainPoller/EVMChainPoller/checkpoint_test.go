@@ -0,0 +1,111 @@
+package EVMChainPoller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_Checkpoint(t *testing.T) {
+	t.Run("reports the last processed block and the ingestion head", func(t *testing.T) {
+		ct := newCatchUpTest(t, &EVMChainPollerConfig{Finality: FinalityConfirmations, Confirmations: 5})
+		ct.client.EXPECT().GetLatestBlock(gomock.Any()).Return(uint64(150), nil)
+
+		checkpoint, err := ct.poller.GetCheckpoint(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(100), checkpoint.LastProcessedBlock.Number)
+		assert.Equal(t, uint64(145), checkpoint.IngestionHead)
+	})
+
+	t.Run("rewinds to an earlier block and drops the history after it", func(t *testing.T) {
+		ct := newCatchUpTest(t, &EVMChainPollerConfig{})
+		ctx := context.Background()
+		for number := uint64(95); number < 100; number++ {
+			require.NoError(t, ct.store.SaveBlock(ctx, catchUpAvsAddress, &storage.BlockRecord{Number: number, Hash: blockHash(number), ChainId: config.ChainId(1)}))
+		}
+		require.NoError(t, ct.store.SaveBlock(ctx, catchUpAvsAddress, ct.checkpoint))
+		ct.client.EXPECT().GetLatestBlock(gomock.Any()).Return(uint64(150), nil)
+		ct.expectBlock(97)
+
+		move, err := ct.poller.Rewind(ctx, 97)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(100), move.Previous.Number)
+		assert.Equal(t, uint64(97), move.Current.Number)
+		assert.Equal(t, blockHash(97), move.Current.Hash)
+
+		lastBlock, err := ct.store.GetLastProcessedBlock(ctx, catchUpAvsAddress, config.ChainId(1))
+		require.NoError(t, err)
+		assert.Equal(t, uint64(97), lastBlock.Number)
+
+		_, err = ct.store.GetBlock(ctx, catchUpAvsAddress, config.ChainId(1), 96)
+		assert.NoError(t, err, "history before the checkpoint is kept")
+		for _, number := range []uint64{98, 99, 100} {
+			_, err = ct.store.GetBlock(ctx, catchUpAvsAddress, config.ChainId(1), number)
+			assert.ErrorIs(t, err, storage.ErrNotFound)
+		}
+	})
+
+	t.Run("only rewinds to blocks before the checkpoint and within the catch-up depth", func(t *testing.T) {
+		ct := newCatchUpTest(t, &EVMChainPollerConfig{MaxCatchUpDepth: 100})
+		ct.client.EXPECT().GetLatestBlock(gomock.Any()).Return(uint64(150), nil).Times(2)
+
+		_, err := ct.poller.Rewind(context.Background(), 100)
+		assert.ErrorIs(t, err, ErrInvalidCheckpoint)
+
+		_, err = ct.poller.Rewind(context.Background(), 20)
+		assert.ErrorIs(t, err, ErrInvalidCheckpoint)
+	})
+
+	t.Run("fast-forwards to the ingestion head", func(t *testing.T) {
+		ct := newCatchUpTest(t, &EVMChainPollerConfig{})
+		ct.client.EXPECT().GetLatestBlock(gomock.Any()).Return(uint64(150), nil)
+		ct.expectBlock(150)
+
+		move, err := ct.poller.FastForward(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(150), move.Current.Number)
+		assert.Equal(t, uint64(150), move.IngestionHead)
+
+		_, err = ct.store.GetBlock(context.Background(), catchUpAvsAddress, config.ChainId(1), 100)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("does not fast-forward a poller at the head", func(t *testing.T) {
+		ct := newCatchUpTest(t, &EVMChainPollerConfig{})
+		ct.client.EXPECT().GetLatestBlock(gomock.Any()).Return(uint64(100), nil)
+
+		_, err := ct.poller.FastForward(context.Background())
+		assert.ErrorIs(t, err, ErrInvalidCheckpoint)
+	})
+
+	t.Run("waits for the block in progress before moving the checkpoint", func(t *testing.T) {
+		ct := newCatchUpTest(t, &EVMChainPollerConfig{})
+		ct.client.EXPECT().GetLatestBlock(gomock.Any()).Return(uint64(150), nil)
+		ct.expectBlock(150)
+
+		ct.poller.processingMu.Lock()
+		moved := make(chan struct{})
+		go func() {
+			_, err := ct.poller.FastForward(context.Background())
+			assert.NoError(t, err)
+			close(moved)
+		}()
+
+		require.Eventually(t, ct.poller.pauseRequested.Load, time.Second, 10*time.Millisecond)
+		select {
+		case <-moved:
+			t.Fatal("checkpoint moved while a block was being processed")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		ct.poller.processingMu.Unlock()
+		<-moved
+		assert.False(t, ct.poller.pauseRequested.Load())
+	})
+}
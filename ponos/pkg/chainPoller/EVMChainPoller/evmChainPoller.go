@@ -4,14 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IAllocationManager"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/chainPoller"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contextManager"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contractStore"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
 
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/ethereum"
@@ -29,8 +33,43 @@ type EVMChainPollerConfig struct {
 	MaxReorgDepth     int
 	BlockHistorySize  int
 	ReorgCheckEnabled bool
+
+	// WebsocketURL enables subscription mode: blocks are processed as soon as their
+	// headers arrive over the websocket instead of on the next PollingInterval tick.
+	// While the subscription is down the poller falls back to polling.
+	WebsocketURL string
+
+	// LogRequestTimeout bounds each eth_getLogs request. Defaults to 30s
+	LogRequestTimeout time.Duration
+
+	// CatchUpWindowSize is the number of blocks covered by each ranged eth_getLogs
+	// request while the poller is more than MaxReorgDepth blocks behind the head.
+	// Defaults to 500
+	CatchUpWindowSize uint64
+	// MaxCatchUpDepth is the furthest behind the head the poller catches up from;
+	// older blocks are skipped. 0 means no limit
+	MaxCatchUpDepth uint64
+	// SkipExpiredTasks drops tasks whose deadline passed before they were ingested.
+	// By default they are recorded in storage as failed.
+	SkipExpiredTasks bool
+
+	// Finality is how settled a block must be before its tasks are ingested. Defaults to FinalityLatest
+	Finality FinalityPolicy
+	// Confirmations is the number of blocks built on a block before its tasks are
+	// ingested, with FinalityConfirmations
+	Confirmations uint64
+
+	// OnOperatorSetChanged is called with the id of an operator set of the AVS when an
+	// ingested block adds or removes one of its operators or slashes one, so state
+	// derived from its operator table can be dropped before the next table root
+	OnOperatorSetChanged func(operatorSetId uint32)
 }
 
+const (
+	DefaultLogRequestTimeout = 30 * time.Second
+	DefaultCatchUpWindowSize = 500
+)
+
 type EVMChainPoller struct {
 	ethClient           ethereum.Client
 	taskQueue           chan *types.Task
@@ -40,6 +79,14 @@ type EVMChainPoller struct {
 	logger              *zap.Logger
 	store               storage.AggregatorStore
 	blockContextManager contextManager.IBlockContextManager
+
+	// watcher triggers processing of new blocks
+	watcher *blockWatcher
+
+	// processingMu is held while blocks are processed, and while the checkpoint is moved
+	processingMu sync.Mutex
+	// pauseRequested makes block processing stop early so the checkpoint can be moved
+	pauseRequested atomic.Bool
 }
 
 func NewEVMChainPoller(
@@ -67,6 +114,15 @@ func NewEVMChainPoller(
 	if !config.ReorgCheckEnabled && config.MaxReorgDepth > 0 {
 		config.ReorgCheckEnabled = true
 	}
+	if config.LogRequestTimeout <= 0 {
+		config.LogRequestTimeout = DefaultLogRequestTimeout
+	}
+	if config.CatchUpWindowSize == 0 {
+		config.CatchUpWindowSize = DefaultCatchUpWindowSize
+	}
+	if config.Finality == "" {
+		config.Finality = FinalityLatest
+	}
 
 	for i, contract := range config.InterestingContracts {
 		logger.Sugar().Infof("InterestingContracts %d: %s\n", i, contract)
@@ -74,7 +130,7 @@ func NewEVMChainPoller(
 	pollerLogger := logger.With(
 		zap.Uint("chainId", uint(config.ChainId)),
 	)
-	return &EVMChainPoller{
+	poller := &EVMChainPoller{
 		ethClient:           ethClient,
 		logger:              pollerLogger,
 		taskQueue:           taskQueue,
@@ -84,6 +140,29 @@ func NewEVMChainPoller(
 		store:               store,
 		blockContextManager: blockContextManager,
 	}
+
+	poller.watcher = &blockWatcher{
+		pollingInterval: config.PollingInterval,
+		logger:          pollerLogger,
+	}
+	chainId := fmt.Sprintf("%d", config.ChainId)
+	if feed, ok := ethClient.(blockSubscriber); ok {
+		// blocks are fetched once for every AVS by the feed, which tells the poller when a new one is ready
+		poller.watcher.listenForNewHeads = feed.SubscribeToBlocks
+	} else if config.WebsocketURL != "" {
+		poller.watcher.listenForNewHeads = websocketHeadsListener(ethClient, config.WebsocketURL)
+		poller.watcher.onSubscribedChange = func(subscribed bool) {
+			value := 0.0
+			if subscribed {
+				value = 1
+			}
+			metrics.AggregatorChainPollerSubscribed.WithLabelValues(config.AvsAddress, chainId).Set(value)
+		}
+		if poller.watcher.listenForNewHeads == nil {
+			pollerLogger.Sugar().Warnw("Ethereum client does not support new heads subscriptions, polling instead")
+		}
+	}
+	return poller
 }
 
 func (ecp *EVMChainPoller) Start(ctx context.Context) error {
@@ -91,13 +170,14 @@ func (ecp *EVMChainPoller) Start(ctx context.Context) error {
 	ecp.logger.Sugar().Infow("Starting Ethereum Listener",
 		zap.Any("chainId", ecp.config.ChainId),
 		zap.Duration("pollingInterval", ecp.config.PollingInterval),
+		zap.String("finality", string(ecp.config.Finality)),
 	)
 
 	lastBlockRecord, err := ecp.store.GetLastProcessedBlock(ctx, ecp.config.AvsAddress, ecp.config.ChainId)
 
 	if err != nil {
 		ecp.logger.Sugar().Infow("Poller could not get last processed block so using latest block")
-		block, err := ecp.ethClient.GetLatestBlock(ctx)
+		block, err := ecp.ingestionHead(ctx)
 		if err != nil {
 			return fmt.Errorf("error getting latest block: %w", err)
 		}
@@ -125,12 +205,6 @@ func (ecp *EVMChainPoller) Start(ctx context.Context) error {
 		return fmt.Errorf("last processed block must exist")
 	}
 
-	if err := ecp.recoverInProgressTasks(ctx); err != nil {
-		ecp.logger.Sugar().Warnw("Failed to recover in-progress tasks",
-			"error", err,
-			"avsAddress", ecp.config.AvsAddress)
-	}
-
 	go ecp.pollForBlocks(ctx)
 
 	return nil
@@ -139,18 +213,11 @@ func (ecp *EVMChainPoller) Start(ctx context.Context) error {
 func (ecp *EVMChainPoller) pollForBlocks(ctx context.Context) {
 
 	ecp.logger.Sugar().Infow("Starting Ethereum Chain Listener poll loop")
-	ticker := time.NewTicker(ecp.config.PollingInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			ecp.logger.Sugar().Infow("Polling loop context cancelled, stopping")
-			return
-		case <-ticker.C:
-			ecp.processNextBlock(ctx)
-		}
-	}
+	ecp.watcher.watch(ctx, func(ctx context.Context) {
+		ecp.processingMu.Lock()
+		defer ecp.processingMu.Unlock()
+		ecp.processNextBlock(ctx)
+	})
 }
 
 func (ecp *EVMChainPoller) processNextBlock(ctx context.Context) {
@@ -161,12 +228,14 @@ func (ecp *EVMChainPoller) processNextBlock(ctx context.Context) {
 		return
 	}
 
-	latestBlockNum, err := ecp.ethClient.GetLatestBlock(ctx)
+	latestBlockNum, err := ecp.ingestionHead(ctx)
 	if err != nil {
 		ecp.logger.Sugar().Errorw("Error getting latest block number", "error", err)
 		return
 	}
 
+	ecp.recordLag(latestBlockNum, latestBlockRecord.Number)
+
 	if latestBlockRecord.Number == latestBlockNum {
 		ecp.logger.Sugar().Debugw("Skipping block processing as the last observed block is the same as the latest block",
 			zap.Uint64("lastObservedBlock", latestBlockRecord.Number),
@@ -175,6 +244,21 @@ func (ecp *EVMChainPoller) processNextBlock(ctx context.Context) {
 		return
 	}
 
+	if latestBlockNum > latestBlockRecord.Number {
+		latestBlockRecord, err = ecp.catchUp(ctx, latestBlockRecord, latestBlockNum)
+		if errors.Is(err, errReorgWhileCatchingUp) || errors.Is(err, errPaused) {
+			return
+		}
+		if err != nil {
+			ecp.logger.Sugar().Errorw("Error catching up to the chain head",
+				zap.Uint64("lastProcessedBlock", latestBlockRecord.Number),
+				zap.Uint64("latestBlock", latestBlockNum),
+				zap.Error(err),
+			)
+			return
+		}
+	}
+
 	var blocksToFetch []uint64
 	if latestBlockNum > latestBlockRecord.Number {
 		for i := latestBlockRecord.Number + 1; i <= latestBlockNum; i++ {
@@ -187,6 +271,9 @@ func (ecp *EVMChainPoller) processNextBlock(ctx context.Context) {
 	)
 
 	for _, blockNum := range blocksToFetch {
+		if ecp.pauseRequested.Load() {
+			return
+		}
 
 		newCanonBlock, err := ecp.ethClient.GetBlockByNumber(ctx, blockNum)
 		if err != nil {
@@ -218,6 +305,7 @@ func (ecp *EVMChainPoller) processNextBlock(ctx context.Context) {
 			)
 			return
 		}
+		ecp.recordLag(latestBlockNum, latestBlockRecord.Number)
 	}
 
 	ecp.logger.Sugar().Debugw("All blocks processed", zap.Any("blocksToFetch", blocksToFetch))
@@ -229,9 +317,18 @@ func (ecp *EVMChainPoller) processNextBlock(ctx context.Context) {
 	}
 }
 
+// recordLag reports how far the poller is behind the chain head
+func (ecp *EVMChainPoller) recordLag(headBlock, processedBlock uint64) {
+	var lag uint64
+	if headBlock > processedBlock {
+		lag = headBlock - processedBlock
+	}
+	metrics.AggregatorChainPollerLagBlocks.WithLabelValues(ecp.config.AvsAddress, fmt.Sprintf("%d", ecp.config.ChainId)).Set(float64(lag))
+}
+
 func (ecp *EVMChainPoller) processBlockLogs(ctx context.Context, block *ethereum.EthereumBlock) (*storage.BlockRecord, error) {
 
-	logs, err := ecp.fetchLogsForInterestingContractsForBlock(block.Number.Value())
+	logs, err := ecp.fetchLogsForInterestingContracts(ctx, block.Number.Value(), block.Number.Value())
 	if err != nil {
 		ecp.logger.Sugar().Errorw("Error fetching logs for block",
 			zap.Uint64("blockNumber", block.Number.Value()),
@@ -313,12 +410,13 @@ func (ecp *EVMChainPoller) listAllInterestingContracts() []string {
 	return contracts
 }
 
-func (ecp *EVMChainPoller) fetchLogsForInterestingContractsForBlock(blockNumber uint64) ([]*ethereum.EthereumEventLog, error) {
+// fetchLogsForInterestingContracts fetches the logs of every interesting contract
+// between fromBlock and toBlock, inclusive
+func (ecp *EVMChainPoller) fetchLogsForInterestingContracts(ctx context.Context, fromBlock, toBlock uint64) ([]*ethereum.EthereumEventLog, error) {
 
 	var wg sync.WaitGroup
 
-	// TODO: make this configurable in the future
-	ctxWithTimeout, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, ecp.config.LogRequestTimeout)
 	defer cancel()
 
 	allContracts := ecp.listAllInterestingContracts()
@@ -335,14 +433,16 @@ func (ecp *EVMChainPoller) fetchLogsForInterestingContractsForBlock(blockNumber
 
 			ecp.logger.Sugar().Debugw("Fetching logs for contract",
 				zap.String("contract", contract),
-				zap.Uint64("blockNumber", blockNumber),
+				zap.Uint64("fromBlock", fromBlock),
+				zap.Uint64("toBlock", toBlock),
 			)
 
-			logs, err := ecp.ethClient.GetLogs(ctxWithTimeout, contract, blockNumber, blockNumber)
+			logs, err := ecp.ethClient.GetLogs(ctxWithTimeout, contract, fromBlock, toBlock)
 			if err != nil {
 				ecp.logger.Sugar().Errorw("Failed to fetch logs for contract",
 					zap.String("contract", contract),
-					zap.Uint64("blockNumber", blockNumber),
+					zap.Uint64("fromBlock", fromBlock),
+					zap.Uint64("toBlock", toBlock),
 					zap.Error(err),
 				)
 				errorsChan <- fmt.Errorf("failed to fetch logs for contract %s: %w", contract, err)
@@ -352,7 +452,8 @@ func (ecp *EVMChainPoller) fetchLogsForInterestingContractsForBlock(blockNumber
 			if len(logs) == 0 {
 				ecp.logger.Sugar().Debugw("No logs found for contract",
 					zap.String("contract", contract),
-					zap.Uint64("blockNumber", blockNumber),
+					zap.Uint64("fromBlock", fromBlock),
+					zap.Uint64("toBlock", toBlock),
 				)
 				logResultsChan <- []*ethereum.EthereumEventLog{}
 				return
@@ -360,7 +461,8 @@ func (ecp *EVMChainPoller) fetchLogsForInterestingContractsForBlock(blockNumber
 
 			ecp.logger.Sugar().Infow("Fetched logs for contract",
 				zap.String("contract", contract),
-				zap.Uint64("blockNumber", blockNumber),
+				zap.Uint64("fromBlock", fromBlock),
+				zap.Uint64("toBlock", toBlock),
 				zap.Int("logCount", len(logs)),
 			)
 
@@ -374,7 +476,8 @@ func (ecp *EVMChainPoller) fetchLogsForInterestingContractsForBlock(blockNumber
 	close(errorsChan)
 
 	ecp.logger.Sugar().Debugw("All logs fetched for contracts",
-		zap.Uint64("blockNumber", blockNumber),
+		zap.Uint64("fromBlock", fromBlock),
+		zap.Uint64("toBlock", toBlock),
 	)
 
 	allErrors := make([]error, 0)
@@ -392,7 +495,8 @@ func (ecp *EVMChainPoller) fetchLogsForInterestingContractsForBlock(blockNumber
 	}
 
 	ecp.logger.Sugar().Infow("All logs fetched for contracts",
-		zap.Uint64("blockNumber", blockNumber),
+		zap.Uint64("fromBlock", fromBlock),
+		zap.Uint64("toBlock", toBlock),
 		zap.Int("logCount", len(allLogs)),
 	)
 
@@ -405,6 +509,19 @@ func (ecp *EVMChainPoller) handleLog(ctx context.Context, lwb *chainPoller.LogWi
 	ecp.logger.Sugar().Infow("Received log from chain poller",
 		zap.Any("log", lwb),
 	)
+
+	ecp.notifyOperatorSetChange(lwb)
+
+	isTaskCreated, err := ecp.isMailboxTaskCreated(lwb)
+	if err != nil || !isTaskCreated {
+		return err
+	}
+
+	return ecp.processTask(ctx, lwb)
+}
+
+// isMailboxTaskCreated reports whether the log is a TaskCreated event of the task mailbox
+func (ecp *EVMChainPoller) isMailboxTaskCreated(lwb *chainPoller.LogWithBlock) (bool, error) {
 	lg := lwb.Log
 
 	// Handle new task created
@@ -414,12 +531,12 @@ func (ecp *EVMChainPoller) handleLog(ctx context.Context, lwb *chainPoller.LogWi
 			zap.String("contractAddress", lg.Address),
 			zap.Strings("addresses", ecp.config.InterestingContracts),
 		)
-		return nil
+		return false, nil
 	}
 
 	mailboxContract, err := ecp.contractStore.GetContractByNameForChainId(config.ContractName_TaskMailbox, lwb.Block.ChainId)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	if mailboxContract == nil {
@@ -430,14 +547,50 @@ func (ecp *EVMChainPoller) handleLog(ctx context.Context, lwb *chainPoller.LogWi
 			zap.Uint64("blockNumber", lwb.Block.Number.Value()),
 			zap.String("transactionHash", lwb.RawLog.TransactionHash.Value()),
 		)
-		return nil
+		return false, nil
 	}
 
-	if !strings.EqualFold(lwb.Log.Address, mailboxContract.Address) {
-		return nil
+	return strings.EqualFold(lwb.Log.Address, mailboxContract.Address), nil
+}
+
+// operatorSetChangeEvents are the AllocationManager events that change the operators or
+// stake of an operator set, and with them its next operator table root
+var operatorSetChangeEvents = map[string]bool{
+	"OperatorAddedToOperatorSet":     true,
+	"OperatorRemovedFromOperatorSet": true,
+	"OperatorSlashed":                true,
+}
+
+var operatorSetType = reflect.TypeOf(IAllocationManager.OperatorSet{})
+
+// notifyOperatorSetChange calls OnOperatorSetChanged when the log changes one of the AVS's operator sets
+func (ecp *EVMChainPoller) notifyOperatorSetChange(lwb *chainPoller.LogWithBlock) {
+	lg := lwb.Log
+	if ecp.config.OnOperatorSetChanged == nil || !operatorSetChangeEvents[lg.EventName] {
+		return
 	}
 
-	return ecp.processTask(ctx, lwb)
+	// the ABI decoder unpacks the operatorSet tuple into an anonymous struct with the
+	// same fields as the binding's OperatorSet
+	value := reflect.ValueOf(lg.OutputData["operatorSet"])
+	if !value.IsValid() || !value.Type().ConvertibleTo(operatorSetType) {
+		ecp.logger.Sugar().Warnw("Event does not carry an operator set",
+			zap.String("eventName", lg.EventName),
+			zap.String("contractAddress", lg.Address),
+		)
+		return
+	}
+	operatorSet := value.Convert(operatorSetType).Interface().(IAllocationManager.OperatorSet)
+	if !strings.EqualFold(operatorSet.Avs.Hex(), ecp.config.AvsAddress) {
+		return
+	}
+
+	ecp.logger.Sugar().Infow("Operator set changed",
+		zap.String("eventName", lg.EventName),
+		zap.Uint32("operatorSetId", operatorSet.Id),
+		zap.Uint64("blockNumber", lwb.Block.Number.Value()),
+	)
+	ecp.config.OnOperatorSetChanged(operatorSet.Id)
 }
 
 func (ecp *EVMChainPoller) processTask(ctx context.Context, lwb *chainPoller.LogWithBlock) error {
@@ -480,10 +633,17 @@ func (ecp *EVMChainPoller) processTask(ctx context.Context, lwb *chainPoller.Log
 			"taskId", task.TaskId)
 	}
 
+	if taskExpired(task, time.Now()) {
+		ecp.handleExpiredTask(ctx, task)
+		return nil
+	}
+
 	select {
 	case ecp.taskQueue <- task:
 		ecp.logger.Sugar().Infow("Task in queue for processing",
 			"taskId", task.TaskId)
+		metrics.AggregatorTasksReceived.WithLabelValues(ecp.config.AvsAddress, fmt.Sprintf("%d", ecp.config.ChainId)).Inc()
+		metrics.AggregatorTasksQueued.WithLabelValues(ecp.config.AvsAddress).Set(float64(len(ecp.taskQueue)))
 
 		if err := ecp.store.SavePendingTask(ctx, task); err != nil {
 			ecp.logger.Sugar().Errorw("Failed to save task to storage",
@@ -514,91 +674,6 @@ func (ecp *EVMChainPoller) processTask(ctx context.Context, lwb *chainPoller.Log
 	return nil
 }
 
-func (ecp *EVMChainPoller) recoverInProgressTasks(ctx context.Context) error {
-
-	tasks, err := ecp.store.ListPendingTasksForAVS(ctx, ecp.config.AvsAddress)
-	if err != nil {
-		return fmt.Errorf("failed to list pending tasks for recovery: %w", err)
-	}
-
-	if len(tasks) == 0 {
-		ecp.logger.Sugar().Debugw("No pending tasks to recover",
-			"avsAddress", ecp.config.AvsAddress)
-		return nil
-	}
-
-	recovered := 0
-	expired := 0
-
-	for _, task := range tasks {
-
-		task.Context = ecp.blockContextManager.GetContext(task.SourceBlockNumber, task)
-
-		select {
-		case <-task.Context.Done():
-			ecp.logger.Sugar().Warnw("Skipping expired task during recovery",
-				"taskId", task.TaskId,
-				"deadline", task.DeadlineUnixSeconds.Unix())
-
-			if err := ecp.store.UpdateTaskStatus(ctx, task.TaskId, storage.TaskStatusFailed); err != nil {
-				ecp.logger.Sugar().Warnw("Failed to mark expired task as failed",
-					"error", err,
-					"taskId", task.TaskId)
-			}
-
-			expired++
-			continue
-
-		default:
-		}
-
-		select {
-		case ecp.taskQueue <- task:
-
-			recovered++
-			ecp.logger.Sugar().Infow("Re-queued recovered task",
-				"taskId", task.TaskId,
-				"avsAddress", ecp.config.AvsAddress)
-		case <-time.After(100 * time.Millisecond):
-			ecp.logger.Sugar().Warnw("Task queue full, cannot recover task",
-				"taskId", task.TaskId)
-			break
-		}
-	}
-
-	ecp.logger.Sugar().Infow("Task recovery completed",
-		"totalPending", len(tasks),
-		"recovered", recovered,
-		"expired", expired,
-		"avsAddress", ecp.config.AvsAddress)
-
-	return nil
-}
-
-func (ecp *EVMChainPoller) reconcileReorg(ctx context.Context, startBlock *ethereum.EthereumBlock) error {
-	orphanedBlocks, err := ecp.findOrphanedBlocks(ctx, startBlock, ecp.config.MaxReorgDepth)
-
-	if err != nil {
-		return err
-	}
-
-	if len(orphanedBlocks) == 0 {
-		return fmt.Errorf("no orphaned blocks found")
-	}
-
-	for _, orphanedBlock := range orphanedBlocks {
-
-		ecp.blockContextManager.CancelBlock(orphanedBlock.Number)
-
-		err = ecp.store.DeleteBlock(ctx, ecp.config.AvsAddress, orphanedBlock.ChainId, orphanedBlock.Number)
-		if err != nil && !errors.Is(err, storage.ErrNotFound) {
-			return fmt.Errorf("failed to delete orphaned block: %w", err)
-		}
-	}
-
-	return nil
-}
-
 func (ecp *EVMChainPoller) findOrphanedBlocks(ctx context.Context, startBlock *ethereum.EthereumBlock, maxDepth int) ([]*storage.BlockRecord, error) {
 	var parentBlockRecord *storage.BlockRecord
 	var orphanedBlocks []*storage.BlockRecord
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,10 +16,11 @@ import (
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/ethereum"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contextManager/taskBlockContextManager"
-	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/logger"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/transactionLogParser/log"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -333,7 +335,7 @@ func TestFindOrphanedBlocks_StateChanges(t *testing.T) {
 	assert.Equal(t, "0x98", savedBlock.ParentHash)
 }
 
-// Test reconcileReorg successfully deletes orphaned blocks
+// Test reconcileReorg replaces orphaned blocks with the canonical branch
 func TestReconcileReorg_Success_DeletesOrphanedBlocks(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -390,14 +392,11 @@ func TestReconcileReorg_Success_DeletesOrphanedBlocks(t *testing.T) {
 		ChainId:    config.ChainId(1),
 	}
 
-	mockClient.EXPECT().GetBlockByNumber(ctx, uint64(99)).Return(chainBlock99, nil)
-	mockClient.EXPECT().GetBlockByNumber(ctx, uint64(98)).Return(chainBlock98, nil)
+	// Blocks 98 and 99 are fetched again when the canonical branch is re-ingested
+	mockClient.EXPECT().GetBlockByNumber(ctx, uint64(99)).Return(chainBlock99, nil).Times(2)
+	mockClient.EXPECT().GetBlockByNumber(ctx, uint64(98)).Return(chainBlock98, nil).Times(2)
 	mockClient.EXPECT().GetBlockByNumber(ctx, uint64(97)).Return(chainBlock97, nil)
 
-	// Expect CancelBlock to be called for each orphaned block
-	mockBlockContextManager.EXPECT().CancelBlock(uint64(99))
-	mockBlockContextManager.EXPECT().CancelBlock(uint64(98))
-
 	poller := &EVMChainPoller{
 		ethClient:           mockClient,
 		store:               store,
@@ -416,14 +415,16 @@ func TestReconcileReorg_Success_DeletesOrphanedBlocks(t *testing.T) {
 	// Verify no error
 	assert.NoError(t, err)
 
-	// Verify orphaned blocks were deleted from storage
-	_, err = store.GetBlock(ctx, "0xtest", config.ChainId(1), 99)
-	assert.Error(t, err, "Block 99 should be deleted")
-	assert.True(t, errors.Is(err, storage.ErrNotFound))
+	// Verify orphaned blocks were replaced by the canonical branch
+	for _, number := range []uint64{98, 99, 100} {
+		block, err := store.GetBlock(ctx, "0xtest", config.ChainId(1), number)
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("0x%d_new", number), block.Hash)
+	}
 
-	_, err = store.GetBlock(ctx, "0xtest", config.ChainId(1), 98)
-	assert.Error(t, err, "Block 98 should be deleted")
-	assert.True(t, errors.Is(err, storage.ErrNotFound))
+	lastBlock, err := store.GetLastProcessedBlock(ctx, "0xtest", config.ChainId(1))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), lastBlock.Number)
 
 	// Verify common ancestor block 97 still exists
 	block97, err := store.GetBlock(ctx, "0xtest", config.ChainId(1), 97)
@@ -484,522 +485,6 @@ func TestReconcileReorg_NoOrphanedBlocks_ReturnsError(t *testing.T) {
 	assert.Contains(t, err.Error(), "no orphaned blocks found")
 }
 
-// Test that expired tasks are marked as failed during recovery
-func TestRecoverInProgressTasks_ExpiredTasks_MarkedAsFailed(t *testing.T) {
-	ctx := context.Background()
-	store := memory.NewInMemoryAggregatorStore()
-	taskQueue := make(chan *types.Task, 10)
-
-	// Create expired and non-expired deadlines
-	expiredDeadline := time.Now().Add(-1 * time.Hour)
-	validDeadline := time.Now().Add(1 * time.Hour)
-
-	// Create tasks with different deadlines
-	expiredTask1 := &types.Task{
-		TaskId:              "expired-task-1",
-		AVSAddress:          "0xtest",
-		DeadlineUnixSeconds: &expiredDeadline,
-		ReferenceTimestamp:  100,
-		SourceBlockNumber:   1000,
-	}
-	expiredTask2 := &types.Task{
-		TaskId:              "expired-task-2",
-		AVSAddress:          "0xtest",
-		DeadlineUnixSeconds: &expiredDeadline,
-		ReferenceTimestamp:  100,
-		SourceBlockNumber:   1001,
-	}
-	validTask := &types.Task{
-		TaskId:              "valid-task",
-		AVSAddress:          "0xtest",
-		DeadlineUnixSeconds: &validDeadline,
-		ReferenceTimestamp:  100,
-		SourceBlockNumber:   1002,
-	}
-
-	// Save all tasks as pending
-	require.NoError(t, store.SavePendingTask(ctx, expiredTask1))
-	require.NoError(t, store.SavePendingTask(ctx, expiredTask2))
-	require.NoError(t, store.SavePendingTask(ctx, validTask))
-
-	mockCtrl := gomock.NewController(t)
-	defer mockCtrl.Finish()
-	mockBlockContextManager := mocks.NewMockIBlockContextManager(mockCtrl)
-
-	// Setup mock to return expired context for expired tasks and valid context for valid task
-	expiredCtx, expiredCancel := context.WithCancel(ctx)
-	expiredCancel() // Cancel immediately to simulate expired context
-	validCtx, cancel := context.WithDeadline(ctx, validDeadline)
-	defer cancel()
-
-	mockBlockContextManager.EXPECT().GetContext(uint64(1000), expiredTask1).Return(expiredCtx)
-	mockBlockContextManager.EXPECT().GetContext(uint64(1001), expiredTask2).Return(expiredCtx)
-	mockBlockContextManager.EXPECT().GetContext(uint64(1002), validTask).Return(validCtx)
-
-	poller := &EVMChainPoller{
-		taskQueue:           taskQueue,
-		store:               store,
-		blockContextManager: mockBlockContextManager,
-		config: &EVMChainPollerConfig{
-			AvsAddress: "0xtest",
-			ChainId:    config.ChainId(1),
-		},
-		logger: zap.NewNop(),
-	}
-
-	// Execute recoverInProgressTasks
-	err := poller.recoverInProgressTasks(ctx)
-	assert.NoError(t, err)
-
-	// Verify only valid task is in the queue (expired tasks should not be queued)
-	assert.Equal(t, 1, len(taskQueue), "Only valid task should be in queue")
-	recoveredTask := <-taskQueue
-	assert.Equal(t, "valid-task", recoveredTask.TaskId)
-
-	// Verify expired tasks are no longer pending and valid task remains pending
-	pendingTasks, err := store.ListPendingTasksForAVS(ctx, "0xtest")
-	assert.NoError(t, err)
-	assert.Equal(t, 1, len(pendingTasks), "Valid task should remain pending until processed by AvsExecutionManager")
-	assert.Equal(t, "valid-task", pendingTasks[0].TaskId, "Only valid task should be pending")
-
-	// Verify expired tasks are not in the pending list
-	for _, task := range pendingTasks {
-		assert.NotContains(t, []string{"expired-task-1", "expired-task-2"}, task.TaskId,
-			"Expired tasks should not be in pending list after being marked as failed")
-	}
-}
-
-// Test that tasks are properly queued and their status is updated correctly
-func TestRecoverInProgressTasks_TasksQueued_StatusUpdated(t *testing.T) {
-	ctx := context.Background()
-	store := memory.NewInMemoryAggregatorStore()
-	taskQueue := make(chan *types.Task, 10)
-
-	validDeadline := time.Now().Add(1 * time.Hour)
-
-	// Create multiple valid tasks
-	task1 := &types.Task{
-		TaskId:              "task-1",
-		AVSAddress:          "0xtest",
-		DeadlineUnixSeconds: &validDeadline,
-		Payload:             []byte("payload-1"),
-		ReferenceTimestamp:  100,
-		SourceBlockNumber:   2000,
-	}
-	task2 := &types.Task{
-		TaskId:              "task-2",
-		AVSAddress:          "0xtest",
-		DeadlineUnixSeconds: &validDeadline,
-		Payload:             []byte("payload-2"),
-		ReferenceTimestamp:  100,
-		SourceBlockNumber:   2001,
-	}
-	task3 := &types.Task{
-		TaskId:              "task-3",
-		AVSAddress:          "0xtest",
-		DeadlineUnixSeconds: &validDeadline,
-		Payload:             []byte("payload-3"),
-		ReferenceTimestamp:  100,
-		SourceBlockNumber:   2002,
-	}
-
-	// Save all tasks as pending
-	require.NoError(t, store.SavePendingTask(ctx, task1))
-	require.NoError(t, store.SavePendingTask(ctx, task2))
-	require.NoError(t, store.SavePendingTask(ctx, task3))
-
-	// Verify tasks are initially in pending list
-	pendingBefore, err := store.ListPendingTasksForAVS(ctx, "0xtest")
-	assert.NoError(t, err)
-	assert.Equal(t, 3, len(pendingBefore), "Should have 3 pending tasks before recovery")
-
-	mockCtrl := gomock.NewController(t)
-	defer mockCtrl.Finish()
-	mockBlockContextManager := mocks.NewMockIBlockContextManager(mockCtrl)
-
-	// Setup mock to return valid contexts for all tasks
-	validCtx, cancel := context.WithDeadline(ctx, validDeadline)
-	defer cancel()
-
-	mockBlockContextManager.EXPECT().GetContext(uint64(2000), task1).Return(validCtx)
-	mockBlockContextManager.EXPECT().GetContext(uint64(2001), task2).Return(validCtx)
-	mockBlockContextManager.EXPECT().GetContext(uint64(2002), task3).Return(validCtx)
-
-	poller := &EVMChainPoller{
-		taskQueue:           taskQueue,
-		store:               store,
-		blockContextManager: mockBlockContextManager,
-		config: &EVMChainPollerConfig{
-			AvsAddress: "0xtest",
-			ChainId:    config.ChainId(1),
-		},
-		logger: zap.NewNop(),
-	}
-
-	// Execute recoverInProgressTasks
-	err = poller.recoverInProgressTasks(ctx)
-	assert.NoError(t, err)
-
-	// Verify all tasks are in the queue
-	assert.Equal(t, 3, len(taskQueue), "All 3 tasks should be in queue")
-
-	// Pull tasks from queue and verify they match what's in storage
-	tasksFromQueue := make(map[string]*types.Task)
-	for i := 0; i < 3; i++ {
-		task := <-taskQueue
-		tasksFromQueue[task.TaskId] = task
-
-		// Verify task data matches what was stored
-		storedTask, err := store.GetTask(ctx, task.TaskId)
-		assert.NoError(t, err)
-		assert.Equal(t, task.TaskId, storedTask.TaskId)
-		assert.Equal(t, task.AVSAddress, storedTask.AVSAddress)
-		assert.Equal(t, task.Payload, storedTask.Payload)
-	}
-
-	// Verify we got all expected tasks
-	assert.NotNil(t, tasksFromQueue["task-1"])
-	assert.NotNil(t, tasksFromQueue["task-2"])
-	assert.NotNil(t, tasksFromQueue["task-3"])
-
-	// Verify payloads match
-	assert.Equal(t, []byte("payload-1"), tasksFromQueue["task-1"].Payload)
-	assert.Equal(t, []byte("payload-2"), tasksFromQueue["task-2"].Payload)
-	assert.Equal(t, []byte("payload-3"), tasksFromQueue["task-3"].Payload)
-
-	// After recovery, tasks remain in pending status until processed by AvsExecutionManager
-	pendingAfter, err := store.ListPendingTasksForAVS(ctx, "0xtest")
-	assert.NoError(t, err)
-	assert.Equal(t, 3, len(pendingAfter), "All tasks should remain pending until processed by AvsExecutionManager")
-}
-
-// Test recovery with no pending tasks
-func TestRecoverInProgressTasks_NoPendingTasks_NoError(t *testing.T) {
-	ctx := context.Background()
-	store := memory.NewInMemoryAggregatorStore()
-	taskQueue := make(chan *types.Task, 10)
-
-	mockCtrl := gomock.NewController(t)
-	defer mockCtrl.Finish()
-	mockBlockContextManager := mocks.NewMockIBlockContextManager(mockCtrl)
-
-	poller := &EVMChainPoller{
-		taskQueue:           taskQueue,
-		store:               store,
-		blockContextManager: mockBlockContextManager,
-		config: &EVMChainPollerConfig{
-			AvsAddress: "0xtest",
-			ChainId:    config.ChainId(1),
-		},
-		logger: zap.NewNop(),
-	}
-
-	// Execute recoverInProgressTasks with no pending tasks
-	err := poller.recoverInProgressTasks(ctx)
-	assert.NoError(t, err)
-
-	// Verify queue is empty
-	assert.Equal(t, 0, len(taskQueue), "Queue should be empty")
-}
-
-// Test recovery when task queue is full
-func TestRecoverInProgressTasks_QueueFull_TasksRemainPending(t *testing.T) {
-	ctx := context.Background()
-	store := memory.NewInMemoryAggregatorStore()
-	taskQueue := make(chan *types.Task, 2) // Small queue that can only hold 2 tasks
-
-	validDeadline := time.Now().Add(1 * time.Hour)
-
-	// Create 4 tasks but queue can only hold 2
-	tasks := make([]*types.Task, 4)
-	for i := 0; i < 4; i++ {
-		tasks[i] = &types.Task{
-			TaskId:              fmt.Sprintf("task-%d", i+1),
-			AVSAddress:          "0xtest",
-			DeadlineUnixSeconds: &validDeadline,
-			ReferenceTimestamp:  100,
-			SourceBlockNumber:   uint64(3000 + i),
-		}
-		require.NoError(t, store.SavePendingTask(ctx, tasks[i]))
-	}
-
-	mockCtrl := gomock.NewController(t)
-	defer mockCtrl.Finish()
-	mockBlockContextManager := mocks.NewMockIBlockContextManager(mockCtrl)
-
-	// Setup mock to return valid contexts for all tasks
-	validCtx, cancel := context.WithDeadline(ctx, validDeadline)
-	defer cancel()
-
-	// We expect GetContext to be called for at least the first 2 tasks (queue capacity)
-	// Since the order of task recovery may vary, use AnyTimes()
-	mockBlockContextManager.EXPECT().GetContext(gomock.Any(), gomock.Any()).Return(validCtx).AnyTimes()
-
-	poller := &EVMChainPoller{
-		taskQueue:           taskQueue,
-		store:               store,
-		blockContextManager: mockBlockContextManager,
-		config: &EVMChainPollerConfig{
-			AvsAddress: "0xtest",
-			ChainId:    config.ChainId(1),
-		},
-		logger: zap.NewNop(),
-	}
-
-	// Execute recoverInProgressTasks
-	err := poller.recoverInProgressTasks(ctx)
-	assert.NoError(t, err)
-
-	// Only 2 tasks should be in queue
-	assert.Equal(t, 2, len(taskQueue), "Queue should contain only 2 tasks")
-
-	// Verify which tasks were queued
-	queuedTaskIds := make(map[string]bool)
-	for len(taskQueue) > 0 {
-		task := <-taskQueue
-		queuedTaskIds[task.TaskId] = true
-	}
-
-	// Exactly 2 tasks should have been queued
-	assert.Equal(t, 2, len(queuedTaskIds), "Exactly 2 tasks should have been queued")
-
-	// All tasks should still be in pending state (they're queued but not yet processed)
-	pendingTasks, err := store.ListPendingTasksForAVS(ctx, "0xtest")
-	assert.NoError(t, err)
-	// All 4 tasks remain pending until AvsExecutionManager processes them
-	assert.Equal(t, 4, len(pendingTasks), "All tasks remain pending until processed by AvsExecutionManager")
-}
-
-func TestPollerProcessesTasksFromStorage(t *testing.T) {
-	ctx := context.Background()
-	store := memory.NewInMemoryAggregatorStore()
-	avsAddress := "0xtest"
-
-	// Create tasks and save to storage (simulating tasks that need recovery)
-	validDeadline := time.Now().Add(1 * time.Hour)
-	task1 := &types.Task{
-		TaskId:              "task-1",
-		AVSAddress:          avsAddress,
-		DeadlineUnixSeconds: &validDeadline,
-		ReferenceTimestamp:  100,
-		SourceBlockNumber:   4000,
-	}
-	task2 := &types.Task{
-		TaskId:              "task-2",
-		AVSAddress:          avsAddress,
-		DeadlineUnixSeconds: &validDeadline,
-		ReferenceTimestamp:  100,
-		SourceBlockNumber:   4001,
-	}
-
-	// Pre-populate storage with pending tasks
-	require.NoError(t, store.SavePendingTask(ctx, task1))
-	require.NoError(t, store.SavePendingTask(ctx, task2))
-
-	// Create minimal poller to test recovery
-	l, err := logger.NewLogger(&logger.LoggerConfig{Debug: false})
-	require.NoError(t, err)
-
-	taskQueue := make(chan *types.Task, 10)
-
-	mockCtrl := gomock.NewController(t)
-	defer mockCtrl.Finish()
-	mockBlockContextManager := mocks.NewMockIBlockContextManager(mockCtrl)
-
-	// Setup mock to return valid contexts
-	validCtx, cancel := context.WithDeadline(ctx, validDeadline)
-	defer cancel()
-	mockBlockContextManager.EXPECT().GetContext(uint64(4000), task1).Return(validCtx)
-	mockBlockContextManager.EXPECT().GetContext(uint64(4001), task2).Return(validCtx)
-
-	poller := &EVMChainPoller{
-		config: &EVMChainPollerConfig{
-			AvsAddress: avsAddress,
-		},
-		taskQueue:           taskQueue,
-		store:               store,
-		blockContextManager: mockBlockContextManager,
-		logger:              l,
-	}
-
-	// Call recoverInProgressTasks directly - this loads from storage and queues
-	err = poller.recoverInProgressTasks(ctx)
-	require.NoError(t, err)
-
-	// Verify tasks were recovered and queued
-	receivedTasks := make(map[string]bool)
-
-	for i := 0; i < 2; i++ {
-		select {
-		case task := <-taskQueue:
-			receivedTasks[task.TaskId] = true
-			t.Logf("Received task: %s", task.TaskId)
-		case <-time.After(500 * time.Millisecond):
-			t.Fatal("Timeout waiting for task")
-		}
-	}
-
-	assert.True(t, receivedTasks["task-1"], "task-1 should be processed")
-	assert.True(t, receivedTasks["task-2"], "task-2 should be processed")
-
-	// Verify tasks are still accessible in storage
-	task1FromStore, err := store.GetTask(ctx, "task-1")
-	assert.NoError(t, err, "Should be able to get task-1 from store")
-	assert.Equal(t, "task-1", task1FromStore.TaskId)
-
-	task2FromStore, err := store.GetTask(ctx, "task-2")
-	assert.NoError(t, err, "Should be able to get task-2 from store")
-	assert.Equal(t, "task-2", task2FromStore.TaskId)
-}
-
-func TestPollerSkipsExpiredTasks(t *testing.T) {
-	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
-	defer cancel()
-
-	store := memory.NewInMemoryAggregatorStore()
-	avsAddress := "0xtest"
-
-	// Create mix of valid and expired tasks
-	validDeadline := time.Now().Add(1 * time.Hour)
-	expiredDeadline := time.Now().Add(-1 * time.Hour)
-
-	validTask := &types.Task{
-		TaskId:              "valid-task",
-		AVSAddress:          avsAddress,
-		DeadlineUnixSeconds: &validDeadline,
-		ReferenceTimestamp:  100,
-		SourceBlockNumber:   5000,
-	}
-	expiredTask := &types.Task{
-		TaskId:              "expired-task",
-		AVSAddress:          avsAddress,
-		DeadlineUnixSeconds: &expiredDeadline,
-		ReferenceTimestamp:  100,
-		SourceBlockNumber:   4999,
-	}
-
-	// Save both tasks to storage
-	require.NoError(t, store.SavePendingTask(ctx, validTask))
-	require.NoError(t, store.SavePendingTask(ctx, expiredTask))
-
-	// Create minimal poller
-	l, _ := logger.NewLogger(&logger.LoggerConfig{Debug: false})
-	taskQueue := make(chan *types.Task, 10)
-
-	mockCtrl := gomock.NewController(t)
-	defer mockCtrl.Finish()
-	mockBlockContextManager := mocks.NewMockIBlockContextManager(mockCtrl)
-
-	// Setup mock to return expired context for expired task and valid context for valid task
-	expiredCtx, expiredCancel := context.WithCancel(ctx)
-	expiredCancel() // Cancel immediately to simulate expired context
-	validCtx, cancel := context.WithDeadline(ctx, validDeadline)
-	defer cancel()
-
-	mockBlockContextManager.EXPECT().GetContext(uint64(5000), validTask).Return(validCtx)
-	mockBlockContextManager.EXPECT().GetContext(uint64(4999), expiredTask).Return(expiredCtx)
-
-	poller := &EVMChainPoller{
-		config: &EVMChainPollerConfig{
-			AvsAddress: avsAddress,
-		},
-		taskQueue:           taskQueue,
-		store:               store,
-		blockContextManager: mockBlockContextManager,
-		logger:              l,
-	}
-
-	// Call recoverInProgressTasks directly
-	err := poller.recoverInProgressTasks(ctx)
-	require.NoError(t, err)
-
-	// Should only receive the valid task
-	select {
-	case task := <-taskQueue:
-		assert.Equal(t, "valid-task", task.TaskId, "Should receive valid task")
-	case <-time.After(500 * time.Millisecond):
-		t.Fatal("Timeout waiting for valid task")
-	}
-
-	// Should not receive expired task
-	select {
-	case task := <-taskQueue:
-		t.Fatalf("Should not receive expired task, got: %s", task.TaskId)
-	case <-time.After(100 * time.Millisecond):
-		// Expected - no more tasks
-	}
-
-	// Verify expired task was marked as failed
-	err = store.UpdateTaskStatus(ctx, "expired-task", storage.TaskStatusCompleted)
-	assert.Error(t, err, "Expired task should be in failed state")
-}
-
-func TestPollerHandlesChannelFull(t *testing.T) {
-	ctx := context.Background()
-	store := memory.NewInMemoryAggregatorStore()
-	avsAddress := "0xtest"
-
-	// Create more tasks than channel capacity
-	validDeadline := time.Now().Add(1 * time.Hour)
-	tasks := make([]*types.Task, 5)
-	for i := 0; i < 5; i++ {
-		tasks[i] = &types.Task{
-			TaskId:              fmt.Sprintf("task-%d", i),
-			AVSAddress:          avsAddress,
-			DeadlineUnixSeconds: &validDeadline,
-			ReferenceTimestamp:  100,
-			SourceBlockNumber:   uint64(6000 + i),
-		}
-		require.NoError(t, store.SavePendingTask(ctx, tasks[i]))
-	}
-
-	// Create poller with small channel
-	l, _ := logger.NewLogger(&logger.LoggerConfig{Debug: false})
-	smallTaskQueue := make(chan *types.Task, 2)
-
-	mockCtrl := gomock.NewController(t)
-	defer mockCtrl.Finish()
-	mockBlockContextManager := mocks.NewMockIBlockContextManager(mockCtrl)
-
-	// Setup mock to return valid contexts
-	validCtx, cancel := context.WithDeadline(ctx, validDeadline)
-	defer cancel()
-	mockBlockContextManager.EXPECT().GetContext(gomock.Any(), gomock.Any()).Return(validCtx).AnyTimes()
-
-	poller := &EVMChainPoller{
-		config: &EVMChainPollerConfig{
-			AvsAddress: avsAddress,
-		},
-		taskQueue:           smallTaskQueue,
-		store:               store,
-		blockContextManager: mockBlockContextManager,
-		logger:              l,
-	}
-
-	// Call recovery directly
-	err := poller.recoverInProgressTasks(ctx)
-	require.NoError(t, err)
-
-	// Should receive first 2 tasks
-	received := 0
-	for i := 0; i < 2; i++ {
-		select {
-		case <-smallTaskQueue:
-			received++
-		case <-time.After(200 * time.Millisecond):
-			break
-		}
-	}
-
-	assert.Equal(t, 2, received, "Should receive 2 tasks (channel capacity)")
-
-	// Verify remaining tasks are still pending (not all could be queued)
-	pendingTasks, err := store.ListPendingTasksForAVS(ctx, avsAddress)
-	require.NoError(t, err)
-	// Some tasks should remain pending since channel was full
-	assert.True(t, len(pendingTasks) > 0, "Some tasks should remain pending due to full channel")
-}
-
 func TestEVMChainPoller_TaskContextAssignment(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1095,6 +580,76 @@ func TestEVMChainPoller_TaskContextAssignment(t *testing.T) {
 	}
 }
 
+// decodeOperatorSetEvent returns the output data of an AllocationManager event that carries
+// an operator set, decoded the way the log parser decodes it
+func decodeOperatorSetEvent(t *testing.T, avs common.Address, operatorSetId uint32) map[string]interface{} {
+	operatorSetType, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "avs", Type: "address"},
+		{Name: "id", Type: "uint32"},
+	})
+	require.NoError(t, err)
+	args := abi.Arguments{
+		{Name: "operator", Type: abi.Type{T: abi.AddressTy, Size: 20}},
+		{Name: "operatorSet", Type: operatorSetType},
+	}
+
+	operatorSet := struct {
+		Avs common.Address
+		Id  uint32
+	}{Avs: avs, Id: operatorSetId}
+	data, err := args.Pack(common.HexToAddress("0x00000000000000000000000000000000000000aa"), operatorSet)
+	require.NoError(t, err)
+
+	outputData := make(map[string]interface{})
+	require.NoError(t, args.UnpackIntoMap(outputData, data))
+	return outputData
+}
+
+func TestEVMChainPoller_NotifiesOperatorSetChanges(t *testing.T) {
+	avsAddress := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	otherAvsAddress := common.HexToAddress("0x0000000000000000000000000000000000000002")
+
+	tests := []struct {
+		name      string
+		eventName string
+		avs       common.Address
+		notified  bool
+	}{
+		{name: "operator added", eventName: "OperatorAddedToOperatorSet", avs: avsAddress, notified: true},
+		{name: "operator removed", eventName: "OperatorRemovedFromOperatorSet", avs: avsAddress, notified: true},
+		{name: "operator slashed", eventName: "OperatorSlashed", avs: avsAddress, notified: true},
+		{name: "operator set of another AVS", eventName: "OperatorAddedToOperatorSet", avs: otherAvsAddress},
+		{name: "unrelated event", eventName: "OperatorSetCreated", avs: avsAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			poller := createTestPoller(nil, memory.NewInMemoryAggregatorStore())
+			poller.config.AvsAddress = strings.ToLower(avsAddress.Hex())
+			var changed []uint32
+			poller.config.OnOperatorSetChanged = func(operatorSetId uint32) {
+				changed = append(changed, operatorSetId)
+			}
+
+			lwb := &chainPoller.LogWithBlock{
+				Block: &ethereum.EthereumBlock{Number: ethereum.EthereumQuantity(100), ChainId: config.ChainId(1)},
+				Log: &log.DecodedLog{
+					EventName:  tt.eventName,
+					Address:    "0xallocationmanager",
+					OutputData: decodeOperatorSetEvent(t, tt.avs, 3),
+				},
+			}
+			require.NoError(t, poller.handleLog(context.Background(), lwb))
+
+			if tt.notified {
+				assert.Equal(t, []uint32{3}, changed)
+			} else {
+				assert.Empty(t, changed)
+			}
+		})
+	}
+}
+
 func TestEVMChainPoller_ContextCancellationOnReorg(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1151,13 +706,21 @@ func TestEVMChainPoller_ContextCancellationOnReorg(t *testing.T) {
 		ChainId:    config.ChainId(1),
 	}
 
-	mockClient.EXPECT().GetBlockByNumber(ctx, uint64(99)).Return(chainBlock99, nil)
-	mockClient.EXPECT().GetBlockByNumber(ctx, uint64(98)).Return(chainBlock98, nil)
+	mockClient.EXPECT().GetBlockByNumber(ctx, uint64(99)).Return(chainBlock99, nil).Times(2)
+	mockClient.EXPECT().GetBlockByNumber(ctx, uint64(98)).Return(chainBlock98, nil).Times(2)
 	mockClient.EXPECT().GetBlockByNumber(ctx, uint64(97)).Return(chainBlock97, nil)
 
-	// Expect CancelBlock to be called for orphaned blocks
-	mockBlockContextManager.EXPECT().CancelBlock(uint64(99))
-	mockBlockContextManager.EXPECT().CancelBlock(uint64(98))
+	// The task of orphaned block 99 is not part of the canonical branch
+	require.NoError(t, store.SavePendingTask(ctx, &types.Task{
+		TaskId:            "0xorphaned",
+		AVSAddress:        "0xavs",
+		ChainId:           config.ChainId(1),
+		SourceBlockNumber: 99,
+		BlockHash:         "0x99_old",
+	}))
+
+	// Expect only the orphaned task to be cancelled
+	mockBlockContextManager.EXPECT().CancelTasks([]string{"0xorphaned"})
 
 	poller := &EVMChainPoller{
 		ethClient:           mockClient,
@@ -1175,7 +738,7 @@ func TestEVMChainPoller_ContextCancellationOnReorg(t *testing.T) {
 	err = poller.reconcileReorg(ctx, startBlock)
 	assert.NoError(t, err)
 
-	// Verify CancelBlock was called for each orphaned block
+	// Verify CancelTasks was called for the orphaned task
 	ctrl.Finish() // This will verify all expectations were met
 }
 
@@ -1251,135 +814,87 @@ func TestTaskBlockContextManager_CancelBlock(t *testing.T) {
 	}
 }
 
-// Test that tasks loaded from storage are populated with their context
-func TestRecoverInProgressTasks_PopulatesContextFromStorage(t *testing.T) {
+// newHeadsTestPoller returns a poller whose chain head never moves past block 100,
+// along with a channel that receives every time it checks the chain head
+func newHeadsTestPoller(t *testing.T, pollingInterval time.Duration) (*EVMChainPoller, <-chan struct{}) {
 	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	ctx := context.Background()
+	mockClient := mocks.NewMockClient(ctrl)
 	store := memory.NewInMemoryAggregatorStore()
-	taskQueue := make(chan *types.Task, 10)
-	mockBlockContextManager := mocks.NewMockIBlockContextManager(ctrl)
+	require.NoError(t, store.SaveBlock(context.Background(), "0xtest", &storage.BlockRecord{
+		Number:  100,
+		Hash:    "0xhash100",
+		ChainId: config.ChainId(1),
+	}))
+
+	polled := make(chan struct{}, 100)
+	mockClient.EXPECT().GetLatestBlock(gomock.Any()).DoAndReturn(func(ctx context.Context) (uint64, error) {
+		polled <- struct{}{}
+		return 100, nil
+	}).AnyTimes()
 
-	// Create tasks with different source block numbers
-	validDeadline := time.Now().Add(1 * time.Hour)
-	expiredDeadline := time.Now().Add(-1 * time.Hour)
+	poller := createTestPoller(mockClient, store)
+	poller.config.PollingInterval = pollingInterval
+	poller.watcher = &blockWatcher{pollingInterval: pollingInterval, logger: zap.NewNop()}
+	return poller, polled
+}
 
-	task1 := &types.Task{
-		TaskId:              "task-1",
-		AVSAddress:          "0xtest",
-		DeadlineUnixSeconds: &validDeadline,
-		SourceBlockNumber:   100,
-		ReferenceTimestamp:  1234567890,
+func TestPollForBlocks_NewHeadsSubscription_ProcessesBlockOnHeader(t *testing.T) {
+	poller, polled := newHeadsTestPoller(t, time.Hour)
+
+	sendHead := make(chan struct{})
+	poller.watcher.listenForNewHeads = func(ctx context.Context, handler func(header *ethTypes.Header) error) error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-sendHead:
+				_ = handler(&ethTypes.Header{Number: big.NewInt(101)})
+			}
+		}
 	}
 
-	task2 := &types.Task{
-		TaskId:              "task-2",
-		AVSAddress:          "0xtest",
-		DeadlineUnixSeconds: &validDeadline,
-		SourceBlockNumber:   101,
-		ReferenceTimestamp:  1234567891,
-	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go poller.pollForBlocks(ctx)
 
-	expiredTask := &types.Task{
-		TaskId:              "expired-task",
-		AVSAddress:          "0xtest",
-		DeadlineUnixSeconds: &expiredDeadline,
-		SourceBlockNumber:   99,
-		ReferenceTimestamp:  1234567889,
+	sendHead <- struct{}{}
+	select {
+	case <-polled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("block was not processed when its header arrived")
 	}
+	assert.True(t, poller.watcher.subscribed.Load())
+	assert.True(t, poller.watcher.headsArriving(time.Now()))
+}
 
-	// Save all tasks as pending
-	require.NoError(t, store.SavePendingTask(ctx, task1))
-	require.NoError(t, store.SavePendingTask(ctx, task2))
-	require.NoError(t, store.SavePendingTask(ctx, expiredTask))
-
-	// Create test contexts with different states
-	validCtx1 := context.WithValue(context.Background(), testContextKey("block"), "100")
-	validCtx2 := context.WithValue(context.Background(), testContextKey("block"), "101")
-	expiredCtx, cancelExpired := context.WithCancel(context.Background())
-	cancelExpired() // Make it expired
-
-	// Set up expectations for GetContext calls
-	mockBlockContextManager.EXPECT().
-		GetContext(uint64(100), gomock.Any()).
-		DoAndReturn(func(blockNum uint64, task *types.Task) context.Context {
-			assert.Equal(t, uint64(100), blockNum)
-			assert.Equal(t, "task-1", task.TaskId)
-			return validCtx1
-		})
-
-	mockBlockContextManager.EXPECT().
-		GetContext(uint64(101), gomock.Any()).
-		DoAndReturn(func(blockNum uint64, task *types.Task) context.Context {
-			assert.Equal(t, uint64(101), blockNum)
-			assert.Equal(t, "task-2", task.TaskId)
-			return validCtx2
-		})
-
-	mockBlockContextManager.EXPECT().
-		GetContext(uint64(99), gomock.Any()).
-		DoAndReturn(func(blockNum uint64, task *types.Task) context.Context {
-			assert.Equal(t, uint64(99), blockNum)
-			assert.Equal(t, "expired-task", task.TaskId)
-			return expiredCtx
-		})
+func TestPollForBlocks_NewHeadsSubscriptionDrops_FallsBackToPolling(t *testing.T) {
+	poller, polled := newHeadsTestPoller(t, 20*time.Millisecond)
 
-	poller := &EVMChainPoller{
-		taskQueue:           taskQueue,
-		store:               store,
-		blockContextManager: mockBlockContextManager,
-		config: &EVMChainPollerConfig{
-			AvsAddress: "0xtest",
-			ChainId:    config.ChainId(1),
-		},
-		logger: zap.NewNop(),
+	attempts := make(chan struct{}, 100)
+	poller.watcher.listenForNewHeads = func(ctx context.Context, handler func(header *ethTypes.Header) error) error {
+		attempts <- struct{}{}
+		return errors.New("websocket closed")
 	}
 
-	// Execute recoverInProgressTasks
-	err := poller.recoverInProgressTasks(ctx)
-	assert.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go poller.pollForBlocks(ctx)
 
-	// Verify that valid tasks are queued with proper contexts
-	queuedTasks := make(map[string]*types.Task)
-	for i := 0; i < 2; i++ {
+	for i := 0; i < 3; i++ {
 		select {
-		case task := <-taskQueue:
-			queuedTasks[task.TaskId] = task
-		case <-time.After(100 * time.Millisecond):
-			t.Fatal("Expected 2 tasks to be queued")
+		case <-polled:
+		case <-time.After(5 * time.Second):
+			t.Fatal("poller did not fall back to polling")
 		}
 	}
+	assert.False(t, poller.watcher.subscribed.Load())
 
-	// Verify task 1 has the correct context
-	assert.NotNil(t, queuedTasks["task-1"])
-	assert.NotNil(t, queuedTasks["task-1"].Context)
-	assert.Equal(t, "100", queuedTasks["task-1"].Context.Value(testContextKey("block")))
-
-	// Verify task 2 has the correct context
-	assert.NotNil(t, queuedTasks["task-2"])
-	assert.NotNil(t, queuedTasks["task-2"].Context)
-	assert.Equal(t, "101", queuedTasks["task-2"].Context.Value(testContextKey("block")))
-
-	// Verify expired task was not queued
-	select {
-	case task := <-taskQueue:
-		t.Fatalf("Should not receive expired task, got: %s", task.TaskId)
-	case <-time.After(50 * time.Millisecond):
-		// Expected - no more tasks
-	}
-
-	// Verify that valid tasks remain pending (they're queued but not yet picked up by AvsExecutionManager)
-	pendingTasks, err := store.ListPendingTasksForAVS(ctx, "0xtest")
-	assert.NoError(t, err)
-	assert.Equal(t, 2, len(pendingTasks), "Valid tasks should remain pending until AvsExecutionManager processes them")
-
-	// Verify the pending tasks are the valid ones, not the expired one
-	pendingTaskIds := make(map[string]bool)
-	for _, task := range pendingTasks {
-		pendingTaskIds[task.TaskId] = true
+	// the subscription keeps being retried
+	for i := 0; i < 2; i++ {
+		select {
+		case <-attempts:
+		case <-time.After(5 * time.Second):
+			t.Fatal("poller did not resubscribe")
+		}
 	}
-	assert.True(t, pendingTaskIds["task-1"], "task-1 should be pending")
-	assert.True(t, pendingTaskIds["task-2"], "task-2 should be pending")
-	assert.False(t, pendingTaskIds["expired-task"], "expired-task should not be pending")
 }
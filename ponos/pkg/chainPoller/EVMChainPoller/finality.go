@@ -0,0 +1,73 @@
+package EVMChainPoller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/ethereum"
+)
+
+// FinalityPolicy is how settled a block must be before the tasks it creates are
+// ingested. Waiting longer lowers the chance of a task being reorged away at the
+// cost of picking it up later.
+type FinalityPolicy string
+
+const (
+	// FinalityLatest ingests tasks from the chain head and cancels them if their block is reorged away
+	FinalityLatest FinalityPolicy = "latest"
+	// FinalityConfirmations ingests tasks once Confirmations blocks have been built on their block
+	FinalityConfirmations FinalityPolicy = "confirmations"
+	// FinalitySafe ingests tasks once their block is at or below the chain's safe block
+	FinalitySafe FinalityPolicy = "safe"
+	// FinalityFinalized ingests tasks once their block is finalized. Tasks ingested
+	// this way are never cancelled
+	FinalityFinalized FinalityPolicy = "finalized"
+)
+
+// ingestionHead returns the newest block whose tasks may be ingested under the finality policy
+func (ecp *EVMChainPoller) ingestionHead(ctx context.Context) (uint64, error) {
+	switch ecp.config.Finality {
+	case FinalitySafe, FinalityFinalized:
+		reader, ok := ecp.ethClient.(ethereum.TaggedBlockReader)
+		if !ok {
+			return 0, fmt.Errorf("ethereum client cannot resolve %s blocks", ecp.config.Finality)
+		}
+		return reader.GetBlockNumberByTag(ctx, ethereum.BlockType(ecp.config.Finality))
+	}
+
+	head, err := ecp.ethClient.GetLatestBlock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if ecp.config.Finality == FinalityConfirmations {
+		if head <= ecp.config.Confirmations {
+			return 0, nil
+		}
+		return head - ecp.config.Confirmations, nil
+	}
+	return head, nil
+}
+
+// unsettledDepth is how many blocks behind the ingestion head can still be
+// reorged; they are processed block by block with full reorg checks, while older
+// blocks are caught up with ranged log requests
+func (ecp *EVMChainPoller) unsettledDepth() uint64 {
+	maxReorgDepth := uint64(ecp.config.MaxReorgDepth)
+	switch ecp.config.Finality {
+	case FinalitySafe, FinalityFinalized:
+		return 0
+	case FinalityConfirmations:
+		if ecp.config.Confirmations >= maxReorgDepth {
+			return 0
+		}
+		return maxReorgDepth - ecp.config.Confirmations
+	}
+	return maxReorgDepth
+}
+
+// cancelsOrphanedTasks reports whether tasks from blocks that were reorged away are
+// cancelled. Finalized blocks cannot be reorged, so a finalized block whose hash
+// changes points at a faulty RPC endpoint rather than at tasks that no longer exist.
+func (ecp *EVMChainPoller) cancelsOrphanedTasks() bool {
+	return ecp.config.Finality != FinalityFinalized
+}
@@ -0,0 +1,91 @@
+package EVMChainPoller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/mocks"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/ethereum"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// taggedClient is a mock client that also resolves block tags
+type taggedClient struct {
+	*mocks.MockClient
+	tagged map[ethereum.BlockType]uint64
+}
+
+func (c *taggedClient) GetBlockNumberByTag(ctx context.Context, tag ethereum.BlockType) (uint64, error) {
+	return c.tagged[tag], nil
+}
+
+func Test_FinalityPolicy(t *testing.T) {
+	t.Run("ingests blocks once they have enough confirmations", func(t *testing.T) {
+		ct := newCatchUpTest(t, &EVMChainPollerConfig{MaxReorgDepth: 10, Finality: FinalityConfirmations, Confirmations: 4})
+		ct.client.EXPECT().GetLatestBlock(gomock.Any()).Return(uint64(120), nil)
+
+		head, err := ct.poller.ingestionHead(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(116), head)
+		assert.Equal(t, uint64(6), ct.poller.unsettledDepth())
+	})
+
+	t.Run("ingests blocks once they are finalized", func(t *testing.T) {
+		ct := newCatchUpTest(t, &EVMChainPollerConfig{MaxReorgDepth: 10, Finality: FinalityFinalized})
+		ct.poller.ethClient = &taggedClient{
+			MockClient: ct.client,
+			tagged:     map[ethereum.BlockType]uint64{ethereum.BlockType_Finalized: 90, ethereum.BlockType_Safe: 110},
+		}
+
+		head, err := ct.poller.ingestionHead(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(90), head)
+		assert.Equal(t, uint64(0), ct.poller.unsettledDepth())
+	})
+
+	t.Run("defaults to the latest block", func(t *testing.T) {
+		ct := newCatchUpTest(t, &EVMChainPollerConfig{MaxReorgDepth: 10})
+		ct.client.EXPECT().GetLatestBlock(gomock.Any()).Return(uint64(120), nil)
+
+		head, err := ct.poller.ingestionHead(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(120), head)
+		assert.Equal(t, uint64(10), ct.poller.unsettledDepth())
+	})
+
+	for _, finality := range []FinalityPolicy{FinalityLatest, FinalityFinalized} {
+		t.Run("cancels orphaned tasks with the "+string(finality)+" policy only if blocks can be reorged", func(t *testing.T) {
+			ct := newCatchUpTest(t, &EVMChainPollerConfig{MaxReorgDepth: 10, Finality: finality})
+			ctx := context.Background()
+			ct.client.EXPECT().GetBlockByNumber(gomock.Any(), uint64(100)).Return(&ethereum.EthereumBlock{
+				Number:     100,
+				Hash:       "0x100b",
+				ParentHash: ethereum.EthereumHexString(blockHash(99)),
+				ChainId:    config.ChainId(1),
+			}, nil).Times(2)
+			ct.expectBlock(99)
+			ct.client.EXPECT().GetLogs(gomock.Any(), "0xmailbox", uint64(100), uint64(101)).Return(nil, nil)
+			require.NoError(t, ct.store.SavePendingTask(ctx, &types.Task{
+				TaskId:            "0xtask1",
+				AVSAddress:        catchUpAvsAddress,
+				ChainId:           config.ChainId(1),
+				SourceBlockNumber: 100,
+				BlockHash:         ct.checkpoint.Hash,
+			}))
+			if finality != FinalityFinalized {
+				ct.blockContextManager.EXPECT().CancelTasks([]string{"0xtask1"}).Times(1)
+			}
+
+			err := ct.poller.reconcileReorg(ctx, &ethereum.EthereumBlock{
+				Number:     101,
+				Hash:       "0x101b",
+				ParentHash: "0x100b",
+			})
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package EVMChainPoller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/chainPoller"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/ethereum"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+	"go.uber.org/zap"
+)
+
+// canonicalBlock is a block of the canonical branch with the logs it emitted
+type canonicalBlock struct {
+	block *ethereum.EthereumBlock
+	logs  []*chainPoller.LogWithBlock
+}
+
+// reconcileReorg handles a reorg exposed by canonicalHead, whose parent does not
+// match the last processed block. It walks the stored block history back to the
+// common ancestor, diffs the tasks of the orphaned blocks against the tasks of the
+// canonical branch and then:
+//   - cancels tasks that are no longer on chain
+//   - keeps tasks included again in the canonical branch, recording their new block
+//   - re-ingests the canonical branch up to canonicalHead, queueing new tasks
+//
+// If the canonical branch cannot be fetched every task of the orphaned blocks is
+// cancelled and the branch is left to regular processing from the common ancestor.
+func (ecp *EVMChainPoller) reconcileReorg(ctx context.Context, canonicalHead *ethereum.EthereumBlock) error {
+	orphanedBlocks, err := ecp.findOrphanedBlocks(ctx, canonicalHead, ecp.config.MaxReorgDepth)
+	if err != nil {
+		return err
+	}
+
+	if len(orphanedBlocks) == 0 {
+		return fmt.Errorf("no orphaned blocks found")
+	}
+
+	orphanedTaskIds, err := ecp.listOrphanedTaskIds(ctx, orphanedBlocks)
+	if err != nil {
+		return err
+	}
+
+	event := &storage.ReorgEvent{
+		AvsAddress:     ecp.config.AvsAddress,
+		ChainId:        ecp.config.ChainId,
+		DetectedAt:     time.Now(),
+		CommonAncestor: orphanedBlocks[len(orphanedBlocks)-1].Number - 1,
+		CanonicalHead:  canonicalHead.Number.Value(),
+		OrphanedBlocks: orphanedBlocks,
+	}
+
+	branch, branchErr := ecp.fetchCanonicalBranch(ctx, event.CommonAncestor, canonicalHead)
+	var canonicalTasks map[string]*types.Task
+	if branchErr == nil {
+		canonicalTasks, branchErr = ecp.canonicalBranchTasks(branch)
+	}
+	if branchErr != nil {
+		ecp.logger.Sugar().Warnw("Failed to fetch the canonical branch, cancelling every task of the orphaned blocks",
+			"commonAncestor", event.CommonAncestor,
+			"canonicalHead", event.CanonicalHead,
+			"error", branchErr)
+		branch = nil
+		canonicalTasks = nil
+	}
+
+	for _, taskId := range orphanedTaskIds {
+		task, ok := canonicalTasks[taskId]
+		if !ok {
+			event.CancelledTaskIds = append(event.CancelledTaskIds, taskId)
+			continue
+		}
+		if err := ecp.store.UpdateTaskBlock(ctx, taskId, task.SourceBlockNumber, task.BlockHash); err != nil {
+			return fmt.Errorf("failed to move task %s to block %d: %w", taskId, task.SourceBlockNumber, err)
+		}
+		event.RetainedTaskIds = append(event.RetainedTaskIds, taskId)
+	}
+	for taskId := range canonicalTasks {
+		if !slices.Contains(orphanedTaskIds, taskId) {
+			event.NewTaskIds = append(event.NewTaskIds, taskId)
+		}
+	}
+	sort.Strings(event.NewTaskIds)
+
+	if len(event.CancelledTaskIds) > 0 {
+		if ecp.cancelsOrphanedTasks() {
+			ecp.blockContextManager.CancelTasks(event.CancelledTaskIds)
+		} else {
+			ecp.logger.Sugar().Errorw("Finalized block hash changed, keeping its tasks",
+				"taskIds", event.CancelledTaskIds,
+				"commonAncestor", event.CommonAncestor)
+			event.RetainedTaskIds = append(event.RetainedTaskIds, event.CancelledTaskIds...)
+			event.CancelledTaskIds = nil
+		}
+	}
+
+	for _, orphanedBlock := range orphanedBlocks {
+		err = ecp.store.DeleteBlock(ctx, ecp.config.AvsAddress, orphanedBlock.ChainId, orphanedBlock.Number)
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return fmt.Errorf("failed to delete orphaned block: %w", err)
+		}
+	}
+
+	for _, cb := range branch {
+		for _, lwb := range cb.logs {
+			if err := ecp.handleLog(ctx, lwb); err != nil {
+				return fmt.Errorf("failed to re-ingest block %d: %w", cb.block.Number.Value(), err)
+			}
+		}
+		if err := ecp.store.SaveBlock(ctx, ecp.config.AvsAddress, ecp.newBlockRecord(cb.block)); err != nil {
+			return fmt.Errorf("failed to save block %d: %w", cb.block.Number.Value(), err)
+		}
+	}
+
+	ecp.recordReorg(ctx, event)
+	return nil
+}
+
+// listOrphanedTaskIds returns the IDs of the stored tasks created in the orphaned blocks
+func (ecp *EVMChainPoller) listOrphanedTaskIds(ctx context.Context, orphanedBlocks []*storage.BlockRecord) ([]string, error) {
+	filter := &storage.TaskFilter{
+		AvsAddress: ecp.config.AvsAddress,
+		ChainId:    ecp.config.ChainId,
+		PageSize:   storage.MaxTaskPageSize,
+	}
+	for _, orphanedBlock := range orphanedBlocks {
+		filter.BlockHashes = append(filter.BlockHashes, orphanedBlock.Hash)
+	}
+
+	var taskIds []string
+	for {
+		page, err := ecp.store.ListTasks(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tasks of orphaned blocks: %w", err)
+		}
+		for _, record := range page.Tasks {
+			taskIds = append(taskIds, record.Task.TaskId)
+		}
+		if page.NextPageToken == "" {
+			return taskIds, nil
+		}
+		filter.PageToken = page.NextPageToken
+	}
+}
+
+// fetchCanonicalBranch fetches the blocks after the common ancestor up to and
+// including canonicalHead, along with their logs, oldest first
+func (ecp *EVMChainPoller) fetchCanonicalBranch(ctx context.Context, commonAncestor uint64, canonicalHead *ethereum.EthereumBlock) ([]*canonicalBlock, error) {
+	headNum := canonicalHead.Number.Value()
+	if headNum <= commonAncestor {
+		return nil, nil
+	}
+
+	branch := make([]*canonicalBlock, 0, headNum-commonAncestor)
+	byNumber := make(map[uint64]*canonicalBlock, headNum-commonAncestor)
+	for blockNum := commonAncestor + 1; blockNum <= headNum; blockNum++ {
+		block := canonicalHead
+		if blockNum != headNum {
+			var err error
+			block, err = ecp.ethClient.GetBlockByNumber(ctx, blockNum)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch block %d: %w", blockNum, err)
+			}
+		}
+		if len(branch) > 0 && block.ParentHash.Value() != branch[len(branch)-1].block.Hash.Value() {
+			return nil, fmt.Errorf("block %d does not extend block %d, the chain moved while it was fetched", blockNum, blockNum-1)
+		}
+		block.ChainId = ecp.config.ChainId
+
+		cb := &canonicalBlock{block: block}
+		branch = append(branch, cb)
+		byNumber[blockNum] = cb
+	}
+
+	logs, err := ecp.fetchLogsForInterestingContracts(ctx, commonAncestor+1, headNum)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].LogIndex < logs[j].LogIndex
+	})
+
+	for _, log := range logs {
+		if log.Removed {
+			continue
+		}
+		cb, ok := byNumber[log.BlockNumber.Value()]
+		if !ok || !strings.EqualFold(cb.block.Hash.Value(), log.BlockHash.Value()) {
+			return nil, fmt.Errorf("log of transaction %s is not part of the canonical branch", log.TransactionHash.Value())
+		}
+		decodedLog, err := ecp.logParser.DecodeLog(nil, log)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode log of transaction %s: %w", log.TransactionHash.Value(), err)
+		}
+		cb.logs = append(cb.logs, &chainPoller.LogWithBlock{
+			Block:  cb.block,
+			RawLog: log,
+			Log:    decodedLog,
+		})
+	}
+	return branch, nil
+}
+
+// canonicalBranchTasks returns the tasks of this AVS created in the canonical branch, by ID
+func (ecp *EVMChainPoller) canonicalBranchTasks(branch []*canonicalBlock) (map[string]*types.Task, error) {
+	tasks := make(map[string]*types.Task)
+	for _, cb := range branch {
+		for _, lwb := range cb.logs {
+			isTaskCreated, err := ecp.isMailboxTaskCreated(lwb)
+			if err != nil {
+				return nil, err
+			}
+			if !isTaskCreated {
+				continue
+			}
+			task, err := types.NewTaskFromLog(lwb.Log, lwb.Block, lwb.Log.Address)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert task: %w", err)
+			}
+			if strings.EqualFold(task.AVSAddress, ecp.config.AvsAddress) {
+				tasks[task.TaskId] = task
+			}
+		}
+	}
+	return tasks, nil
+}
+
+// recordReorg stores the reorg event and reports it in logs and metrics
+func (ecp *EVMChainPoller) recordReorg(ctx context.Context, event *storage.ReorgEvent) {
+	if err := ecp.store.SaveReorgEvent(ctx, event); err != nil {
+		ecp.logger.Sugar().Warnw("Failed to save reorg event",
+			"error", err,
+			"commonAncestor", event.CommonAncestor)
+	}
+
+	chainId := ecp.chainIdLabel()
+	metrics.AggregatorChainReorgs.WithLabelValues(ecp.config.AvsAddress, chainId).Inc()
+	metrics.AggregatorChainReorgDepth.WithLabelValues(ecp.config.AvsAddress, chainId).Observe(float64(len(event.OrphanedBlocks)))
+	metrics.AggregatorChainReorgTasks.WithLabelValues(ecp.config.AvsAddress, chainId, "cancelled").Add(float64(len(event.CancelledTaskIds)))
+	metrics.AggregatorChainReorgTasks.WithLabelValues(ecp.config.AvsAddress, chainId, "retained").Add(float64(len(event.RetainedTaskIds)))
+	metrics.AggregatorChainReorgTasks.WithLabelValues(ecp.config.AvsAddress, chainId, "new").Add(float64(len(event.NewTaskIds)))
+
+	ecp.logger.Sugar().Warnw("Handled chain reorganization",
+		zap.String("avsAddress", event.AvsAddress),
+		zap.Uint("chainId", uint(event.ChainId)),
+		zap.Uint64("commonAncestor", event.CommonAncestor),
+		zap.Uint64("canonicalHead", event.CanonicalHead),
+		zap.Int("orphanedBlocks", len(event.OrphanedBlocks)),
+		zap.Strings("cancelledTaskIds", event.CancelledTaskIds),
+		zap.Strings("retainedTaskIds", event.RetainedTaskIds),
+		zap.Strings("newTaskIds", event.NewTaskIds),
+	)
+}
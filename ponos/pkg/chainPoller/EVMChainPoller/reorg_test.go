@@ -0,0 +1,127 @@
+package EVMChainPoller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/ethereum"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contracts"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_ReconcileReorg(t *testing.T) {
+	t.Run("cancels only tasks missing from the canonical branch and re-ingests it", func(t *testing.T) {
+		ct := newCatchUpTest(t, &EVMChainPollerConfig{MaxReorgDepth: 10})
+		ctx := context.Background()
+		deadline := time.Now().Add(time.Hour)
+
+		// the last processed block 100 is orphaned along with both of its tasks
+		for _, taskId := range []string{"0xkept", "0xgone"} {
+			require.NoError(t, ct.store.SavePendingTask(ctx, &types.Task{
+				TaskId:              taskId,
+				AVSAddress:          catchUpAvsAddress,
+				ChainId:             config.ChainId(1),
+				SourceBlockNumber:   100,
+				BlockHash:           ct.checkpoint.Hash,
+				DeadlineUnixSeconds: &deadline,
+			}))
+		}
+
+		// the canonical branch includes 0xkept again in block 101 and a new task in block 100
+		canonical100 := &ethereum.EthereumBlock{
+			Number:     100,
+			Hash:       "0x100b",
+			ParentHash: ethereum.EthereumHexString(blockHash(99)),
+			ChainId:    config.ChainId(1),
+		}
+		canonicalHead := &ethereum.EthereumBlock{
+			Number:     101,
+			Hash:       "0x101b",
+			ParentHash: "0x100b",
+		}
+		ct.client.EXPECT().GetBlockByNumber(gomock.Any(), uint64(100)).Return(canonical100, nil).Times(2)
+		ct.expectBlock(99)
+
+		newLog := &ethereum.EthereumEventLog{Address: "0xmailbox", BlockNumber: 100, BlockHash: "0x100b"}
+		keptLog := &ethereum.EthereumEventLog{Address: "0xmailbox", BlockNumber: 101, BlockHash: "0x101b"}
+		ct.client.EXPECT().GetLogs(gomock.Any(), "0xmailbox", uint64(100), uint64(101)).Return([]*ethereum.EthereumEventLog{keptLog, newLog}, nil)
+		ct.logParser.EXPECT().DecodeLog(nil, newLog).Return(newTaskCreatedLog("0xnew", deadline), nil)
+		ct.logParser.EXPECT().DecodeLog(nil, keptLog).Return(newTaskCreatedLog("0xkept", deadline), nil)
+		ct.contractStore.EXPECT().GetContractByNameForChainId(config.ContractName_TaskMailbox, config.ChainId(1)).
+			Return(&contracts.Contract{Address: "0xmailbox"}, nil).AnyTimes()
+		ct.blockContextManager.EXPECT().GetContext(uint64(100), gomock.Any()).Return(ctx)
+		ct.blockContextManager.EXPECT().GetContext(uint64(101), gomock.Any()).Return(ctx)
+
+		ct.blockContextManager.EXPECT().CancelTasks([]string{"0xgone"})
+
+		require.NoError(t, ct.poller.reconcileReorg(ctx, canonicalHead))
+
+		kept, err := ct.store.GetTask(ctx, "0xkept")
+		require.NoError(t, err)
+		assert.Equal(t, uint64(101), kept.SourceBlockNumber)
+		assert.Equal(t, "0x101b", kept.BlockHash)
+
+		select {
+		case task := <-ct.taskQueue:
+			assert.Equal(t, "0xnew", task.TaskId)
+			assert.Equal(t, "0x100b", task.BlockHash)
+		default:
+			t.Fatal("task of the canonical branch was not queued")
+		}
+		assert.Empty(t, ct.taskQueue, "retained tasks are not queued again")
+
+		lastBlock, err := ct.store.GetLastProcessedBlock(ctx, catchUpAvsAddress, config.ChainId(1))
+		require.NoError(t, err)
+		assert.Equal(t, uint64(101), lastBlock.Number)
+		assert.Equal(t, "0x101b", lastBlock.Hash)
+
+		events, err := ct.store.ListReorgEvents(ctx, catchUpAvsAddress, config.ChainId(1))
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, uint64(99), events[0].CommonAncestor)
+		assert.Equal(t, uint64(101), events[0].CanonicalHead)
+		require.Len(t, events[0].OrphanedBlocks, 1)
+		assert.Equal(t, ct.checkpoint.Hash, events[0].OrphanedBlocks[0].Hash)
+		assert.Equal(t, []string{"0xgone"}, events[0].CancelledTaskIds)
+		assert.Equal(t, []string{"0xkept"}, events[0].RetainedTaskIds)
+		assert.Equal(t, []string{"0xnew"}, events[0].NewTaskIds)
+	})
+
+	t.Run("cancels every orphaned task when the canonical branch cannot be fetched", func(t *testing.T) {
+		ct := newCatchUpTest(t, &EVMChainPollerConfig{MaxReorgDepth: 10})
+		ctx := context.Background()
+
+		require.NoError(t, ct.store.SavePendingTask(ctx, &types.Task{
+			TaskId:            "0xorphaned",
+			AVSAddress:        catchUpAvsAddress,
+			ChainId:           config.ChainId(1),
+			SourceBlockNumber: 100,
+			BlockHash:         ct.checkpoint.Hash,
+		}))
+
+		ct.client.EXPECT().GetBlockByNumber(gomock.Any(), uint64(100)).Return(&ethereum.EthereumBlock{
+			Number:     100,
+			Hash:       "0x100b",
+			ParentHash: ethereum.EthereumHexString(blockHash(99)),
+			ChainId:    config.ChainId(1),
+		}, nil).Times(2)
+		ct.expectBlock(99)
+		ct.client.EXPECT().GetLogs(gomock.Any(), "0xmailbox", uint64(100), uint64(101)).Return(nil, assert.AnError)
+		ct.blockContextManager.EXPECT().CancelTasks([]string{"0xorphaned"})
+
+		require.NoError(t, ct.poller.reconcileReorg(ctx, &ethereum.EthereumBlock{
+			Number:     101,
+			Hash:       "0x101b",
+			ParentHash: "0x100b",
+		}))
+
+		lastBlock, err := ct.store.GetLastProcessedBlock(ctx, catchUpAvsAddress, config.ChainId(1))
+		require.NoError(t, err)
+		assert.Equal(t, uint64(99), lastBlock.Number, "the canonical branch is left to regular processing")
+	})
+}
@@ -0,0 +1,206 @@
+package EVMChainPoller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/chainPoller"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/ethereum"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+	"go.uber.org/zap"
+)
+
+// ReplayOutcome is what a replay did with a task found in the replayed block range
+type ReplayOutcome string
+
+const (
+	// ReplayOutcomeReplayed tasks were queued for processing, or would be on a dry run
+	ReplayOutcomeReplayed ReplayOutcome = "replayed"
+	// ReplayOutcomeCompleted tasks were skipped because they already completed
+	ReplayOutcomeCompleted ReplayOutcome = "completed"
+	// ReplayOutcomeInProgress tasks were skipped because they are still pending or processing
+	ReplayOutcomeInProgress ReplayOutcome = "in_progress"
+	// ReplayOutcomeExpired tasks were skipped because their deadline has passed
+	ReplayOutcomeExpired ReplayOutcome = "expired"
+)
+
+// ReplayOptions selects the blocks to replay and how
+type ReplayOptions struct {
+	FromBlock uint64
+	ToBlock   uint64
+	// DryRun reports the tasks that would be replayed without queueing them
+	DryRun bool
+	// IgnoreDeadlines reports tasks whose deadline has passed as replayable. Only
+	// allowed on a dry run, since an expired task cannot be processed
+	IgnoreDeadlines bool
+}
+
+// ReplayedTask is a task found in the replayed block range
+type ReplayedTask struct {
+	TaskId      string
+	BlockNumber uint64
+	Outcome     ReplayOutcome
+}
+
+// Replay re-ingests the TaskCreated events of this AVS between FromBlock and ToBlock,
+// e.g. after an outage or a bug fix. Tasks that already completed or are still in
+// progress are skipped; the rest are stored as pending and queued for processing
+// like newly created tasks. Replaying does not move the poller's checkpoint.
+func (ecp *EVMChainPoller) Replay(ctx context.Context, opts *ReplayOptions) ([]*ReplayedTask, error) {
+	if opts.FromBlock > opts.ToBlock {
+		return nil, fmt.Errorf("from block %d is after to block %d", opts.FromBlock, opts.ToBlock)
+	}
+	if opts.IgnoreDeadlines && !opts.DryRun {
+		return nil, fmt.Errorf("deadlines can only be ignored on a dry run")
+	}
+
+	ecp.logger.Sugar().Infow("Replaying tasks",
+		zap.String("avsAddress", ecp.config.AvsAddress),
+		zap.Uint("chainId", uint(ecp.config.ChainId)),
+		zap.Uint64("fromBlock", opts.FromBlock),
+		zap.Uint64("toBlock", opts.ToBlock),
+		zap.Bool("dryRun", opts.DryRun),
+	)
+
+	replayed := make([]*ReplayedTask, 0)
+	for fromBlock := opts.FromBlock; fromBlock <= opts.ToBlock; {
+		toBlock := min(fromBlock+ecp.config.CatchUpWindowSize-1, opts.ToBlock)
+
+		tasks, err := ecp.fetchTasksInRange(ctx, fromBlock, toBlock)
+		if err != nil {
+			return replayed, err
+		}
+		for _, task := range tasks {
+			outcome, err := ecp.replayTask(ctx, task, opts)
+			if err != nil {
+				return replayed, err
+			}
+			replayed = append(replayed, &ReplayedTask{
+				TaskId:      task.TaskId,
+				BlockNumber: task.SourceBlockNumber,
+				Outcome:     outcome,
+			})
+		}
+
+		if toBlock == opts.ToBlock {
+			break
+		}
+		fromBlock = toBlock + 1
+	}
+
+	ecp.logger.Sugar().Infow("Replayed tasks",
+		zap.String("avsAddress", ecp.config.AvsAddress),
+		zap.Uint("chainId", uint(ecp.config.ChainId)),
+		zap.Uint64("fromBlock", opts.FromBlock),
+		zap.Uint64("toBlock", opts.ToBlock),
+		zap.Int("tasks", len(replayed)),
+		zap.Bool("dryRun", opts.DryRun),
+	)
+	return replayed, nil
+}
+
+// fetchTasksInRange returns the tasks of this AVS created between fromBlock and toBlock, oldest first
+func (ecp *EVMChainPoller) fetchTasksInRange(ctx context.Context, fromBlock, toBlock uint64) ([]*types.Task, error) {
+	logs, err := ecp.fetchLogsForInterestingContracts(ctx, fromBlock, toBlock)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].LogIndex < logs[j].LogIndex
+	})
+
+	tasks := make([]*types.Task, 0)
+	for _, log := range logs {
+		if log.Removed {
+			continue
+		}
+		decodedLog, err := ecp.logParser.DecodeLog(nil, log)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode log of transaction %s: %w", log.TransactionHash.Value(), err)
+		}
+
+		lwb := &chainPoller.LogWithBlock{
+			Block: &ethereum.EthereumBlock{
+				Number:  log.BlockNumber,
+				Hash:    log.BlockHash,
+				ChainId: ecp.config.ChainId,
+			},
+			RawLog: log,
+			Log:    decodedLog,
+		}
+		isTaskCreated, err := ecp.isMailboxTaskCreated(lwb)
+		if err != nil {
+			return nil, err
+		}
+		if !isTaskCreated {
+			continue
+		}
+
+		task, err := types.NewTaskFromLog(decodedLog, lwb.Block, decodedLog.Address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert task: %w", err)
+		}
+		if strings.EqualFold(task.AVSAddress, ecp.config.AvsAddress) {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks, nil
+}
+
+// replayTask queues a task found while replaying unless it should be skipped
+func (ecp *EVMChainPoller) replayTask(ctx context.Context, task *types.Task, opts *ReplayOptions) (ReplayOutcome, error) {
+	record, err := ecp.store.GetTaskRecord(ctx, task.TaskId)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return "", fmt.Errorf("failed to get task %s: %w", task.TaskId, err)
+	}
+	if record != nil {
+		switch record.Status {
+		case storage.TaskStatusCompleted:
+			return ReplayOutcomeCompleted, nil
+		case storage.TaskStatusPending, storage.TaskStatusProcessing:
+			return ReplayOutcomeInProgress, nil
+		}
+	}
+
+	if !opts.IgnoreDeadlines && taskExpired(task, time.Now()) {
+		return ReplayOutcomeExpired, nil
+	}
+	if opts.DryRun {
+		return ReplayOutcomeReplayed, nil
+	}
+
+	// failed is a terminal status, so a failed task is stored afresh
+	if record != nil {
+		if err := ecp.store.DeleteTask(ctx, task.TaskId); err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return "", fmt.Errorf("failed to reset task %s: %w", task.TaskId, err)
+		}
+	}
+
+	task.Context = ecp.blockContextManager.GetContext(task.SourceBlockNumber, task)
+	if err := ecp.store.SavePendingTask(ctx, task); err != nil {
+		return "", fmt.Errorf("failed to save task %s: %w", task.TaskId, err)
+	}
+
+	select {
+	case ecp.taskQueue <- task:
+	case <-ctx.Done():
+		return "", fmt.Errorf("replay stopped before task %s was queued: %w", task.TaskId, ctx.Err())
+	}
+
+	ecp.logger.Sugar().Infow("Replayed task",
+		zap.String("taskId", task.TaskId),
+		zap.Uint64("blockNumber", task.SourceBlockNumber),
+	)
+	metrics.AggregatorTasksReplayed.WithLabelValues(ecp.config.AvsAddress, ecp.chainIdLabel()).Inc()
+	metrics.AggregatorTasksQueued.WithLabelValues(ecp.config.AvsAddress).Set(float64(len(ecp.taskQueue)))
+	return ReplayOutcomeReplayed, nil
+}
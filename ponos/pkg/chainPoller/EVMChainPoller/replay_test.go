@@ -0,0 +1,126 @@
+package EVMChainPoller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/ethereum"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contracts"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// expectReplayLogs serves one TaskCreated log per task ID, each in its own block from block 200 on
+func (ct *catchUpTest) expectReplayLogs(fromBlock, toBlock uint64, deadlines map[string]time.Time, taskIds ...string) {
+	logs := make([]*ethereum.EthereumEventLog, 0, len(taskIds))
+	for i, taskId := range taskIds {
+		number := uint64(200 + i)
+		log := &ethereum.EthereumEventLog{
+			Address:     "0xmailbox",
+			BlockNumber: ethereum.EthereumQuantity(number),
+			BlockHash:   ethereum.EthereumHexString(blockHash(number)),
+		}
+		ct.logParser.EXPECT().DecodeLog(nil, log).Return(newTaskCreatedLog(taskId, deadlines[taskId]), nil)
+		logs = append(logs, log)
+	}
+	ct.client.EXPECT().GetLogs(gomock.Any(), "0xmailbox", fromBlock, toBlock).Return(logs, nil)
+	ct.contractStore.EXPECT().GetContractByNameForChainId(config.ContractName_TaskMailbox, config.ChainId(1)).
+		Return(&contracts.Contract{Address: "0xmailbox"}, nil).AnyTimes()
+}
+
+func (ct *catchUpTest) saveTaskWithStatus(t *testing.T, taskId string, status storage.TaskStatus) {
+	ctx := context.Background()
+	require.NoError(t, ct.store.SavePendingTask(ctx, &types.Task{
+		TaskId:     taskId,
+		AVSAddress: catchUpAvsAddress,
+		ChainId:    config.ChainId(1),
+	}))
+	if status == storage.TaskStatusCompleted {
+		require.NoError(t, ct.store.UpdateTaskStatus(ctx, taskId, storage.TaskStatusProcessing))
+	}
+	if status != storage.TaskStatusPending {
+		require.NoError(t, ct.store.UpdateTaskStatus(ctx, taskId, status))
+	}
+}
+
+func Test_Replay(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	t.Run("queues tasks that did not complete and skips the rest", func(t *testing.T) {
+		ct := newCatchUpTest(t, &EVMChainPollerConfig{CatchUpWindowSize: 500})
+		ctx := context.Background()
+
+		ct.saveTaskWithStatus(t, "0xcompleted", storage.TaskStatusCompleted)
+		ct.saveTaskWithStatus(t, "0xfailed", storage.TaskStatusFailed)
+		ct.saveTaskWithStatus(t, "0xpending", storage.TaskStatusPending)
+		deadlines := map[string]time.Time{
+			"0xcompleted": future,
+			"0xfailed":    future,
+			"0xpending":   future,
+			"0xmissed":    future,
+			"0xexpired":   past,
+		}
+		ct.expectReplayLogs(150, 250, deadlines, "0xcompleted", "0xfailed", "0xpending", "0xmissed", "0xexpired")
+		ct.blockContextManager.EXPECT().GetContext(gomock.Any(), gomock.Any()).Return(ctx).Times(2)
+
+		replayed, err := ct.poller.Replay(ctx, &ReplayOptions{FromBlock: 150, ToBlock: 250})
+		require.NoError(t, err)
+
+		outcomes := make(map[string]ReplayOutcome)
+		for _, task := range replayed {
+			outcomes[task.TaskId] = task.Outcome
+		}
+		assert.Equal(t, map[string]ReplayOutcome{
+			"0xcompleted": ReplayOutcomeCompleted,
+			"0xfailed":    ReplayOutcomeReplayed,
+			"0xpending":   ReplayOutcomeInProgress,
+			"0xmissed":    ReplayOutcomeReplayed,
+			"0xexpired":   ReplayOutcomeExpired,
+		}, outcomes)
+
+		require.Len(t, ct.taskQueue, 2)
+		assert.Equal(t, "0xfailed", (<-ct.taskQueue).TaskId)
+		assert.Equal(t, "0xmissed", (<-ct.taskQueue).TaskId)
+
+		record, err := ct.store.GetTaskRecord(ctx, "0xfailed")
+		require.NoError(t, err)
+		assert.Equal(t, storage.TaskStatusPending, record.Status)
+
+		checkpoint, err := ct.store.GetLastProcessedBlock(ctx, catchUpAvsAddress, config.ChainId(1))
+		require.NoError(t, err)
+		assert.Equal(t, ct.checkpoint.Number, checkpoint.Number, "replaying does not move the checkpoint")
+	})
+
+	t.Run("reports expired tasks without queueing anything on a dry run", func(t *testing.T) {
+		ct := newCatchUpTest(t, &EVMChainPollerConfig{CatchUpWindowSize: 50})
+		ct.expectReplayLogs(150, 199, nil)
+		ct.expectReplayLogs(200, 220, map[string]time.Time{"0xexpired": past}, "0xexpired")
+
+		replayed, err := ct.poller.Replay(context.Background(), &ReplayOptions{
+			FromBlock:       150,
+			ToBlock:         220,
+			DryRun:          true,
+			IgnoreDeadlines: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, replayed, 1)
+		assert.Equal(t, ReplayOutcomeReplayed, replayed[0].Outcome)
+		assert.Empty(t, ct.taskQueue)
+
+		_, err = ct.store.GetTaskRecord(context.Background(), "0xexpired")
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("only ignores deadlines on a dry run", func(t *testing.T) {
+		ct := newCatchUpTest(t, &EVMChainPollerConfig{})
+
+		_, err := ct.poller.Replay(context.Background(), &ReplayOptions{FromBlock: 150, ToBlock: 220, IgnoreDeadlines: true})
+		assert.Error(t, err)
+	})
+}
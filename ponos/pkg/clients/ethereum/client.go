@@ -12,6 +12,7 @@ import (
 	"sync"
 	"time"
 
+	goEthereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -21,8 +22,9 @@ import (
 type BlockType string
 
 const (
-	BlockType_Safe   BlockType = "safe"
-	BlockType_Latest BlockType = "latest"
+	BlockType_Safe      BlockType = "safe"
+	BlockType_Latest    BlockType = "latest"
+	BlockType_Finalized BlockType = "finalized"
 )
 
 type RequestMethod struct {
@@ -66,6 +68,16 @@ type Client interface {
 	GetLogs(ctx context.Context, address string, fromBlock uint64, toBlock uint64) ([]*EthereumEventLog, error)
 }
 
+// TaggedBlockReader resolves a block tag such as safe or finalized to the number of the block it points to
+type TaggedBlockReader interface {
+	GetBlockNumberByTag(ctx context.Context, tag BlockType) (uint64, error)
+}
+
+// HeadSubscriber streams the headers of new blocks, e.g. an ethclient.Client connected over a websocket
+type HeadSubscriber interface {
+	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (goEthereum.Subscription, error)
+}
+
 type EthereumClient struct {
 	Logger       *zap.Logger
 	httpClient   *http.Client
@@ -75,6 +87,10 @@ type EthereumClient struct {
 type EthereumClientConfig struct {
 	BaseUrl   string
 	BlockType BlockType
+
+	// DisableRetries makes every request a single attempt, for callers that fail
+	// over to another endpoint instead of retrying
+	DisableRetries bool
 }
 
 //nolint:all
@@ -127,30 +143,45 @@ func (c *EthereumClient) GetEthereumContractCaller() (*ethclient.Client, error)
 	return d, nil
 }
 
+// ListenForNewBlocks subscribes to new block headers on wsc and passes each one to
+// recvBlockHandler until ctx is done or the subscription fails. It returns nil once
+// ctx is done and the reason the subscription ended otherwise.
 func (c *EthereumClient) ListenForNewBlocks(
 	ctx context.Context,
-	wsc *ethclient.Client,
-	quitChan chan struct{},
+	wsc HeadSubscriber,
+	recvBlockHandler func(block *types.Header) error,
+) error {
+	return listenForNewBlocks(ctx, wsc, recvBlockHandler, c.Logger)
+}
+
+func listenForNewBlocks(
+	ctx context.Context,
+	wsc HeadSubscriber,
 	recvBlockHandler func(block *types.Header) error,
+	logger *zap.Logger,
 ) error {
 	ch := make(chan *types.Header)
 	sub, err := wsc.SubscribeNewHead(ctx, ch)
 	if err != nil {
 		return err
 	}
+	defer sub.Unsubscribe()
 
-	defer close(ch)
 	for {
 		select {
 		case err := <-sub.Err():
-			c.Logger.Sugar().Errorw("Received error", zap.Error(err))
+			if err == nil {
+				err = fmt.Errorf("new heads subscription closed")
+			}
+			return err
 		case header := <-ch:
-			err = recvBlockHandler(header)
-			if err != nil {
-				c.Logger.Sugar().Errorw("Failed to handle block on exit", zap.Error(err))
+			if err := recvBlockHandler(header); err != nil {
+				logger.Sugar().Errorw("Failed to handle new block header",
+					zap.Uint64("blockNumber", header.Number.Uint64()),
+					zap.Error(err),
+				)
 			}
-		case <-quitChan:
-			c.Logger.Sugar().Infow("Received quit")
+		case <-ctx.Done():
 			return nil
 		}
 	}
@@ -181,11 +212,23 @@ func (c *EthereumClient) GetBlockNumberUint64(ctx context.Context) (uint64, erro
 }
 
 func (c *EthereumClient) GetLatestBlock(ctx context.Context) (uint64, error) {
-	var rpcRequest *RPCRequest
 	if c.clientConfig.BlockType == BlockType_Latest {
+		return c.GetBlockNumberByTag(ctx, BlockType_Latest)
+	}
+	return c.GetBlockNumberByTag(ctx, BlockType_Safe)
+}
+
+func (c *EthereumClient) GetBlockNumberByTag(ctx context.Context, tag BlockType) (uint64, error) {
+	var rpcRequest *RPCRequest
+	switch tag {
+	case BlockType_Latest:
 		rpcRequest = GetLatestBlockRequest(1)
-	} else {
+	case BlockType_Safe:
 		rpcRequest = GetSafeBlockRequest(1)
+	case BlockType_Finalized:
+		rpcRequest = GetFinalizedBlockRequest(1)
+	default:
+		return 0, fmt.Errorf("unsupported block tag %q", tag)
 	}
 
 	res, err := c.Call(ctx, rpcRequest)
@@ -584,6 +627,9 @@ func (c *EthereumClient) call(ctx context.Context, rpcRequest *RPCRequest) (*RPC
 }
 
 func (c *EthereumClient) Call(ctx context.Context, rpcRequest *RPCRequest) (*RPCResponse, error) {
+	if c.clientConfig.DisableRetries {
+		return c.call(ctx, rpcRequest)
+	}
 	backoffs := []int{1, 3, 5, 10, 20, 30, 60}
 
 	for i, backoff := range backoffs {
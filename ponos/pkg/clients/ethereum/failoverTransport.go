@@ -0,0 +1,100 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// contractCallAttemptTimeout bounds a single JSON-RPC request to one endpoint, so a hanging
+// endpoint leaves time to fail over to the next one
+const contractCallAttemptTimeout = 10 * time.Second
+
+// failoverTransport carries the JSON-RPC requests of contract calls and transactions. Each
+// request goes to the endpoints of the MultiEndpointClient in order of preference until one
+// answers, and the outcome feeds the same endpoint health the block and log reads use.
+// Transport errors and HTTP error statuses fail over; JSON-RPC errors such as reverts are
+// returned as they are, since another endpoint would give the same answer.
+type failoverTransport struct {
+	client *MultiEndpointClient
+	base   http.RoundTripper
+}
+
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+	}
+
+	return withEndpointFailover(req.Context(), t.client, "contract_call", func(ctx context.Context, e *endpoint) (*http.Response, error) {
+		return t.send(ctx, req, body, e)
+	})
+}
+
+// send makes one attempt at the request against the endpoint, reading the whole response so
+// a connection that drops mid-response counts against the endpoint
+func (t *failoverTransport) send(ctx context.Context, req *http.Request, body []byte, e *endpoint) (*http.Response, error) {
+	target, err := url.Parse(e.url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint url: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, contractCallAttemptTimeout)
+	defer cancel()
+
+	attempt := req.Clone(ctx)
+	attempt.URL = target
+	attempt.Host = target.Host
+	attempt.Body = io.NopCloser(bytes.NewReader(body))
+	attempt.ContentLength = int64(len(body))
+
+	res, err := t.base.RoundTrip(attempt)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if res.StatusCode >= http.StatusInternalServerError || res.StatusCode == http.StatusTooManyRequests {
+		return nil, fmt.Errorf("unexpected status %s", res.Status)
+	}
+
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+	res.ContentLength = int64(len(resBody))
+	return res, nil
+}
+
+// GetEthereumContractCaller returns a go-ethereum client for contract calls and transactions
+// that fails over between the endpoints
+func (c *MultiEndpointClient) GetEthereumContractCaller() (*ethclient.Client, error) {
+	if len(c.endpoints) == 0 {
+		return nil, errors.New("no rpc endpoints configured")
+	}
+
+	// requests are sent to whichever endpoint the transport picks; the url here only tells
+	// the rpc client to use HTTP
+	rpcClient, err := rpc.DialOptions(context.Background(), c.endpoints[0].url, rpc.WithHTTPClient(&http.Client{
+		Transport: &failoverTransport{client: c, base: http.DefaultTransport},
+	}))
+	if err != nil {
+		c.logger.Sugar().Errorw("Failed to create new eth client", "error", err)
+		return nil, err
+	}
+	return ethclient.NewClient(rpcClient), nil
+}
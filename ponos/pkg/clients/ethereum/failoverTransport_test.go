@@ -0,0 +1,88 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newJsonRpcServer answers every JSON-RPC request with the result, or with the error when
+// it is set, and counts the requests it receives
+func newJsonRpcServer(t *testing.T, result string, rpcErr string, calls *atomic.Int32) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		var req struct {
+			Id json.RawMessage `json:"id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if rpcErr != "" {
+			_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":-32000,"message":%q}}`, req.Id, rpcErr)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%q}`, req.Id, result)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newUnavailableServer(t *testing.T, calls *atomic.Int32) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func Test_FailoverTransport(t *testing.T) {
+	t.Run("fails over contract calls to the next endpoint", func(t *testing.T) {
+		var failingCalls, workingCalls atomic.Int32
+		failing := newUnavailableServer(t, &failingCalls)
+		working := newJsonRpcServer(t, "0x7a69", "", &workingCalls)
+
+		client := NewMultiEndpointClient(&MultiEndpointClientConfig{ChainId: 31337, Urls: []string{failing.URL, working.URL}}, zap.NewNop())
+		ethClient, err := client.GetEthereumContractCaller()
+		require.NoError(t, err)
+
+		chainId, err := ethClient.ChainID(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(31337), chainId.Uint64())
+
+		_, err = ethClient.ChainID(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), failingCalls.Load(), "an endpoint cooling down is not tried while another is healthy")
+		assert.Equal(t, int32(2), workingCalls.Load())
+
+		statuses := client.EndpointStatuses()
+		assert.False(t, statuses[0].Healthy)
+		assert.True(t, statuses[1].Healthy)
+	})
+
+	t.Run("returns JSON-RPC errors without failing over", func(t *testing.T) {
+		var revertingCalls, otherCalls atomic.Int32
+		reverting := newJsonRpcServer(t, "", "execution reverted", &revertingCalls)
+		other := newJsonRpcServer(t, "0x7a69", "", &otherCalls)
+
+		client := NewMultiEndpointClient(&MultiEndpointClientConfig{ChainId: 31337, Urls: []string{reverting.URL, other.URL}}, zap.NewNop())
+		ethClient, err := client.GetEthereumContractCaller()
+		require.NoError(t, err)
+
+		_, err = ethClient.ChainID(context.Background())
+		require.ErrorContains(t, err, "execution reverted")
+		assert.Equal(t, int32(1), revertingCalls.Load())
+		assert.Equal(t, int32(0), otherCalls.Load())
+		assert.True(t, client.EndpointStatuses()[0].Healthy)
+	})
+}
@@ -0,0 +1,380 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"go.uber.org/zap"
+)
+
+const (
+	// endpointCooldown is how long an endpoint is skipped after its first failure;
+	// it doubles with every further consecutive failure up to maxEndpointCooldown
+	endpointCooldown    = 5 * time.Second
+	maxEndpointCooldown = 5 * time.Minute
+
+	// latencySmoothing is the weight of the newest sample in an endpoint's latency average
+	latencySmoothing = 0.2
+)
+
+// ErrNoQuorum is returned by quorum reads when not enough endpoints agree
+var ErrNoQuorum = errors.New("rpc endpoints did not reach quorum")
+
+type MultiEndpointClientConfig struct {
+	ChainId   config.ChainId
+	Urls      []string
+	BlockType BlockType
+
+	// Quorum is how many endpoints must agree on the chain head, a block's hash or a
+	// block's logs for the read to succeed. Reads go to every endpoint when it is
+	// greater than 1, and to a single endpoint with failover otherwise.
+	Quorum int
+}
+
+// EndpointStatus is a point-in-time snapshot of an endpoint's health
+type EndpointStatus struct {
+	Url                 string
+	Healthy             bool
+	Latency             time.Duration
+	ConsecutiveFailures int
+	LastError           string
+}
+
+type endpoint struct {
+	url    string
+	label  string
+	client Client
+
+	mu                  sync.Mutex
+	latency             time.Duration
+	consecutiveFailures int
+	unhealthyUntil      time.Time
+	lastError           string
+}
+
+func (e *endpoint) healthy(now time.Time) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return !now.Before(e.unhealthyUntil)
+}
+
+// MultiEndpointClient reads from several RPC endpoints of the same chain. Each
+// request goes to the healthy endpoint with the lowest latency and fails over to
+// the next one when it errors. An endpoint that fails is skipped for a cooldown
+// that grows with consecutive failures; when every endpoint is cooling down they
+// are all tried anyway, soonest to recover first, so reads never stall on health
+// bookkeeping alone.
+//
+// With a quorum configured, reads of the chain head, blocks and logs go to every
+// endpoint and only succeed when enough of them agree.
+type MultiEndpointClient struct {
+	config    *MultiEndpointClientConfig
+	logger    *zap.Logger
+	endpoints []*endpoint
+	chainId   string
+}
+
+func NewMultiEndpointClient(cfg *MultiEndpointClientConfig, l *zap.Logger) *MultiEndpointClient {
+	endpoints := make([]*endpoint, 0, len(cfg.Urls))
+	for _, u := range cfg.Urls {
+		endpoints = append(endpoints, &endpoint{
+			url:   u,
+			label: endpointLabel(u),
+			client: NewEthereumClient(&EthereumClientConfig{
+				BaseUrl:        u,
+				BlockType:      cfg.BlockType,
+				DisableRetries: true,
+			}, l),
+		})
+	}
+	return newMultiEndpointClient(cfg, endpoints, l)
+}
+
+func newMultiEndpointClient(cfg *MultiEndpointClientConfig, endpoints []*endpoint, l *zap.Logger) *MultiEndpointClient {
+	c := &MultiEndpointClient{
+		config:    cfg,
+		logger:    l.With(zap.Uint("chainId", uint(cfg.ChainId))),
+		endpoints: endpoints,
+		chainId:   fmt.Sprintf("%d", cfg.ChainId),
+	}
+	for _, e := range endpoints {
+		metrics.RpcEndpointHealthy.WithLabelValues(c.chainId, e.label).Set(1)
+	}
+	return c
+}
+
+// endpointLabel identifies an endpoint by its host, leaving out paths and query
+// strings that often carry API keys
+func endpointLabel(rawUrl string) string {
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.Host == "" {
+		return "unknown"
+	}
+	return u.Host
+}
+
+// EndpointStatuses returns the health of every endpoint
+func (c *MultiEndpointClient) EndpointStatuses() []EndpointStatus {
+	now := time.Now()
+	statuses := make([]EndpointStatus, 0, len(c.endpoints))
+	for _, e := range c.endpoints {
+		healthy := e.healthy(now)
+		e.mu.Lock()
+		statuses = append(statuses, EndpointStatus{
+			Url:                 e.label,
+			Healthy:             healthy,
+			Latency:             e.latency,
+			ConsecutiveFailures: e.consecutiveFailures,
+			LastError:           e.lastError,
+		})
+		e.mu.Unlock()
+	}
+	return statuses
+}
+
+func (c *MultiEndpointClient) GetLatestBlock(ctx context.Context) (uint64, error) {
+	if c.config.Quorum <= 1 {
+		return withFailover(ctx, c, "block_number", func(ctx context.Context, client Client) (uint64, error) {
+			return client.GetLatestBlock(ctx)
+		})
+	}
+
+	return c.quorumHead(ctx, "block_number", func(ctx context.Context, client Client) (uint64, error) {
+		return client.GetLatestBlock(ctx)
+	})
+}
+
+// GetBlockNumberByTag resolves a block tag on the endpoints, see GetLatestBlock
+func (c *MultiEndpointClient) GetBlockNumberByTag(ctx context.Context, tag BlockType) (uint64, error) {
+	getTaggedBlock := func(ctx context.Context, client Client) (uint64, error) {
+		reader, ok := client.(TaggedBlockReader)
+		if !ok {
+			return 0, fmt.Errorf("client does not support block tags")
+		}
+		return reader.GetBlockNumberByTag(ctx, tag)
+	}
+	if c.config.Quorum <= 1 {
+		return withFailover(ctx, c, "block_number_by_tag", getTaggedBlock)
+	}
+	return c.quorumHead(ctx, "block_number_by_tag", getTaggedBlock)
+}
+
+// quorumHead returns the highest block number at least Quorum endpoints have reached
+func (c *MultiEndpointClient) quorumHead(ctx context.Context, method string, fn func(context.Context, Client) (uint64, error)) (uint64, error) {
+	results := queryAll(ctx, c, fn)
+	if len(results) < c.config.Quorum {
+		return 0, c.noQuorum(method, len(results))
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i] > results[j] })
+	return results[c.config.Quorum-1], nil
+}
+
+func (c *MultiEndpointClient) GetBlockByNumber(ctx context.Context, blockNumber uint64) (*EthereumBlock, error) {
+	getBlock := func(ctx context.Context, client Client) (*EthereumBlock, error) {
+		return client.GetBlockByNumber(ctx, blockNumber)
+	}
+	if c.config.Quorum <= 1 {
+		return withFailover(ctx, c, "block", getBlock)
+	}
+	return agreed(c, "block", queryAll(ctx, c, getBlock), func(block *EthereumBlock) string {
+		if block == nil {
+			return ""
+		}
+		return block.Hash.Value()
+	})
+}
+
+func (c *MultiEndpointClient) GetLogs(ctx context.Context, address string, fromBlock uint64, toBlock uint64) ([]*EthereumEventLog, error) {
+	getLogs := func(ctx context.Context, client Client) ([]*EthereumEventLog, error) {
+		return client.GetLogs(ctx, address, fromBlock, toBlock)
+	}
+	if c.config.Quorum <= 1 {
+		return withFailover(ctx, c, "logs", getLogs)
+	}
+	return agreed(c, "logs", queryAll(ctx, c, getLogs), func(logs []*EthereumEventLog) string {
+		var key strings.Builder
+		for _, log := range logs {
+			fmt.Fprintf(&key, "%s/%s/%d;", log.BlockHash.Value(), log.TransactionHash.Value(), log.LogIndex.Value())
+		}
+		return key.String()
+	})
+}
+
+// GetWebsocketConnection dials a websocket endpoint for new heads subscriptions
+func (c *MultiEndpointClient) GetWebsocketConnection(wsUrl string) (*ethclient.Client, error) {
+	return ethclient.Dial(wsUrl)
+}
+
+// ListenForNewBlocks streams new block headers, see EthereumClient.ListenForNewBlocks
+func (c *MultiEndpointClient) ListenForNewBlocks(ctx context.Context, wsc HeadSubscriber, recvBlockHandler func(block *types.Header) error) error {
+	return listenForNewBlocks(ctx, wsc, recvBlockHandler, c.logger)
+}
+
+// orderedEndpoints returns the healthy endpoints fastest first, followed by the
+// ones cooling down, soonest to recover first
+func (c *MultiEndpointClient) orderedEndpoints(now time.Time) []*endpoint {
+	var healthy, cooling []*endpoint
+	for _, e := range c.endpoints {
+		if e.healthy(now) {
+			healthy = append(healthy, e)
+		} else {
+			cooling = append(cooling, e)
+		}
+	}
+	sort.SliceStable(healthy, func(i, j int) bool {
+		return healthy[i].snapshotLatency() < healthy[j].snapshotLatency()
+	})
+	sort.SliceStable(cooling, func(i, j int) bool {
+		return cooling[i].snapshotUnhealthyUntil().Before(cooling[j].snapshotUnhealthyUntil())
+	})
+	return append(healthy, cooling...)
+}
+
+func (e *endpoint) snapshotLatency() time.Duration {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.latency
+}
+
+func (e *endpoint) snapshotUnhealthyUntil() time.Time {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.unhealthyUntil
+}
+
+// record updates the endpoint's health with the outcome of a request
+func (c *MultiEndpointClient) record(ctx context.Context, e *endpoint, elapsed time.Duration, err error) {
+	if err != nil && ctx.Err() != nil {
+		// the caller gave up, which says nothing about the endpoint
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if err == nil {
+		if e.consecutiveFailures > 0 {
+			c.logger.Sugar().Infow("RPC endpoint recovered", zap.String("endpoint", e.label))
+		}
+		e.consecutiveFailures = 0
+		e.unhealthyUntil = time.Time{}
+		if e.latency == 0 {
+			e.latency = elapsed
+		} else {
+			e.latency = time.Duration((1-latencySmoothing)*float64(e.latency) + latencySmoothing*float64(elapsed))
+		}
+		metrics.RpcEndpointHealthy.WithLabelValues(c.chainId, e.label).Set(1)
+		metrics.RpcEndpointLatencySeconds.WithLabelValues(c.chainId, e.label).Set(e.latency.Seconds())
+		return
+	}
+
+	e.consecutiveFailures++
+	e.lastError = err.Error()
+	cooldown := endpointCooldown << min(e.consecutiveFailures-1, 16)
+	if cooldown > maxEndpointCooldown {
+		cooldown = maxEndpointCooldown
+	}
+	e.unhealthyUntil = time.Now().Add(cooldown)
+	metrics.RpcEndpointHealthy.WithLabelValues(c.chainId, e.label).Set(0)
+	c.logger.Sugar().Warnw("RPC endpoint request failed",
+		zap.String("endpoint", e.label),
+		zap.Int("consecutiveFailures", e.consecutiveFailures),
+		zap.Duration("cooldown", cooldown),
+		zap.Error(err),
+	)
+}
+
+func (c *MultiEndpointClient) noQuorum(method string, agreeing int) error {
+	metrics.RpcQuorumFailures.WithLabelValues(c.chainId, method).Inc()
+	return fmt.Errorf("%w: %d of %d endpoints agreed, %d required", ErrNoQuorum, agreeing, len(c.endpoints), c.config.Quorum)
+}
+
+// withFailover sends the request to one endpoint at a time, in order of
+// preference, until one succeeds
+func withFailover[T any](ctx context.Context, c *MultiEndpointClient, method string, fn func(context.Context, Client) (T, error)) (T, error) {
+	return withEndpointFailover(ctx, c, method, func(ctx context.Context, e *endpoint) (T, error) {
+		return fn(ctx, e.client)
+	})
+}
+
+// withEndpointFailover is withFailover for requests that need the endpoint itself
+// rather than its client
+func withEndpointFailover[T any](ctx context.Context, c *MultiEndpointClient, method string, fn func(context.Context, *endpoint) (T, error)) (T, error) {
+	var zero T
+	var errs []error
+	for i, e := range c.orderedEndpoints(time.Now()) {
+		start := time.Now()
+		result, err := fn(ctx, e)
+		c.record(ctx, e, time.Since(start), err)
+		if err == nil {
+			if i > 0 {
+				metrics.RpcFailovers.WithLabelValues(c.chainId, method).Inc()
+			}
+			return result, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", e.label, err))
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return zero, fmt.Errorf("all rpc endpoints failed: %w", errors.Join(errs...))
+}
+
+// queryAll sends the request to every endpoint at once and returns the successful results
+func queryAll[T any](ctx context.Context, c *MultiEndpointClient, fn func(context.Context, Client) (T, error)) []T {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results []T
+	)
+	for _, e := range c.endpoints {
+		wg.Add(1)
+		go func(e *endpoint) {
+			defer wg.Done()
+			start := time.Now()
+			result, err := fn(ctx, e.client)
+			c.record(ctx, e, time.Since(start), err)
+			if err != nil {
+				return
+			}
+			mu.Lock()
+			results = append(results, result)
+			mu.Unlock()
+		}(e)
+	}
+	wg.Wait()
+	return results
+}
+
+// agreed returns the result that at least Quorum endpoints returned, comparing results by key
+func agreed[T any](c *MultiEndpointClient, method string, results []T, key func(T) string) (T, error) {
+	var zero T
+	counts := make(map[string]int)
+	best, bestCount := "", 0
+	for _, result := range results {
+		k := key(result)
+		counts[k]++
+		if counts[k] > bestCount {
+			best, bestCount = k, counts[k]
+		}
+	}
+	if bestCount < c.config.Quorum {
+		return zero, c.noQuorum(method, bestCount)
+	}
+	for _, result := range results {
+		if key(result) == best {
+			return result, nil
+		}
+	}
+	return zero, c.noQuorum(method, 0)
+}
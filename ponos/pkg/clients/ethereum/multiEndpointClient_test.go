@@ -0,0 +1,122 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type fakeClient struct {
+	head  uint64
+	hash  string
+	logs  []*EthereumEventLog
+	err   error
+	calls atomic.Int32
+}
+
+func (f *fakeClient) GetLatestBlock(ctx context.Context) (uint64, error) {
+	f.calls.Add(1)
+	return f.head, f.err
+}
+
+func (f *fakeClient) GetBlockByNumber(ctx context.Context, blockNumber uint64) (*EthereumBlock, error) {
+	f.calls.Add(1)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &EthereumBlock{Number: EthereumQuantity(blockNumber), Hash: EthereumHexString(f.hash)}, nil
+}
+
+func (f *fakeClient) GetLogs(ctx context.Context, address string, fromBlock uint64, toBlock uint64) ([]*EthereumEventLog, error) {
+	f.calls.Add(1)
+	return f.logs, f.err
+}
+
+func newTestMultiEndpointClient(quorum int, clients ...*fakeClient) *MultiEndpointClient {
+	endpoints := make([]*endpoint, 0, len(clients))
+	for i, client := range clients {
+		endpoints = append(endpoints, &endpoint{
+			url:    "http://rpc" + string(rune('a'+i)) + ".example",
+			label:  "rpc" + string(rune('a'+i)) + ".example",
+			client: client,
+		})
+	}
+	return newMultiEndpointClient(&MultiEndpointClientConfig{ChainId: 1, Quorum: quorum}, endpoints, zap.NewNop())
+}
+
+func Test_MultiEndpointClient(t *testing.T) {
+	t.Run("fails over to the next endpoint and skips the failed one", func(t *testing.T) {
+		failing := &fakeClient{err: errors.New("connection refused")}
+		working := &fakeClient{head: 100}
+		client := newTestMultiEndpointClient(1, failing, working)
+
+		head, err := client.GetLatestBlock(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(100), head)
+
+		head, err = client.GetLatestBlock(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(100), head)
+		assert.Equal(t, int32(1), failing.calls.Load(), "an endpoint cooling down is not tried while another is healthy")
+
+		statuses := client.EndpointStatuses()
+		assert.False(t, statuses[0].Healthy)
+		assert.Equal(t, 1, statuses[0].ConsecutiveFailures)
+		assert.True(t, statuses[1].Healthy)
+	})
+
+	t.Run("tries endpoints cooling down when none is healthy", func(t *testing.T) {
+		flaky := &fakeClient{err: errors.New("timeout")}
+		client := newTestMultiEndpointClient(1, flaky)
+
+		_, err := client.GetLatestBlock(context.Background())
+		require.Error(t, err)
+
+		flaky.err = nil
+		flaky.head = 42
+		head, err := client.GetLatestBlock(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(42), head)
+		assert.True(t, client.EndpointStatuses()[0].Healthy)
+	})
+
+	t.Run("prefers the fastest healthy endpoint", func(t *testing.T) {
+		client := newTestMultiEndpointClient(1, &fakeClient{}, &fakeClient{})
+		client.endpoints[0].latency = 200 * time.Millisecond
+		client.endpoints[1].latency = 20 * time.Millisecond
+
+		ordered := client.orderedEndpoints(time.Now())
+		assert.Equal(t, client.endpoints[1], ordered[0])
+	})
+
+	t.Run("returns the highest head a quorum has reached", func(t *testing.T) {
+		client := newTestMultiEndpointClient(2, &fakeClient{head: 105}, &fakeClient{head: 100}, &fakeClient{head: 90})
+
+		head, err := client.GetLatestBlock(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(100), head)
+	})
+
+	t.Run("returns a block a quorum agrees on", func(t *testing.T) {
+		client := newTestMultiEndpointClient(2, &fakeClient{hash: "0xa"}, &fakeClient{hash: "0xb"}, &fakeClient{hash: "0xa"})
+
+		block, err := client.GetBlockByNumber(context.Background(), 100)
+		require.NoError(t, err)
+		assert.Equal(t, "0xa", block.Hash.Value())
+	})
+
+	t.Run("fails when endpoints disagree", func(t *testing.T) {
+		logsA := []*EthereumEventLog{{BlockHash: "0xa", TransactionHash: "0x1", LogIndex: 0}}
+		logsB := []*EthereumEventLog{{BlockHash: "0xb", TransactionHash: "0x1", LogIndex: 0}}
+		client := newTestMultiEndpointClient(2, &fakeClient{logs: logsA}, &fakeClient{logs: logsB}, &fakeClient{err: errors.New("down")})
+
+		_, err := client.GetLogs(context.Background(), "0xmailbox", 100, 100)
+		assert.ErrorIs(t, err, ErrNoQuorum)
+	})
+}
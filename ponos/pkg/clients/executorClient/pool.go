@@ -0,0 +1,305 @@
+package executorClient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	executorV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/executor"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+const (
+	// DefaultPoolIdleTimeout is how long a connection may go unused before it is closed
+	DefaultPoolIdleTimeout = 10 * time.Minute
+	// DefaultPoolSweepInterval is how often the pool looks for idle connections
+	DefaultPoolSweepInterval = time.Minute
+)
+
+const (
+	dialReasonNew           = "new"
+	dialReasonReconnect     = "reconnect"
+	dialReasonSocketChanged = "socket_changed"
+
+	evictReasonIdle          = "idle"
+	evictReasonReconnect     = "reconnect"
+	evictReasonSocketChanged = "socket_changed"
+	evictReasonShutdown      = "shutdown"
+)
+
+// SubmitTaskRetryConfig returns the connection configuration used to submit tasks to
+// executors. Retries in the unary interceptor are disabled because task sessions
+// retry submissions themselves, bounded by the task deadline.
+func SubmitTaskRetryConfig() *clients.RetryConfig {
+	retryConfig := clients.DefaultRetryConfig()
+	retryConfig.MaxRetries = 0
+	return retryConfig
+}
+
+type ExecutorClientPoolConfig struct {
+	TLSEnabled bool
+	// IdleTimeout is how long a connection may go unused before it is closed
+	IdleTimeout time.Duration
+	// SweepInterval is how often idle connections are looked for
+	SweepInterval time.Duration
+	// RetryConfig is used when dialing executors, defaults to SubmitTaskRetryConfig
+	RetryConfig *clients.RetryConfig
+}
+
+type pooledConnection struct {
+	socket   string
+	conn     *grpc.ClientConn
+	client   executorV1.ExecutorServiceClient
+	inFlight int
+	lastUsed time.Time
+
+	// operators are the lowercased addresses of operators whose registered socket this is
+	operators map[string]struct{}
+	// closing is set once the connection has been removed from the pool; it is
+	// closed as soon as it has no calls in flight
+	closing bool
+	reason  string
+}
+
+// ExecutorClientPool hands out long-lived gRPC clients to executors, keyed by
+// socket, so that every task does not have to dial every operator. It is shared
+// by all AVS execution managers of an aggregator.
+//
+// Connections that are in a transient failure state are redialed when they are
+// next acquired, connections that have not been used for IdleTimeout are closed,
+// and when an operator's registered socket changes its old connection is closed
+// once no other operator uses it.
+type ExecutorClientPool struct {
+	config *ExecutorClientPoolConfig
+	logger *zap.Logger
+	dial   func(socket string) (*grpc.ClientConn, error)
+
+	mu sync.Mutex
+	// connections are the pooled connections keyed by socket
+	connections map[string]*pooledConnection
+	// operatorSockets maps a lowercased operator address to its last seen socket
+	operatorSockets map[string]string
+	closed          bool
+}
+
+func NewExecutorClientPool(cfg *ExecutorClientPoolConfig, logger *zap.Logger) *ExecutorClientPool {
+	if cfg == nil {
+		cfg = &ExecutorClientPoolConfig{}
+	}
+	if cfg.IdleTimeout <= 0 {
+		cfg.IdleTimeout = DefaultPoolIdleTimeout
+	}
+	if cfg.SweepInterval <= 0 {
+		cfg.SweepInterval = DefaultPoolSweepInterval
+	}
+	if cfg.RetryConfig == nil {
+		cfg.RetryConfig = SubmitTaskRetryConfig()
+	}
+
+	p := &ExecutorClientPool{
+		config:          cfg,
+		logger:          logger,
+		connections:     make(map[string]*pooledConnection),
+		operatorSockets: make(map[string]string),
+	}
+	p.dial = func(socket string) (*grpc.ClientConn, error) {
+		return clients.NewGrpcClientWithRetry(socket, p.config.TLSEnabled, p.config.RetryConfig)
+	}
+	return p
+}
+
+// Start evicts idle connections until the context is done, then closes the pool
+func (p *ExecutorClientPool) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(p.config.SweepInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				p.Close()
+				return
+			case <-ticker.C:
+				p.evictIdle(time.Now())
+			}
+		}
+	}()
+}
+
+// Acquire returns a client for the operator's executor at socket. The returned
+// release function must be called once the caller is done with the client.
+func (p *ExecutorClientPool) Acquire(operatorAddress string, socket string) (executorV1.ExecutorServiceClient, func(), error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return nil, nil, fmt.Errorf("executor client pool is closed")
+	}
+
+	operator := strings.ToLower(operatorAddress)
+	reason := dialReasonNew
+	if previous, ok := p.operatorSockets[operator]; ok && previous != socket {
+		p.logger.Sugar().Infow("Executor socket changed, invalidating pooled connection",
+			zap.String("operatorAddress", operatorAddress),
+			zap.String("previousSocket", previous),
+			zap.String("socket", socket),
+		)
+		p.detachOperator(operator, previous)
+		reason = dialReasonSocketChanged
+	}
+
+	pc, ok := p.connections[socket]
+	if ok && !p.isHealthy(pc) {
+		if pc.inFlight > 0 {
+			// other calls are still using the connection, nudge it to reconnect now
+			// rather than waiting out its backoff
+			pc.conn.ResetConnectBackoff()
+		} else {
+			p.logger.Sugar().Infow("Redialing unhealthy executor connection",
+				zap.String("socket", socket),
+				zap.String("state", pc.conn.GetState().String()),
+			)
+			p.removeConnection(pc, evictReasonReconnect)
+			pc, ok = nil, false
+			reason = dialReasonReconnect
+		}
+	}
+
+	if !ok {
+		conn, err := p.dial(socket)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to dial executor at %s: %w", socket, err)
+		}
+		pc = &pooledConnection{
+			socket:    socket,
+			conn:      conn,
+			client:    executorV1.NewExecutorServiceClient(conn),
+			operators: make(map[string]struct{}),
+		}
+		p.connections[socket] = pc
+		metrics.AggregatorExecutorConnections.Inc()
+		metrics.AggregatorExecutorConnectionDials.WithLabelValues(reason).Inc()
+	}
+
+	pc.operators[operator] = struct{}{}
+	p.operatorSockets[operator] = socket
+	pc.inFlight++
+	pc.lastUsed = time.Now()
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() { p.release(pc) })
+	}
+	return pc.client, release, nil
+}
+
+// Close closes every pooled connection, including those with calls in flight
+func (p *ExecutorClientPool) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed = true
+	for _, pc := range p.connections {
+		pc.inFlight = 0
+		p.removeConnection(pc, evictReasonShutdown)
+	}
+	p.operatorSockets = make(map[string]string)
+}
+
+func (p *ExecutorClientPool) release(pc *pooledConnection) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if pc.inFlight > 0 {
+		pc.inFlight--
+	}
+	pc.lastUsed = time.Now()
+	if pc.closing && pc.inFlight == 0 {
+		p.closeConnection(pc)
+	}
+}
+
+// evictIdle closes connections that have no calls in flight and have not been
+// used since before the idle timeout
+func (p *ExecutorClientPool) evictIdle(now time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, pc := range p.connections {
+		if pc.inFlight > 0 || now.Sub(pc.lastUsed) < p.config.IdleTimeout {
+			continue
+		}
+		p.logger.Sugar().Debugw("Closing idle executor connection",
+			zap.String("socket", pc.socket),
+			zap.Duration("idle", now.Sub(pc.lastUsed)),
+		)
+		p.removeConnection(pc, evictReasonIdle)
+	}
+}
+
+// isHealthy reports whether a connection can be handed out as is. Ready, idle and
+// connecting connections are fine; a connection in transient failure fails calls
+// fast until its backoff elapses, so it is redialed instead.
+func (p *ExecutorClientPool) isHealthy(pc *pooledConnection) bool {
+	switch pc.conn.GetState() {
+	case connectivity.TransientFailure, connectivity.Shutdown:
+		return false
+	default:
+		return true
+	}
+}
+
+// detachOperator removes the operator from the connection at socket, removing the
+// connection from the pool if no other operator uses it.
+// LOCKING: assumes the caller holds p.mu
+func (p *ExecutorClientPool) detachOperator(operator string, socket string) {
+	delete(p.operatorSockets, operator)
+	pc, ok := p.connections[socket]
+	if !ok {
+		return
+	}
+	delete(pc.operators, operator)
+	if len(pc.operators) == 0 {
+		p.removeConnection(pc, evictReasonSocketChanged)
+	}
+}
+
+// removeConnection takes a connection out of the pool and closes it once it has no
+// calls in flight.
+// LOCKING: assumes the caller holds p.mu
+func (p *ExecutorClientPool) removeConnection(pc *pooledConnection, reason string) {
+	if current, ok := p.connections[pc.socket]; ok && current == pc {
+		delete(p.connections, pc.socket)
+	}
+	for operator := range pc.operators {
+		if p.operatorSockets[operator] == pc.socket {
+			delete(p.operatorSockets, operator)
+		}
+	}
+	pc.closing = true
+	pc.reason = reason
+	if pc.inFlight == 0 {
+		p.closeConnection(pc)
+	}
+}
+
+// LOCKING: assumes the caller holds p.mu
+func (p *ExecutorClientPool) closeConnection(pc *pooledConnection) {
+	if pc.conn == nil {
+		return
+	}
+	if err := pc.conn.Close(); err != nil {
+		p.logger.Sugar().Warnw("Failed to close executor connection",
+			zap.String("socket", pc.socket),
+			zap.Error(err),
+		)
+	}
+	pc.conn = nil
+	metrics.AggregatorExecutorConnections.Dec()
+	metrics.AggregatorExecutorConnectionEvictions.WithLabelValues(pc.reason).Inc()
+}
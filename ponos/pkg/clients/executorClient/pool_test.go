@@ -0,0 +1,160 @@
+package executorClient
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	executorV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+)
+
+// startTestExecutorServer starts an executor gRPC server that rejects every task
+// and returns its socket
+func startTestExecutorServer(t *testing.T) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	executorV1.RegisterExecutorServiceServer(server, &executorV1.UnimplementedExecutorServiceServer{})
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	return lis.Addr().String()
+}
+
+func Test_ExecutorClientPool(t *testing.T) {
+	t.Run("reuses one connection per socket", func(t *testing.T) {
+		socket := startTestExecutorServer(t)
+		pool := NewExecutorClientPool(nil, zaptest.NewLogger(t))
+		defer pool.Close()
+
+		for _, operator := range []string{"0xA", "0xa", "0xb"} {
+			client, release, err := pool.Acquire(operator, socket)
+			require.NoError(t, err)
+
+			_, err = client.SubmitTask(context.Background(), &executorV1.TaskSubmission{TaskId: "task"})
+			assert.Equal(t, codes.Unimplemented, status.Code(err))
+			release()
+		}
+
+		require.Len(t, pool.connections, 1)
+		assert.Len(t, pool.connections[socket].operators, 2)
+		assert.Equal(t, 0, pool.connections[socket].inFlight)
+	})
+
+	t.Run("closes the old connection when an operator's socket changes", func(t *testing.T) {
+		oldSocket := startTestExecutorServer(t)
+		newSocket := startTestExecutorServer(t)
+		pool := NewExecutorClientPool(nil, zaptest.NewLogger(t))
+		defer pool.Close()
+
+		_, releaseOld, err := pool.Acquire("0xa", oldSocket)
+		require.NoError(t, err)
+		old := pool.connections[oldSocket]
+
+		_, releaseNew, err := pool.Acquire("0xa", newSocket)
+		require.NoError(t, err)
+		defer releaseNew()
+
+		assert.NotContains(t, pool.connections, oldSocket)
+		assert.Contains(t, pool.connections, newSocket)
+		assert.Equal(t, newSocket, pool.operatorSockets["0xa"])
+
+		// the old connection stays open until the call using it completes
+		require.NotNil(t, old.conn)
+		assert.NotEqual(t, connectivity.Shutdown, old.conn.GetState())
+		releaseOld()
+		assert.Nil(t, old.conn)
+	})
+
+	t.Run("keeps a socket shared with another operator when one operator moves", func(t *testing.T) {
+		sharedSocket := startTestExecutorServer(t)
+		newSocket := startTestExecutorServer(t)
+		pool := NewExecutorClientPool(nil, zaptest.NewLogger(t))
+		defer pool.Close()
+
+		for _, operator := range []string{"0xa", "0xb"} {
+			_, release, err := pool.Acquire(operator, sharedSocket)
+			require.NoError(t, err)
+			release()
+		}
+		_, release, err := pool.Acquire("0xa", newSocket)
+		require.NoError(t, err)
+		release()
+
+		require.Contains(t, pool.connections, sharedSocket)
+		assert.Len(t, pool.connections[sharedSocket].operators, 1)
+		assert.Len(t, pool.connections, 2)
+	})
+
+	t.Run("evicts idle connections without calls in flight", func(t *testing.T) {
+		idleSocket := startTestExecutorServer(t)
+		busySocket := startTestExecutorServer(t)
+		pool := NewExecutorClientPool(&ExecutorClientPoolConfig{IdleTimeout: time.Minute}, zaptest.NewLogger(t))
+		defer pool.Close()
+
+		_, releaseIdle, err := pool.Acquire("0xa", idleSocket)
+		require.NoError(t, err)
+		releaseIdle()
+		_, releaseBusy, err := pool.Acquire("0xb", busySocket)
+		require.NoError(t, err)
+		defer releaseBusy()
+
+		pool.evictIdle(time.Now().Add(30 * time.Second))
+		assert.Len(t, pool.connections, 2)
+
+		pool.evictIdle(time.Now().Add(2 * time.Minute))
+		assert.NotContains(t, pool.connections, idleSocket)
+		assert.Contains(t, pool.connections, busySocket)
+		assert.NotContains(t, pool.operatorSockets, "0xa")
+	})
+
+	t.Run("redials connections in transient failure", func(t *testing.T) {
+		// reserve a port with nothing listening on it
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		socket := lis.Addr().String()
+		require.NoError(t, lis.Close())
+
+		pool := NewExecutorClientPool(nil, zaptest.NewLogger(t))
+		defer pool.Close()
+
+		_, release, err := pool.Acquire("0xa", socket)
+		require.NoError(t, err)
+		release()
+		first := pool.connections[socket]
+
+		require.Eventually(t, func() bool {
+			return first.conn.GetState() == connectivity.TransientFailure
+		}, 5*time.Second, 10*time.Millisecond)
+
+		_, release, err = pool.Acquire("0xa", socket)
+		require.NoError(t, err)
+		release()
+
+		assert.NotSame(t, first, pool.connections[socket])
+		assert.Nil(t, first.conn)
+	})
+
+	t.Run("rejects acquisitions once closed", func(t *testing.T) {
+		socket := startTestExecutorServer(t)
+		pool := NewExecutorClientPool(nil, zaptest.NewLogger(t))
+
+		_, release, err := pool.Acquire("0xa", socket)
+		require.NoError(t, err)
+		pool.Close()
+		release()
+
+		assert.Empty(t, pool.connections)
+		_, _, err = pool.Acquire("0xa", socket)
+		assert.Error(t, err)
+	})
+}
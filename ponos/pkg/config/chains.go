@@ -0,0 +1,205 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ChainRole is the part a chain plays in an EigenLayer deployment. The L1 hosts the
+// core contracts operator sets are registered with; L2s host a TaskMailbox and the
+// certificate verifiers the operator tables are transported to.
+type ChainRole string
+
+const (
+	ChainRoleL1 ChainRole = "l1"
+	ChainRoleL2 ChainRole = "l2"
+)
+
+// ChainDefinition declares the EigenLayer deployment on an EVM chain. For built-in
+// chains every field is optional and overrides the built-in defaults field by field.
+// Any other chain must declare its role and at least its TaskMailbox address.
+type ChainDefinition struct {
+	Role             ChainRole                 `json:"role,omitempty" yaml:"role,omitempty"`
+	CoreContracts    *CoreContractAddresses    `json:"coreContracts,omitempty" yaml:"coreContracts,omitempty"`
+	TableCalculators *TableCalculatorAddresses `json:"tableCalculators,omitempty" yaml:"tableCalculators,omitempty"`
+}
+
+func (cd *ChainDefinition) Validate(chainId ChainId) field.ErrorList {
+	var allErrors field.ErrorList
+	if cd.Role != "" && cd.Role != ChainRoleL1 && cd.Role != ChainRoleL2 {
+		allErrors = append(allErrors, field.NotSupported(field.NewPath("role"), cd.Role, []string{string(ChainRoleL1), string(ChainRoleL2)}))
+	}
+
+	if !IsBuiltInChain(chainId) {
+		if cd.Role == "" {
+			allErrors = append(allErrors, field.Required(field.NewPath("role"), "role is required for chains that are not built in"))
+		}
+		required := []string{"taskMailbox"}
+		if cd.Role == ChainRoleL1 {
+			required = append(required, "allocationManager", "keyRegistrar", "crossChainRegistry")
+		}
+		coreContracts := cd.CoreContracts
+		if coreContracts == nil {
+			coreContracts = &CoreContractAddresses{}
+		}
+		for _, f := range coreContracts.fields() {
+			if slices.Contains(required, f.name) && *f.address == "" {
+				allErrors = append(allErrors, field.Required(field.NewPath("coreContracts", f.name), fmt.Sprintf("%s is required for chains that are not built in", f.name)))
+			}
+		}
+	}
+
+	if cd.CoreContracts != nil {
+		for _, f := range cd.CoreContracts.fields() {
+			if *f.address != "" && !common.IsHexAddress(*f.address) {
+				allErrors = append(allErrors, field.Invalid(field.NewPath("coreContracts", f.name), *f.address, "must be a hex address"))
+			}
+		}
+	}
+	if cd.TableCalculators != nil {
+		for name, address := range map[string]string{"bn254": cd.TableCalculators.BN254, "ecdsa": cd.TableCalculators.ECDSA} {
+			if address != "" && !common.IsHexAddress(address) {
+				allErrors = append(allErrors, field.Invalid(field.NewPath("tableCalculators", name), address, "must be a hex address"))
+			}
+		}
+	}
+	return allErrors
+}
+
+// RoleForChain returns the role declared for the chain, falling back to the role of the built-in chain
+func (cd *ChainDefinition) RoleForChain(chainId ChainId) ChainRole {
+	if cd.Role != "" {
+		return cd.Role
+	}
+	if slices.Contains(BuiltInL1ChainIds, chainId) {
+		return ChainRoleL1
+	}
+	return ChainRoleL2
+}
+
+type coreContractField struct {
+	name    string
+	address *string
+}
+
+func (c *CoreContractAddresses) fields() []coreContractField {
+	return []coreContractField{
+		{"allocationManager", &c.AllocationManager},
+		{"delegationManager", &c.DelegationManager},
+		{"releaseManager", &c.ReleaseManager},
+		{"taskMailbox", &c.TaskMailbox},
+		{"keyRegistrar", &c.KeyRegistrar},
+		{"crossChainRegistry", &c.CrossChainRegistry},
+		{"ecdsaCertificateVerifier", &c.ECDSACertificateVerifier},
+		{"bn254CertificateVerifier", &c.BN254CertificateVerifier},
+	}
+}
+
+// withOverrides returns a copy of the addresses with every non-empty address of overrides applied
+func (c *CoreContractAddresses) withOverrides(overrides *CoreContractAddresses) *CoreContractAddresses {
+	merged := *c
+	if overrides == nil {
+		return &merged
+	}
+	overrideFields := overrides.fields()
+	for i, f := range merged.fields() {
+		if address := *overrideFields[i].address; address != "" {
+			*f.address = address
+		}
+	}
+	return &merged
+}
+
+var (
+	registeredChainsLock sync.RWMutex
+	registeredChains     = make(map[ChainId]*ChainDefinition)
+)
+
+// RegisterChain makes a chain declared in config available next to the built-in
+// chains, or overrides the defaults of a built-in chain. Registering a chain again
+// replaces its previous definition.
+func RegisterChain(chainId ChainId, definition *ChainDefinition) {
+	registeredChainsLock.Lock()
+	defer registeredChainsLock.Unlock()
+	registeredChains[chainId] = definition
+}
+
+func getRegisteredChain(chainId ChainId) (*ChainDefinition, bool) {
+	registeredChainsLock.RLock()
+	defer registeredChainsLock.RUnlock()
+	definition, ok := registeredChains[chainId]
+	return definition, ok
+}
+
+// IsBuiltInChain returns true for the chains ponos ships contract addresses for
+func IsBuiltInChain(chainId ChainId) bool {
+	return slices.Contains(SupportedChainIds, chainId)
+}
+
+// IsSupportedChain returns true for built-in chains and chains registered from config
+func IsSupportedChain(chainId ChainId) bool {
+	if IsBuiltInChain(chainId) {
+		return true
+	}
+	_, ok := getRegisteredChain(chainId)
+	return ok
+}
+
+func IsL1Chain(chainId ChainId) bool {
+	if definition, ok := getRegisteredChain(chainId); ok {
+		return definition.RoleForChain(chainId) == ChainRoleL1
+	}
+	return slices.Contains(BuiltInL1ChainIds, chainId)
+}
+
+// GetCoreContractsForChainId returns the core contract addresses of a chain, with the
+// addresses declared in config applied over the built-in ones
+func GetCoreContractsForChainId(chainId ChainId) (*CoreContractAddresses, error) {
+	builtIn, isBuiltIn := CoreContracts[chainId]
+	definition, isRegistered := getRegisteredChain(chainId)
+	if !isRegistered {
+		if !isBuiltIn {
+			return nil, fmt.Errorf("unsupported chain ID: %d", chainId)
+		}
+		return builtIn, nil
+	}
+	if !isBuiltIn {
+		builtIn = &CoreContractAddresses{}
+	}
+	return builtIn.withOverrides(definition.CoreContracts), nil
+}
+
+func GetContractsMapForChain(chainId ChainId) *CoreContractAddresses {
+	contracts, err := GetCoreContractsForChainId(chainId)
+	if err != nil {
+		return nil
+	}
+	return contracts
+}
+
+// GetTableCalculatorsForChainId returns the operator table calculator addresses of a
+// chain, with the addresses declared in config applied over the built-in ones
+func GetTableCalculatorsForChainId(chainId ChainId) (*TableCalculatorAddresses, error) {
+	calculators := &TableCalculatorAddresses{}
+	builtIn, found := TableCalculatorsByChain[chainId]
+	if found {
+		*calculators = *builtIn
+	}
+	if definition, ok := getRegisteredChain(chainId); ok && definition.TableCalculators != nil {
+		found = true
+		if definition.TableCalculators.BN254 != "" {
+			calculators.BN254 = definition.TableCalculators.BN254
+		}
+		if definition.TableCalculators.ECDSA != "" {
+			calculators.ECDSA = definition.TableCalculators.ECDSA
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("no table calculators configured for chain ID: %d", chainId)
+	}
+	return calculators, nil
+}
@@ -0,0 +1,97 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ChainRegistry(t *testing.T) {
+	const customChainId ChainId = 424242
+	t.Cleanup(func() {
+		delete(registeredChains, customChainId)
+		delete(registeredChains, ChainId_BaseSepolia)
+	})
+
+	t.Run("Should not support a chain that is neither built in nor registered", func(t *testing.T) {
+		assert.False(t, IsSupportedChain(customChainId))
+		_, err := GetCoreContractsForChainId(customChainId)
+		assert.Error(t, err)
+		_, err = GetTableCalculatorsForChainId(customChainId)
+		assert.Error(t, err)
+	})
+
+	t.Run("Should use the contracts and role declared for a registered chain", func(t *testing.T) {
+		RegisterChain(customChainId, &ChainDefinition{
+			Role: ChainRoleL1,
+			CoreContracts: &CoreContractAddresses{
+				AllocationManager:  "0x0000000000000000000000000000000000000001",
+				TaskMailbox:        "0x0000000000000000000000000000000000000002",
+				KeyRegistrar:       "0x0000000000000000000000000000000000000003",
+				CrossChainRegistry: "0x0000000000000000000000000000000000000004",
+			},
+			TableCalculators: &TableCalculatorAddresses{BN254: "0x0000000000000000000000000000000000000005"},
+		})
+
+		assert.True(t, IsSupportedChain(customChainId))
+		assert.True(t, IsL1Chain(customChainId))
+
+		contracts, err := GetCoreContractsForChainId(customChainId)
+		require.NoError(t, err)
+		assert.Equal(t, "0x0000000000000000000000000000000000000002", contracts.TaskMailbox)
+		assert.Empty(t, contracts.DelegationManager)
+
+		calculators, err := GetTableCalculatorsForChainId(customChainId)
+		require.NoError(t, err)
+		assert.Equal(t, "0x0000000000000000000000000000000000000005", calculators.BN254)
+		assert.Empty(t, calculators.ECDSA)
+	})
+
+	t.Run("Should apply declared addresses over the defaults of a built-in chain", func(t *testing.T) {
+		RegisterChain(ChainId_BaseSepolia, &ChainDefinition{
+			CoreContracts:    &CoreContractAddresses{TaskMailbox: "0x0000000000000000000000000000000000000006"},
+			TableCalculators: &TableCalculatorAddresses{ECDSA: "0x0000000000000000000000000000000000000007"},
+		})
+
+		assert.False(t, IsL1Chain(ChainId_BaseSepolia))
+
+		contracts, err := GetCoreContractsForChainId(ChainId_BaseSepolia)
+		require.NoError(t, err)
+		assert.Equal(t, "0x0000000000000000000000000000000000000006", contracts.TaskMailbox)
+		assert.Equal(t, baseSepoliaCoreContracts.BN254CertificateVerifier, contracts.BN254CertificateVerifier)
+		assert.Equal(t, "0xb99cc53e8db7018f557606c2a5b066527bf96b26", baseSepoliaCoreContracts.TaskMailbox, "built-in defaults are left untouched")
+
+		calculators, err := GetTableCalculatorsForChainId(ChainId_BaseSepolia)
+		require.NoError(t, err)
+		assert.Equal(t, TableCalculatorsByChain[ChainId_BaseSepolia].BN254, calculators.BN254)
+		assert.Equal(t, "0x0000000000000000000000000000000000000007", calculators.ECDSA)
+	})
+}
+
+func Test_ChainDefinitionValidate(t *testing.T) {
+	t.Run("Should not require anything for a built-in chain", func(t *testing.T) {
+		assert.Empty(t, (&ChainDefinition{}).Validate(ChainId_EthereumMainnet))
+	})
+
+	t.Run("Should require the L1 core contracts of a chain that is not built in", func(t *testing.T) {
+		errs := (&ChainDefinition{
+			Role:          ChainRoleL1,
+			CoreContracts: &CoreContractAddresses{TaskMailbox: "0x0000000000000000000000000000000000000002"},
+		}).Validate(424242)
+		require.Len(t, errs, 3)
+		assert.Equal(t, "coreContracts.allocationManager", errs[0].Field)
+	})
+
+	t.Run("Should reject unknown roles and malformed addresses", func(t *testing.T) {
+		errs := (&ChainDefinition{
+			Role:             "l3",
+			CoreContracts:    &CoreContractAddresses{TaskMailbox: "mailbox"},
+			TableCalculators: &TableCalculatorAddresses{BN254: "calculator"},
+		}).Validate(ChainId_BaseMainnet)
+		require.Len(t, errs, 3)
+		assert.Equal(t, "role", errs[0].Field)
+		assert.Equal(t, "coreContracts.taskMailbox", errs[1].Field)
+		assert.Equal(t, "tableCalculators.bn254", errs[2].Field)
+	})
+}
@@ -2,14 +2,13 @@ package config
 
 import (
 	"fmt"
-	"slices"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 type TableCalculatorAddresses struct {
-	BN254 string
-	ECDSA string
+	BN254 string `json:"bn254,omitempty" yaml:"bn254,omitempty"`
+	ECDSA string `json:"ecdsa,omitempty" yaml:"ecdsa,omitempty"`
 }
 
 var TableCalculatorsByChain = map[ChainId]*TableCalculatorAddresses{
@@ -109,25 +108,24 @@ var EthereumSimulationContracts = CoreContractAddresses{
 	TaskMailbox:       "0x7306a649b451ae08781108445425bd4e8acf1e00",
 }
 
-func IsL1Chain(chainId ChainId) bool {
-	return slices.Contains([]ChainId{
-		ChainId_EthereumMainnet,
-		ChainId_EthereumHolesky,
-		ChainId_EthereumHoodi,
-		ChainId_EthereumAnvil,
-		ChainId_EthereumSepolia,
-	}, chainId)
+// BuiltInL1ChainIds are the built-in chains that play the L1 role
+var BuiltInL1ChainIds = []ChainId{
+	ChainId_EthereumMainnet,
+	ChainId_EthereumHolesky,
+	ChainId_EthereumHoodi,
+	ChainId_EthereumAnvil,
+	ChainId_EthereumSepolia,
 }
 
 type CoreContractAddresses struct {
-	AllocationManager        string
-	DelegationManager        string
-	ReleaseManager           string
-	TaskMailbox              string
-	KeyRegistrar             string
-	CrossChainRegistry       string
-	ECDSACertificateVerifier string
-	BN254CertificateVerifier string
+	AllocationManager        string `json:"allocationManager,omitempty" yaml:"allocationManager,omitempty"`
+	DelegationManager        string `json:"delegationManager,omitempty" yaml:"delegationManager,omitempty"`
+	ReleaseManager           string `json:"releaseManager,omitempty" yaml:"releaseManager,omitempty"`
+	TaskMailbox              string `json:"taskMailbox,omitempty" yaml:"taskMailbox,omitempty"`
+	KeyRegistrar             string `json:"keyRegistrar,omitempty" yaml:"keyRegistrar,omitempty"`
+	CrossChainRegistry       string `json:"crossChainRegistry,omitempty" yaml:"crossChainRegistry,omitempty"`
+	ECDSACertificateVerifier string `json:"ecdsaCertificateVerifier,omitempty" yaml:"ecdsaCertificateVerifier,omitempty"`
+	BN254CertificateVerifier string `json:"bn254CertificateVerifier,omitempty" yaml:"bn254CertificateVerifier,omitempty"`
 }
 
 var (
@@ -180,15 +178,9 @@ var (
 	}
 )
 
-func GetCoreContractsForChainId(chainId ChainId) (*CoreContractAddresses, error) {
-	contracts, ok := CoreContracts[chainId]
-	if !ok {
-		return nil, fmt.Errorf("unsupported chain ID: %d", chainId)
-	}
-	return contracts, nil
-}
-
 var (
+	// SupportedChainIds are the built-in chains. Other EVM chains can be declared in config,
+	// see RegisterChain
 	SupportedChainIds = []ChainId{
 		ChainId_EthereumMainnet,
 		ChainId_BaseMainnet,
@@ -201,14 +193,6 @@ var (
 	}
 )
 
-func GetContractsMapForChain(chainId ChainId) *CoreContractAddresses {
-	contracts, ok := CoreContracts[chainId]
-	if !ok {
-		return nil
-	}
-	return contracts
-}
-
 type OperatorConfig struct {
 	Address string `json:"address" yaml:"address"`
 	// OperatorPrivateKey is the private key of the operator used for signing transactions.
@@ -198,6 +198,9 @@ func (dcm *DockerContainerManager) Create(ctx context.Context, config *Container
 	if config.CPUShares > 0 {
 		hostConfig.CPUShares = config.CPUShares
 	}
+	if config.NanoCPUs > 0 {
+		hostConfig.NanoCPUs = config.NanoCPUs
+	}
 
 	// Set restart policy if specified
 	if config.RestartPolicy != "" {
@@ -26,6 +26,7 @@ type ContainerConfig struct {
 	// Resource limits
 	MemoryLimit int64 // in bytes
 	CPUShares   int64
+	NanoCPUs    int64 // CPU limit in units of 1e-9 CPUs
 
 	// Security settings
 	User       string
@@ -9,10 +9,14 @@ import (
 type IBlockContextManager interface {
 	GetContext(blockNumber uint64, task *types.Task) context.Context
 	CancelBlock(blockNumber uint64)
+	// CancelTasks cancels individual tasks, whichever block they were created in,
+	// leaving the other tasks of their blocks running
+	CancelTasks(taskIds []string)
 }
 
 type BlockContext struct {
-	Ctx     context.Context
-	Cancel  context.CancelFunc
-	TaskIDs map[string]struct{}
+	Ctx    context.Context
+	Cancel context.CancelFunc
+	// Tasks cancels each task of the block on its own
+	Tasks map[string]context.CancelFunc
 }
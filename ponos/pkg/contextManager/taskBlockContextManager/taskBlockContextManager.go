@@ -2,6 +2,7 @@ package taskBlockContextManager
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 
@@ -34,30 +35,40 @@ func NewTaskBlockContextManager(parentCtx context.Context, store storage.Aggrega
 	return mgr
 }
 
+// GetContext returns the context of a task, which ends at the deadline of the
+// first task of its block, when its block is cancelled, or when the task itself is
 func (bcm *TaskBlockContextManager) GetContext(blockNumber uint64, task *types.Task) context.Context {
 	bcm.mu.Lock()
 	defer bcm.mu.Unlock()
 
-	if blockCtx, exists := bcm.blockContexts[blockNumber]; exists {
-		blockCtx.TaskIDs[task.TaskId] = struct{}{}
-		return blockCtx.Ctx
-	}
-
-	ctx, cancel := context.WithDeadline(bcm.parentCtx, *task.DeadlineUnixSeconds)
+	blockCtx, exists := bcm.blockContexts[blockNumber]
+	if !exists {
+		ctx, cancel := context.WithDeadline(bcm.parentCtx, *task.DeadlineUnixSeconds)
+		blockCtx = &contextManager.BlockContext{
+			Ctx:    ctx,
+			Cancel: cancel,
+			Tasks:  make(map[string]context.CancelFunc),
+		}
+		bcm.blockContexts[blockNumber] = blockCtx
 
-	bcm.blockContexts[blockNumber] = &contextManager.BlockContext{
-		Ctx:     ctx,
-		Cancel:  cancel,
-		TaskIDs: map[string]struct{}{task.TaskId: {}},
+		bcm.logger.Debug("Created new context for block",
+			zap.Uint64("blockNumber", blockNumber),
+			zap.String("taskId", task.TaskId),
+			zap.Time("deadline", *task.DeadlineUnixSeconds),
+		)
 	}
 
-	bcm.logger.Debug("Created new context for block",
-		zap.Uint64("blockNumber", blockNumber),
-		zap.String("taskId", task.TaskId),
-		zap.Time("deadline", *task.DeadlineUnixSeconds),
-	)
-
-	return ctx
+	taskCtx, cancel := context.WithCancel(blockCtx.Ctx)
+	if cancelPrevious, ok := blockCtx.Tasks[task.TaskId]; ok {
+		// the task was seen before, e.g. during recovery, and is cancelled everywhere at once
+		blockCtx.Tasks[task.TaskId] = func() {
+			cancelPrevious()
+			cancel()
+		}
+	} else {
+		blockCtx.Tasks[task.TaskId] = cancel
+	}
+	return taskCtx
 }
 
 func (bcm *TaskBlockContextManager) CancelBlock(blockNumber uint64) {
@@ -71,7 +82,7 @@ func (bcm *TaskBlockContextManager) CancelBlock(blockNumber uint64) {
 
 	blockCtx.Cancel()
 
-	for taskID := range blockCtx.TaskIDs {
+	for taskID := range blockCtx.Tasks {
 		if err := bcm.store.DeleteTask(context.Background(), taskID); err != nil {
 			bcm.logger.Error("Failed to delete task from storage",
 				zap.String("taskId", taskID),
@@ -85,7 +96,40 @@ func (bcm *TaskBlockContextManager) CancelBlock(blockNumber uint64) {
 
 	bcm.logger.Info("Cancelled context for block",
 		zap.Uint64("blockNumber", blockNumber),
-		zap.Int("deletedTasks", len(blockCtx.TaskIDs)),
+		zap.Int("deletedTasks", len(blockCtx.Tasks)),
+	)
+}
+
+// CancelTasks cancels the given tasks and deletes them from storage. A block whose
+// tasks have all been cancelled is released.
+func (bcm *TaskBlockContextManager) CancelTasks(taskIds []string) {
+	bcm.mu.Lock()
+	defer bcm.mu.Unlock()
+
+	for _, taskID := range taskIds {
+		for blockNumber, blockCtx := range bcm.blockContexts {
+			cancel, ok := blockCtx.Tasks[taskID]
+			if !ok {
+				continue
+			}
+			cancel()
+			delete(blockCtx.Tasks, taskID)
+			if len(blockCtx.Tasks) == 0 {
+				blockCtx.Cancel()
+				delete(bcm.blockContexts, blockNumber)
+			}
+		}
+
+		if err := bcm.store.DeleteTask(context.Background(), taskID); err != nil && !errors.Is(err, storage.ErrNotFound) {
+			bcm.logger.Error("Failed to delete task from storage",
+				zap.String("taskId", taskID),
+				zap.Error(err),
+			)
+		}
+	}
+
+	bcm.logger.Info("Cancelled tasks",
+		zap.Strings("taskIds", taskIds),
 	)
 }
 
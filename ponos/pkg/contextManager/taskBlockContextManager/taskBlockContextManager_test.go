@@ -553,3 +553,35 @@ func TestTaskBlockContextManager_EmptyState(t *testing.T) {
 	assert.Equal(t, 0, len(mgr.blockContexts))
 	mgr.mu.RUnlock()
 }
+
+// Test that CancelTasks cancels only the given tasks of a block
+func TestTaskBlockContextManager_CancelTasks_LeavesOtherTasksRunning(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewInMemoryAggregatorStore()
+	mgr := NewTaskBlockContextManager(ctx, store, zap.NewNop())
+
+	deadline := time.Now().Add(1 * time.Hour)
+	cancelled := &types.Task{TaskId: "task-1", AVSAddress: "0xtest", DeadlineUnixSeconds: &deadline}
+	retained := &types.Task{TaskId: "task-2", AVSAddress: "0xtest", DeadlineUnixSeconds: &deadline}
+	require.NoError(t, store.SavePendingTask(ctx, cancelled))
+	require.NoError(t, store.SavePendingTask(ctx, retained))
+
+	cancelledCtx := mgr.GetContext(100, cancelled)
+	retainedCtx := mgr.GetContext(100, retained)
+
+	mgr.CancelTasks([]string{"task-1"})
+
+	assert.ErrorIs(t, cancelledCtx.Err(), context.Canceled)
+	assert.NoError(t, retainedCtx.Err())
+	_, err := store.GetTask(ctx, "task-1")
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+	_, err = store.GetTask(ctx, "task-2")
+	assert.NoError(t, err)
+
+	// the last task of a block releases the block
+	mgr.CancelTasks([]string{"task-2"})
+	assert.ErrorIs(t, retainedCtx.Err(), context.Canceled)
+	mgr.mu.RLock()
+	assert.Empty(t, mgr.blockContexts)
+	mgr.mu.RUnlock()
+}
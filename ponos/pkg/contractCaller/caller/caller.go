@@ -1410,9 +1410,9 @@ func (cc *ContractCaller) CreateGenerationReservation(
 }
 
 func GetTableCalculatorAddress(curveType config.CurveType, chainId config.ChainId) (common.Address, error) {
-	calculators, ok := config.TableCalculatorsByChain[chainId]
-	if !ok {
-		return common.Address{}, fmt.Errorf("no table calculators configured for chain ID: %d", chainId)
+	calculators, err := config.GetTableCalculatorsForChainId(chainId)
+	if err != nil {
+		return common.Address{}, err
 	}
 
 	switch curveType {
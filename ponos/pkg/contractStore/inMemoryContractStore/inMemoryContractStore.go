@@ -100,6 +100,46 @@ func (ics *InMemoryContractStore) OverrideContract(contractName string, chainIds
 	return nil
 }
 
+// SetChainContracts points the stored contracts of a chain at the addresses declared in
+// config. A chain without an entry for one of the contracts gets one, using the ABI the
+// contract has on the other chains.
+func (ics *InMemoryContractStore) SetChainContracts(chainId config.ChainId, addresses *config.CoreContractAddresses) error {
+	for name, address := range map[string]string{
+		config.ContractName_AllocationManager: addresses.AllocationManager,
+		config.ContractName_TaskMailbox:       addresses.TaskMailbox,
+	} {
+		if address == "" {
+			continue
+		}
+		existing := util.Find(ics.contracts, func(c *contracts.Contract) bool {
+			return c.Name == name && c.ChainId == chainId
+		})
+		if existing != nil {
+			existing.Address = address
+			continue
+		}
+
+		template := util.Find(ics.contracts, func(c *contracts.Contract) bool {
+			return c.Name == name
+		})
+		if template == nil {
+			return fmt.Errorf("no ABI loaded for contract %s", name)
+		}
+		ics.logger.Sugar().Infow("Adding contract for configured chain",
+			zap.String("name", name),
+			zap.String("address", address),
+			zap.Any("chainId", chainId),
+		)
+		ics.contracts = append(ics.contracts, &contracts.Contract{
+			Name:        name,
+			Address:     address,
+			ChainId:     chainId,
+			AbiVersions: template.AbiVersions,
+		})
+	}
+	return nil
+}
+
 func (ics *InMemoryContractStore) ListContracts() []*contracts.Contract {
 	return ics.contracts
 }
@@ -4,6 +4,7 @@ import (
 	"slices"
 	"testing"
 
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contracts"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/eigenlayer"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/executor/executorConfig"
@@ -44,6 +45,32 @@ func Test_InMemoryContractStore(t *testing.T) {
 		assert.NotNil(t, taskMailbox)
 		assert.Equal(t, "0xtest", taskMailbox.Address)
 	})
+
+	t.Run("Should add the contracts of a chain declared in config", func(t *testing.T) {
+		l, err := logger.NewLogger(&logger.LoggerConfig{Debug: false})
+		assert.Nil(t, err)
+
+		loadedContracts, err := eigenlayer.LoadContracts()
+		assert.Nil(t, err)
+
+		imcs := NewInMemoryContractStore(loadedContracts, l)
+		err = imcs.SetChainContracts(424242, &config.CoreContractAddresses{TaskMailbox: "0xcustommailbox"})
+		assert.Nil(t, err)
+		err = imcs.SetChainContracts(config.ChainId_BaseSepolia, &config.CoreContractAddresses{TaskMailbox: "0xbasemailbox"})
+		assert.Nil(t, err)
+
+		customMailbox, err := imcs.GetContractByNameForChainId(config.ContractName_TaskMailbox, 424242)
+		assert.Nil(t, err)
+		assert.Equal(t, "0xcustommailbox", customMailbox.Address)
+		assert.NotEmpty(t, customMailbox.AbiVersions)
+
+		_, err = imcs.GetContractByNameForChainId(config.ContractName_AllocationManager, 424242)
+		assert.NotNil(t, err)
+
+		baseMailbox, err := imcs.GetContractByNameForChainId(config.ContractName_TaskMailbox, config.ChainId_BaseSepolia)
+		assert.Nil(t, err)
+		assert.Equal(t, "0xbasemailbox", baseMailbox.Address)
+	})
 }
 
 const (
@@ -0,0 +1,173 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/executor/executorConfig"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
+)
+
+var (
+	errAdmissionQueueFull = errors.New("admission queue is full")
+	errAdmissionTimeout   = errors.New("timed out waiting for admission")
+)
+
+// admissionStats is a snapshot of the admission state of an AVS
+type admissionStats struct {
+	AvsAddress         string
+	MaxConcurrentTasks int
+	QueueDepth         int
+	TaskTimeout        time.Duration
+	Running            int
+	Queued             int
+	Admitted           uint64
+	RejectedQueueFull  uint64
+	RejectedTimeout    uint64
+}
+
+// taskAdmission limits how many tasks of one AVS run at once and how many may wait for a slot
+type taskAdmission struct {
+	avsAddress    string
+	maxConcurrent int
+	queueDepth    int
+	taskTimeout   time.Duration
+
+	// slots holds a token per running task; nil if concurrency is unlimited
+	slots chan struct{}
+
+	mu                sync.Mutex
+	running           int
+	queued            int
+	admitted          uint64
+	rejectedQueueFull uint64
+	rejectedTimeout   uint64
+}
+
+func newTaskAdmission(avsAddress string, cfg *executorConfig.AdmissionConfig) *taskAdmission {
+	ta := &taskAdmission{avsAddress: avsAddress}
+	if cfg == nil {
+		return ta
+	}
+
+	ta.maxConcurrent = cfg.MaxConcurrentTasks
+	ta.queueDepth = cfg.QueueDepth
+	ta.taskTimeout = cfg.TaskTimeoutDuration()
+	if ta.maxConcurrent > 0 {
+		ta.slots = make(chan struct{}, ta.maxConcurrent)
+	}
+	return ta
+}
+
+// admit takes a slot for a task, waiting in the queue until one frees up, ctx is done or the task
+// timeout passes. Tasks are rejected right away if all slots are taken and the queue is full. Once
+// admitted, the task should run with the returned context, whose task timeout starts at admission
+// so time spent queued does not shorten it, and the returned release func must be called once the
+// task is done.
+func (ta *taskAdmission) admit(ctx context.Context) (context.Context, func(), error) {
+	if ta.slots == nil {
+		ta.mu.Lock()
+		ta.running++
+		ta.admitted++
+		ta.mu.Unlock()
+		return ta.taskContext(ctx)
+	}
+
+	select {
+	case ta.slots <- struct{}{}:
+		ta.mu.Lock()
+		ta.running++
+		ta.admitted++
+		ta.mu.Unlock()
+		return ta.taskContext(ctx)
+	default:
+	}
+
+	ta.mu.Lock()
+	if ta.queued >= ta.queueDepth {
+		ta.rejectedQueueFull++
+		ta.mu.Unlock()
+		metrics.ExecutorTasksRejected.WithLabelValues(ta.avsAddress, "queue_full").Inc()
+		return nil, nil, errAdmissionQueueFull
+	}
+	ta.queued++
+	ta.mu.Unlock()
+
+	queued := metrics.ExecutorTasksQueued.WithLabelValues(ta.avsAddress)
+	queued.Inc()
+	defer queued.Dec()
+
+	waitCtx := ctx
+	if ta.taskTimeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, ta.taskTimeout)
+		defer cancel()
+	}
+
+	select {
+	case ta.slots <- struct{}{}:
+		ta.mu.Lock()
+		ta.queued--
+		ta.running++
+		ta.admitted++
+		ta.mu.Unlock()
+		return ta.taskContext(ctx)
+	case <-waitCtx.Done():
+		// A cancelled request is the caller giving up, not a rejection
+		timedOut := errors.Is(waitCtx.Err(), context.DeadlineExceeded)
+		ta.mu.Lock()
+		ta.queued--
+		if timedOut {
+			ta.rejectedTimeout++
+		}
+		ta.mu.Unlock()
+
+		if !timedOut {
+			return nil, nil, waitCtx.Err()
+		}
+		metrics.ExecutorTasksRejected.WithLabelValues(ta.avsAddress, "queue_timeout").Inc()
+		return nil, nil, errAdmissionTimeout
+	}
+}
+
+// taskContext returns the context an admitted task runs with and the func releasing its slot
+func (ta *taskAdmission) taskContext(ctx context.Context) (context.Context, func(), error) {
+	if ta.taskTimeout <= 0 {
+		return ctx, ta.release, nil
+	}
+
+	taskCtx, cancel := context.WithTimeout(ctx, ta.taskTimeout)
+	return taskCtx, func() {
+		cancel()
+		ta.release()
+	}, nil
+}
+
+func (ta *taskAdmission) release() {
+	ta.mu.Lock()
+	ta.running--
+	ta.mu.Unlock()
+
+	if ta.slots != nil {
+		<-ta.slots
+	}
+}
+
+func (ta *taskAdmission) stats() admissionStats {
+	ta.mu.Lock()
+	defer ta.mu.Unlock()
+
+	return admissionStats{
+		AvsAddress:         ta.avsAddress,
+		MaxConcurrentTasks: ta.maxConcurrent,
+		QueueDepth:         ta.queueDepth,
+		TaskTimeout:        ta.taskTimeout,
+		Running:            ta.running,
+		Queued:             ta.queued,
+		Admitted:           ta.admitted,
+		RejectedQueueFull:  ta.rejectedQueueFull,
+		RejectedTimeout:    ta.rejectedTimeout,
+	}
+}
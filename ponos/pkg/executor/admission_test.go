@@ -0,0 +1,173 @@
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/executor/executorConfig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskAdmission_Unlimited(t *testing.T) {
+	ta := newTaskAdmission("0xavs", nil)
+
+	releases := make([]func(), 0, 10)
+	for i := 0; i < 10; i++ {
+		_, release, err := ta.admit(context.Background())
+		require.NoError(t, err)
+		releases = append(releases, release)
+	}
+	assert.Equal(t, 10, ta.stats().Running)
+
+	for _, release := range releases {
+		release()
+	}
+	stats := ta.stats()
+	assert.Equal(t, 0, stats.Running)
+	assert.Equal(t, uint64(10), stats.Admitted)
+}
+
+func TestTaskAdmission_RejectsWhenQueueFull(t *testing.T) {
+	ta := newTaskAdmission("0xavs", &executorConfig.AdmissionConfig{MaxConcurrentTasks: 1})
+
+	_, release, err := ta.admit(context.Background())
+	require.NoError(t, err)
+	defer release()
+
+	_, _, err = ta.admit(context.Background())
+	assert.ErrorIs(t, err, errAdmissionQueueFull)
+
+	stats := ta.stats()
+	assert.Equal(t, 1, stats.Running)
+	assert.Equal(t, 0, stats.Queued)
+	assert.Equal(t, uint64(1), stats.RejectedQueueFull)
+}
+
+func TestTaskAdmission_QueuedTaskTimesOut(t *testing.T) {
+	ta := newTaskAdmission("0xavs", &executorConfig.AdmissionConfig{MaxConcurrentTasks: 1, QueueDepth: 1})
+
+	_, release, err := ta.admit(context.Background())
+	require.NoError(t, err)
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, _, err = ta.admit(ctx)
+	assert.ErrorIs(t, err, errAdmissionTimeout)
+
+	stats := ta.stats()
+	assert.Equal(t, 0, stats.Queued)
+	assert.Equal(t, uint64(1), stats.RejectedTimeout)
+}
+
+func TestTaskAdmission_QueuedTaskCancelled(t *testing.T) {
+	ta := newTaskAdmission("0xavs", &executorConfig.AdmissionConfig{MaxConcurrentTasks: 1, QueueDepth: 1})
+
+	_, release, err := ta.admit(context.Background())
+	require.NoError(t, err)
+	defer release()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, err = ta.admit(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, uint64(0), ta.stats().RejectedTimeout)
+}
+
+func TestTaskAdmission_QueuedTaskAdmittedOnRelease(t *testing.T) {
+	ta := newTaskAdmission("0xavs", &executorConfig.AdmissionConfig{MaxConcurrentTasks: 1, QueueDepth: 1})
+
+	_, release, err := ta.admit(context.Background())
+	require.NoError(t, err)
+
+	admitted := make(chan error, 1)
+	go func() {
+		_, queuedRelease, err := ta.admit(context.Background())
+		if err == nil {
+			defer queuedRelease()
+		}
+		admitted <- err
+	}()
+
+	require.Eventually(t, func() bool { return ta.stats().Queued == 1 }, time.Second, 5*time.Millisecond)
+	release()
+
+	select {
+	case err := <-admitted:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("queued task was not admitted after release")
+	}
+
+	stats := ta.stats()
+	assert.Equal(t, uint64(2), stats.Admitted)
+	assert.Equal(t, 0, stats.Queued)
+}
+
+func TestTaskAdmission_LateTaskGetsFullTimeout(t *testing.T) {
+	ta := newTaskAdmission("0xavs", &executorConfig.AdmissionConfig{MaxConcurrentTasks: 1, QueueDepth: 1, TaskTimeout: "200ms"})
+
+	_, release, err := ta.admit(context.Background())
+	require.NoError(t, err)
+
+	type admission struct {
+		ctx        context.Context
+		release    func()
+		err        error
+		admittedAt time.Time
+	}
+	admitted := make(chan admission, 1)
+	go func() {
+		ctx, queuedRelease, err := ta.admit(context.Background())
+		admitted <- admission{ctx: ctx, release: queuedRelease, err: err, admittedAt: time.Now()}
+	}()
+
+	// free the slot late in the queued task's timeout
+	require.Eventually(t, func() bool { return ta.stats().Queued == 1 }, time.Second, 5*time.Millisecond)
+	time.Sleep(150 * time.Millisecond)
+	release()
+
+	var late admission
+	select {
+	case late = <-admitted:
+		require.NoError(t, late.err)
+	case <-time.After(time.Second):
+		t.Fatal("queued task was not admitted after release")
+	}
+	defer late.release()
+
+	deadline, ok := late.ctx.Deadline()
+	require.True(t, ok)
+	assert.WithinDuration(t, late.admittedAt.Add(200*time.Millisecond), deadline, 20*time.Millisecond)
+	assert.NoError(t, late.ctx.Err())
+}
+
+func TestTaskAdmission_ReleaseCancelsTaskContext(t *testing.T) {
+	ta := newTaskAdmission("0xavs", &executorConfig.AdmissionConfig{TaskTimeout: "30s"})
+
+	ctx, release, err := ta.admit(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, ctx.Err())
+
+	release()
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+	assert.Equal(t, 0, ta.stats().Running)
+}
+
+func TestTaskAdmission_Stats(t *testing.T) {
+	ta := newTaskAdmission("0xavs", &executorConfig.AdmissionConfig{
+		MaxConcurrentTasks: 2,
+		QueueDepth:         4,
+		TaskTimeout:        "30s",
+	})
+
+	stats := ta.stats()
+	assert.Equal(t, "0xavs", stats.AvsAddress)
+	assert.Equal(t, 2, stats.MaxConcurrentTasks)
+	assert.Equal(t, 4, stats.QueueDepth)
+	assert.Equal(t, 30*time.Second, stats.TaskTimeout)
+}
@@ -35,6 +35,8 @@ const (
 // PerformerContainer holds all information about a container
 type PerformerContainer struct {
 	performerID     string
+	replica         int
+	performer       *PerformerReplicas
 	info            *containerManager.ContainerInfo
 	client          *avsPerformerClient.PerformerClient
 	eventChan       <-chan containerManager.ContainerEvent
@@ -43,7 +45,37 @@ type PerformerContainer struct {
 	statusCancel    context.CancelFunc
 	statusContext   context.Context
 	image           avsPerformer.PerformerImage
-	status          avsPerformer.PerformerResourceStatus
+	load            avsPerformer.ReplicaLoad
+}
+
+// Load returns the rotation and in-flight state used to balance tasks onto this container
+func (pc *PerformerContainer) Load() *avsPerformer.ReplicaLoad {
+	return &pc.load
+}
+
+// refreshRotation puts the container in rotation only while both Docker and the application report healthy
+func (pc *PerformerContainer) refreshRotation() {
+	pc.load.SetInRotation(pc.client != nil && pc.performerHealth.ContainerIsHealthy && pc.performerHealth.ApplicationIsHealthy)
+}
+
+// PerformerReplicas holds the replica containers of a single performer deployment
+type PerformerReplicas struct {
+	performerID string
+	image       avsPerformer.PerformerImage
+	status      avsPerformer.PerformerResourceStatus
+	statusChan  chan avsPerformer.PerformerStatusEvent
+	containers  []*PerformerContainer
+	balancer    avsPerformer.ReplicaBalancer
+}
+
+// allHealthy reports whether every replica container passes both Docker and application health checks
+func (pr *PerformerReplicas) allHealthy() bool {
+	for _, container := range pr.containers {
+		if !container.performerHealth.ContainerIsHealthy || !container.performerHealth.ApplicationIsHealthy {
+			return false
+		}
+	}
+	return true
 }
 
 type AvsContainerPerformer struct {
@@ -52,8 +84,8 @@ type AvsContainerPerformer struct {
 
 	// Container tracking
 	containerManager      containerManager.ContainerManager
-	currentContainer      atomic.Value
-	nextContainer         *PerformerContainer
+	currentPerformer      atomic.Value // *PerformerReplicas
+	nextPerformer         *PerformerReplicas
 	performerContainersMu sync.Mutex
 
 	// Task tracking
@@ -136,7 +168,8 @@ func (aps *AvsContainerPerformer) generatePerformerID() string {
 	return fmt.Sprintf("performer-%s-%s", aps.config.AvsAddress, uuid.New().String())
 }
 
-func (aps *AvsContainerPerformer) buildDockerEnvsFromConfig(image avsPerformer.PerformerImage) []string {
+// buildDockerEnvs resolves the environment variables of a performer image into Docker "NAME=value" form
+func buildDockerEnvs(image avsPerformer.PerformerImage) []string {
 	dockerEnvs := make([]string, 0)
 	for _, env := range image.Envs {
 		val := env.Value
@@ -148,11 +181,12 @@ func (aps *AvsContainerPerformer) buildDockerEnvsFromConfig(image avsPerformer.P
 	return dockerEnvs
 }
 
-// createAndStartContainer creates, starts, and prepares a container for the AVS performer
+// createAndStartContainer creates, starts, and prepares a replica container for the AVS performer
 func (aps *AvsContainerPerformer) createAndStartContainer(
 	ctx context.Context,
 	avsAddress string,
-	image avsPerformer.PerformerImage,
+	performer *PerformerReplicas,
+	replica int,
 	containerConfig *containerManager.ContainerConfig,
 	livenessConfig *containerManager.LivenessConfig,
 ) (*PerformerContainer, error) {
@@ -219,22 +253,24 @@ func (aps *AvsContainerPerformer) createAndStartContainer(
 		return nil, errors.Wrap(err, "failed to start liveness monitoring")
 	}
 
-	performerID := aps.generatePerformerID()
 	aps.logger.Info("Container created and monitoring started",
 		zap.String("avsAddress", avsAddress),
-		zap.String("performerID", performerID),
+		zap.String("performerID", performer.performerID),
+		zap.Int("replica", replica),
 		zap.String("containerID", updatedInfo.ID),
 		zap.String("endpoint", endpoint),
 	)
 
 	// Create the container instance with all components
 	return &PerformerContainer{
-		performerID:   performerID,
+		performerID:   performer.performerID,
+		replica:       replica,
+		performer:     performer,
 		info:          updatedInfo,
 		client:        perfClient,
 		eventChan:     eventChan,
-		image:         image,
-		statusChan:    make(chan avsPerformer.PerformerStatusEvent, 10),
+		image:         performer.image,
+		statusChan:    performer.statusChan,
 		statusCancel:  statusCancel,
 		statusContext: statusCtx,
 		performerHealth: &avsPerformer.PerformerHealth{
@@ -244,6 +280,59 @@ func (aps *AvsContainerPerformer) createAndStartContainer(
 	}, nil
 }
 
+// createReplicas creates and starts the configured number of replica containers for a new performer.
+// If any replica fails to start, the replicas already started are removed again.
+func (aps *AvsContainerPerformer) createReplicas(ctx context.Context, image avsPerformer.PerformerImage) (*PerformerReplicas, error) {
+	performer := &PerformerReplicas{
+		performerID: aps.generatePerformerID(),
+		image:       image,
+		status:      avsPerformer.PerformerResourceStatusStaged,
+		statusChan:  make(chan avsPerformer.PerformerStatusEvent, 10),
+	}
+
+	for replica := 0; replica < aps.config.ReplicaCount(); replica++ {
+		container, err := aps.createAndStartContainer(
+			ctx,
+			aps.config.AvsAddress,
+			performer,
+			replica,
+			containerManager.CreateDefaultContainerConfig(
+				aps.config.AvsAddress,
+				image.Repository,
+				image.Tag,
+				image.Digest,
+				internalContainerPort,
+				aps.config.PerformerNetworkName,
+				buildDockerEnvs(image),
+			),
+			containerManager.NewDefaultAvsPerformerLivenessConfig(),
+		)
+		if err != nil {
+			for _, started := range performer.containers {
+				started.statusCancel()
+				if shutdownErr := aps.shutdownContainer(context.Background(), aps.config.AvsAddress, started); shutdownErr != nil {
+					aps.logger.Error("Failed to clean up replica after creation failure",
+						zap.String("performerID", performer.performerID),
+						zap.Int("replica", started.replica),
+						zap.Error(shutdownErr),
+					)
+				}
+			}
+			return nil, errors.Wrapf(err, "failed to create replica %d", replica)
+		}
+		performer.containers = append(performer.containers, container)
+	}
+
+	return performer, nil
+}
+
+// startMonitoring starts event and application health monitoring for every replica of the performer
+func (aps *AvsContainerPerformer) startMonitoring(performer *PerformerReplicas) {
+	for _, container := range performer.containers {
+		go aps.monitorContainerEvents(container.statusContext, container)
+	}
+}
+
 func (aps *AvsContainerPerformer) Initialize(ctx context.Context) error {
 	aps.performerContainersMu.Lock()
 	defer aps.performerContainersMu.Unlock()
@@ -257,31 +346,17 @@ func (aps *AvsContainerPerformer) Initialize(ctx context.Context) error {
 		return nil
 	}
 
-	// Create and start container
-	performerContainer, err := aps.createAndStartContainer(
-		ctx,
-		aps.config.AvsAddress,
-		aps.config.Image,
-		containerManager.CreateDefaultContainerConfig(
-			aps.config.AvsAddress,
-			aps.config.Image.Repository,
-			aps.config.Image.Tag,
-			aps.config.Image.Digest,
-			internalContainerPort,
-			aps.config.PerformerNetworkName,
-			aps.buildDockerEnvsFromConfig(aps.config.Image),
-		),
-		containerManager.NewDefaultAvsPerformerLivenessConfig(),
-	)
+	// Create and start the replica containers
+	performer, err := aps.createReplicas(ctx, aps.config.Image)
 	if err != nil {
 		return err
 	}
 
-	performerContainer.status = avsPerformer.PerformerResourceStatusInService
-	aps.currentContainer.Store(performerContainer)
+	performer.status = avsPerformer.PerformerResourceStatusInService
+	aps.currentPerformer.Store(performer)
 
-	// Start monitoring events for the new container
-	go aps.monitorContainerEvents(performerContainer.statusContext, performerContainer)
+	// Start monitoring events for the new containers
+	aps.startMonitoring(performer)
 
 	return nil
 }
@@ -306,8 +381,8 @@ func (aps *AvsContainerPerformer) monitorContainerEvents(ctx context.Context, co
 			}
 			aps.handleContainerEvent(ctx, event, container)
 		case <-appHealthCheckTicker.C:
-			// Perform periodic application health checks for containers that are Docker-healthy
-			aps.performPeriodicApplicationHealthChecks(ctx)
+			// Perform periodic application health checks while the container is Docker-healthy
+			aps.performPeriodicApplicationHealthCheck(ctx, container)
 		}
 	}
 }
@@ -325,6 +400,7 @@ func (aps *AvsContainerPerformer) handleContainerEvent(ctx context.Context, even
 
 	aps.performerContainersMu.Lock()
 	defer aps.performerContainersMu.Unlock()
+	defer targetContainer.refreshRotation()
 
 	switch event.Type {
 	case containerManager.EventStarted:
@@ -453,11 +529,12 @@ func (aps *AvsContainerPerformer) recreateContainer(ctx context.Context, targetC
 		zap.String("digest", targetContainer.image.Digest),
 	)
 
-	// Create and start new container
+	// Create and start new container; ctx belongs to the old container's monitor, which was just cancelled
 	newContainer, err := aps.createAndStartContainer(
-		ctx,
+		context.WithoutCancel(ctx),
 		aps.config.AvsAddress,
-		targetContainer.image,
+		targetContainer.performer,
+		targetContainer.replica,
 		containerManager.CreateDefaultContainerConfig(
 			aps.config.AvsAddress,
 			targetContainer.image.Repository,
@@ -465,7 +542,7 @@ func (aps *AvsContainerPerformer) recreateContainer(ctx context.Context, targetC
 			targetContainer.image.Digest,
 			internalContainerPort,
 			aps.config.PerformerNetworkName,
-			aps.buildDockerEnvsFromConfig(targetContainer.image),
+			buildDockerEnvs(targetContainer.image),
 		), containerManager.NewDefaultAvsPerformerLivenessConfig())
 	if err != nil {
 		aps.logger.Error("Failed to recreate container",
@@ -482,10 +559,12 @@ func (aps *AvsContainerPerformer) recreateContainer(ctx context.Context, targetC
 	targetContainer.client = newContainer.client
 	targetContainer.eventChan = newContainer.eventChan
 	targetContainer.performerHealth = newContainer.performerHealth
-	targetContainer.statusChan = newContainer.statusChan
 	targetContainer.statusCancel = newContainer.statusCancel
 	targetContainer.statusContext = newContainer.statusContext
 
+	// The monitor of the old container stops with its status context, so watch the new one
+	go aps.monitorContainerEvents(targetContainer.statusContext, targetContainer)
+
 	aps.logger.Info("Container recreation completed successfully",
 		zap.String("avsAddress", aps.config.AvsAddress),
 		zap.String("performerID", targetContainer.performerID),
@@ -572,7 +651,7 @@ func (aps *AvsContainerPerformer) checkApplicationHealth(ctx context.Context, co
 }
 
 // CreatePerformer creates a new performer and returns the creation result
-// Always updates the nextContainer slot
+// Always updates the nextPerformer slot
 func (aps *AvsContainerPerformer) CreatePerformer(
 	ctx context.Context,
 	image avsPerformer.PerformerImage,
@@ -584,95 +663,84 @@ func (aps *AvsContainerPerformer) CreatePerformer(
 		zap.String("avsAddress", aps.config.AvsAddress),
 		zap.String("imageRepository", image.Repository),
 		zap.String("imageTag", image.Tag),
+		zap.Int("replicas", aps.config.ReplicaCount()),
 	)
 
-	// Check if next container slot is already occupied
-	if aps.nextContainer != nil {
-		aps.logger.Error("Cannot create new performer, next container slot is already occupied",
+	// Check if next performer slot is already occupied
+	if aps.nextPerformer != nil {
+		aps.logger.Error("Cannot create new performer, next performer slot is already occupied",
 			zap.String("avsAddress", aps.config.AvsAddress),
-			zap.String("existingNextPerformerID", aps.nextContainer.performerID),
+			zap.String("existingNextPerformerID", aps.nextPerformer.performerID),
 			zap.String("requestedImage", fmt.Sprintf("%s:%s", image.Repository, image.Tag)),
 		)
-		return nil, fmt.Errorf("a next performer already exists (ID: %s). Please remove it explicitly before creating a new one", aps.nextContainer.performerID)
+		return nil, fmt.Errorf("a next performer already exists (ID: %s). Please remove it explicitly before creating a new one", aps.nextPerformer.performerID)
 	}
 
-	// Create the new container instance
-	newContainer, err := aps.createAndStartContainer(
-		ctx,
-		aps.config.AvsAddress,
-		image,
-		containerManager.CreateDefaultContainerConfig(
-			aps.config.AvsAddress,
-			image.Repository,
-			image.Tag,
-			image.Digest,
-			internalContainerPort,
-			aps.config.PerformerNetworkName,
-			aps.buildDockerEnvsFromConfig(image),
-		), containerManager.NewDefaultAvsPerformerLivenessConfig())
+	// Create the replica containers of the new performer
+	newPerformer, err := aps.createReplicas(ctx, image)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create container")
 	}
 
-	// Always deploy as next container
-	aps.nextContainer = newContainer
-	aps.nextContainer.status = avsPerformer.PerformerResourceStatusStaged
+	// Always deploy as next performer
+	aps.nextPerformer = newPerformer
 
 	aps.logger.Info("Deployed as next performer",
 		zap.String("avsAddress", aps.config.AvsAddress),
-		zap.String("performerID", newContainer.performerID),
-		zap.String("containerID", newContainer.info.ID),
+		zap.String("performerID", newPerformer.performerID),
+		zap.Int("replicas", len(newPerformer.containers)),
 	)
 
-	// Start monitoring events for the new container
-	go aps.monitorContainerEvents(newContainer.statusContext, newContainer)
+	// Start monitoring events for the new containers
+	aps.startMonitoring(newPerformer)
 
-	// Get endpoint for logging
-	endpoint, _ := containerManager.GetContainerEndpoint(newContainer.info, internalContainerPort, aps.config.PerformerNetworkName)
+	// The first replica stands in for the performer in the creation result
+	firstContainer := newPerformer.containers[0]
+	endpoint, _ := containerManager.GetContainerEndpoint(firstContainer.info, internalContainerPort, aps.config.PerformerNetworkName)
 
 	aps.logger.Info("Performer deployment started",
 		zap.String("avsAddress", aps.config.AvsAddress),
-		zap.String("performerID", newContainer.performerID),
-		zap.String("containerID", newContainer.info.ID),
+		zap.String("performerID", newPerformer.performerID),
+		zap.String("containerID", firstContainer.info.ID),
 		zap.String("endpoint", endpoint),
 	)
 
 	return &avsPerformer.PerformerCreationResult{
-		PerformerId: newContainer.performerID,
-		ResourceId:  newContainer.info.ID,
-		StatusChan:  newContainer.statusChan,
+		PerformerId: newPerformer.performerID,
+		ResourceId:  firstContainer.info.ID,
+		StatusChan:  newPerformer.statusChan,
 		Endpoint:    endpoint,
-		Hostname:    newContainer.info.Hostname,
+		Hostname:    firstContainer.info.Hostname,
 	}, nil
 }
 
-// RemovePerformer removes a performer from the server by its performerID.
+// RemovePerformer removes a performer and all of its replica containers from the server by its performerID.
 func (aps *AvsContainerPerformer) RemovePerformer(ctx context.Context, performerID string) error {
 	aps.performerContainersMu.Lock()
 	defer aps.performerContainersMu.Unlock()
 
-	// Determine which container to remove
-	var targetContainer *PerformerContainer
+	// Determine which performer to remove
+	var targetPerformer *PerformerReplicas
 
-	current := aps.currentContainer.Load()
+	current := aps.currentPerformer.Load()
 	if current != nil {
-		currentContainer, ok := current.(*PerformerContainer)
-		if !ok || currentContainer == nil {
-			aps.logger.Error("Invalid type in currentContainer atomic.Value during removal")
-			return fmt.Errorf("invalid performer type stored in currentContainer")
+		currentPerformer, ok := current.(*PerformerReplicas)
+		if !ok {
+			aps.logger.Error("Invalid type in currentPerformer atomic.Value during removal")
+			return fmt.Errorf("invalid performer type stored in currentPerformer")
 		}
-		if currentContainer.performerID == performerID {
-			targetContainer = currentContainer
-			aps.currentContainer.Store((*PerformerContainer)(nil))
+		if currentPerformer != nil && currentPerformer.performerID == performerID {
+			targetPerformer = currentPerformer
+			aps.currentPerformer.Store((*PerformerReplicas)(nil))
 		}
 	}
 
-	if targetContainer == nil && aps.nextContainer != nil && aps.nextContainer.performerID == performerID {
-		targetContainer = aps.nextContainer
-		aps.nextContainer = nil
+	if targetPerformer == nil && aps.nextPerformer != nil && aps.nextPerformer.performerID == performerID {
+		targetPerformer = aps.nextPerformer
+		aps.nextPerformer = nil
 	}
 
-	if targetContainer == nil {
+	if targetPerformer == nil {
 		// Performer not found
 		aps.logger.Error("Performer not found",
 			zap.String("avsAddress", aps.config.AvsAddress),
@@ -681,20 +749,27 @@ func (aps *AvsContainerPerformer) RemovePerformer(ctx context.Context, performer
 		return fmt.Errorf("performer with DeploymentID %s not found", performerID)
 	}
 
-	// Cancel the status context to signal health monitoring goroutines to stop sending
-	if targetContainer.statusCancel != nil {
-		targetContainer.statusCancel()
-	}
+	var errs []error
+	for _, container := range targetPerformer.containers {
+		// Cancel the status context to signal health monitoring goroutines to stop sending
+		if container.statusCancel != nil {
+			container.statusCancel()
+		}
 
-	// Shutdown the container (this will stop monitoring and remove it)
-	if err := aps.shutdownContainer(ctx, aps.config.AvsAddress, targetContainer); err != nil {
-		aps.logger.Error("Failed to shutdown container",
-			zap.String("avsAddress", aps.config.AvsAddress),
-			zap.String("performerID", performerID),
-			zap.String("containerID", targetContainer.info.ID),
-			zap.Error(err),
-		)
-		return fmt.Errorf("failed to remove performer: %w", err)
+		// Shutdown the container (this will stop monitoring and remove it)
+		if err := aps.shutdownContainer(ctx, aps.config.AvsAddress, container); err != nil {
+			aps.logger.Error("Failed to shutdown container",
+				zap.String("avsAddress", aps.config.AvsAddress),
+				zap.String("performerID", performerID),
+				zap.Int("replica", container.replica),
+				zap.String("containerID", container.info.ID),
+				zap.Error(err),
+			)
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to remove performer: %w", stderrors.Join(errs...))
 	}
 
 	aps.logger.Info("Performer removed successfully",
@@ -705,19 +780,19 @@ func (aps *AvsContainerPerformer) RemovePerformer(ctx context.Context, performer
 	return nil
 }
 
-// PromotePerformer promotes the specified performer to currentContainer
+// PromotePerformer promotes the specified performer to currentPerformer
 // If the performer is already current, it's a no-op success
-// If the performer is next and healthy, it's promoted to current
+// If the performer is next and all of its replicas are healthy, it's promoted to current
 // If the performer is not found or unhealthy, an error is returned
 func (aps *AvsContainerPerformer) PromotePerformer(ctx context.Context, performerID string) error {
 	aps.performerContainersMu.Lock()
 	defer aps.performerContainersMu.Unlock()
 
-	// Check if the performer is already the current container
-	if current := aps.currentContainer.Load(); current != nil {
-		currentContainer := current.(*PerformerContainer)
-		if currentContainer != nil && currentContainer.performerID == performerID {
-			aps.logger.Info("Performer is already the current container",
+	// Check if the performer is already the current performer
+	if current := aps.currentPerformer.Load(); current != nil {
+		currentPerformer := current.(*PerformerReplicas)
+		if currentPerformer != nil && currentPerformer.performerID == performerID {
+			aps.logger.Info("Performer is already the current performer",
 				zap.String("avsAddress", aps.config.AvsAddress),
 				zap.String("performerID", performerID),
 			)
@@ -725,43 +800,45 @@ func (aps *AvsContainerPerformer) PromotePerformer(ctx context.Context, performe
 		}
 	}
 
-	// Check if the performer is the next container
-	if aps.nextContainer == nil || aps.nextContainer.performerID != performerID {
+	// Check if the performer is the next performer
+	if aps.nextPerformer == nil || aps.nextPerformer.performerID != performerID {
 		return fmt.Errorf("performer %s is not in the next deployment slot", performerID)
 	}
 
-	// Verify nextContainer is healthy
-	if !aps.nextContainer.performerHealth.ContainerIsHealthy || !aps.nextContainer.performerHealth.ApplicationIsHealthy {
-		return fmt.Errorf("cannot promote unhealthy performer %s (container health: %v, application health: %v)",
-			performerID,
-			aps.nextContainer.performerHealth.ContainerIsHealthy,
-			aps.nextContainer.performerHealth.ApplicationIsHealthy)
+	// Verify every replica of nextPerformer is healthy
+	for _, container := range aps.nextPerformer.containers {
+		if !container.performerHealth.ContainerIsHealthy || !container.performerHealth.ApplicationIsHealthy {
+			return fmt.Errorf("cannot promote unhealthy performer %s (replica: %d, container health: %v, application health: %v)",
+				performerID,
+				container.replica,
+				container.performerHealth.ContainerIsHealthy,
+				container.performerHealth.ApplicationIsHealthy)
+		}
 	}
 
 	aps.logger.Info("Promoting performer to current",
 		zap.String("avsAddress", aps.config.AvsAddress),
 		zap.String("performerID", performerID),
-		zap.String("containerID", aps.nextContainer.info.ID),
+		zap.Int("replicas", len(aps.nextPerformer.containers)),
 	)
 
-	// Initiate draining of the old container
-	if current := aps.currentContainer.Load(); current != nil {
-		oldContainer := current.(*PerformerContainer)
-		if oldContainer != nil && oldContainer.info != nil {
+	// Initiate draining of the old performer
+	if current := aps.currentPerformer.Load(); current != nil {
+		oldPerformer := current.(*PerformerReplicas)
+		if oldPerformer != nil {
 			aps.logger.Info("Initiating drain of old performer",
-				zap.String("oldPerformerID", oldContainer.performerID),
-				zap.String("oldContainerID", oldContainer.info.ID),
+				zap.String("oldPerformerID", oldPerformer.performerID),
 			)
 
 			// Start draining in a separate goroutine
-			aps.startDrainAndRemove(oldContainer)
+			aps.startDrainAndRemove(oldPerformer)
 		}
 	}
 
 	// Promote next to current and update status
-	aps.nextContainer.status = avsPerformer.PerformerResourceStatusInService
-	aps.currentContainer.Store(aps.nextContainer)
-	aps.nextContainer = nil
+	aps.nextPerformer.status = avsPerformer.PerformerResourceStatusInService
+	aps.currentPerformer.Store(aps.nextPerformer)
+	aps.nextPerformer = nil
 
 	aps.logger.Info("Performer promotion completed successfully",
 		zap.String("avsAddress", aps.config.AvsAddress),
@@ -771,39 +848,52 @@ func (aps *AvsContainerPerformer) PromotePerformer(ctx context.Context, performe
 	return nil
 }
 
+// RunTask executes the task on the replica of the current performer with the fewest tasks in flight
 func (aps *AvsContainerPerformer) RunTask(ctx context.Context, task *performerTask.PerformerTask) (*performerTask.PerformerTaskResult, error) {
 	aps.logger.Sugar().Infow("Processing task", zap.Any("task", task))
 
-	// Load current container using atomic accessor
-	current := aps.currentContainer.Load()
+	// Load current performer using atomic accessor
+	current := aps.currentPerformer.Load()
 	if current == nil {
 		return nil, fmt.Errorf("no current container available to execute task")
 	}
 
-	currentContainer, ok := current.(*PerformerContainer)
-	if !ok || currentContainer == nil || currentContainer.client == nil {
+	currentPerformer, ok := current.(*PerformerReplicas)
+	if !ok || currentPerformer == nil {
+		return nil, fmt.Errorf("no current container available to execute task")
+	}
+
+	container, ok := avsPerformer.PickReplica(&currentPerformer.balancer, currentPerformer.containers)
+	if !ok || container.client == nil {
 		return nil, fmt.Errorf("no current container available to execute task")
 	}
 
 	// Track this task with the performer's WaitGroup
-	wg := aps.getOrCreateTaskWaitGroup(currentContainer.performerID)
+	wg := aps.getOrCreateTaskWaitGroup(currentPerformer.performerID)
 	wg.Add(1)
 	defer wg.Done()
 
+	container.load.TaskStarted()
+	defer container.load.TaskDone()
+
 	// Execute the task
-	res, err := currentContainer.client.PerformerClient.ExecuteTask(ctx, &performerV1.TaskRequest{
+	res, err := container.client.PerformerClient.ExecuteTask(ctx, &performerV1.TaskRequest{
 		TaskId:  []byte(task.TaskID),
 		Payload: task.Payload,
 	})
 	if err != nil {
 		aps.logger.Sugar().Errorw("Performer failed to handle task",
 			zap.String("avsAddress", aps.config.AvsAddress),
-			zap.String("performerID", currentContainer.performerID),
+			zap.String("performerID", currentPerformer.performerID),
+			zap.Int("replica", container.replica),
 			zap.Error(err),
 		)
 		return nil, err
 	}
-	aps.logger.Sugar().Infow("Performer handled task")
+	aps.logger.Sugar().Infow("Performer handled task",
+		zap.String("performerID", currentPerformer.performerID),
+		zap.Int("replica", container.replica),
+	)
 
 	return performerTask.NewTaskResultFromResultProto(res), nil
 }
@@ -871,47 +961,49 @@ func (aps *AvsContainerPerformer) Shutdown() error {
 	}
 	aps.drainingPerformersMu.Unlock()
 
-	// Shutdown both current and next containers
+	// Shutdown both current and next performers
 	var errs []error
 
-	if current := aps.currentContainer.Load(); current != nil {
-		currentContainer, ok := current.(*PerformerContainer)
+	if current := aps.currentPerformer.Load(); current != nil {
+		currentPerformer, ok := current.(*PerformerReplicas)
 		if !ok {
-			aps.logger.Error("Invalid type in currentContainer atomic.Value during shutdown")
-			errs = append(errs, fmt.Errorf("invalid performer type stored in currentContainer"))
+			aps.logger.Error("Invalid type in currentPerformer atomic.Value during shutdown")
+			errs = append(errs, fmt.Errorf("invalid performer type stored in currentPerformer"))
 		}
-		if currentContainer != nil && currentContainer.info != nil {
-			aps.logger.Info("Shutting down current container",
+		if currentPerformer != nil {
+			aps.logger.Info("Shutting down current performer",
 				zap.String("avsAddress", aps.config.AvsAddress),
-				zap.String("performerID", currentContainer.performerID),
-				zap.String("containerID", currentContainer.info.ID),
+				zap.String("performerID", currentPerformer.performerID),
+				zap.Int("replicas", len(currentPerformer.containers)),
 			)
-			// Wait for running tasks to complete
-			aps.logger.Info("Waiting for running tasks to complete",
-				zap.String("performerID", currentContainer.performerID),
+			aps.logger.Info("Waiting for tasks to complete",
+				zap.String("performerID", currentPerformer.performerID),
 			)
-			aps.waitForTaskCompletion(currentContainer.performerID)
-			// Then shutdown container
-			if err := aps.shutdownContainer(context.Background(), aps.config.AvsAddress, currentContainer); err != nil {
-				errs = append(errs, fmt.Errorf("failed to shutdown current container: %w", err))
+			aps.waitForTaskCompletion(currentPerformer.performerID)
+			for _, container := range currentPerformer.containers {
+				if err := aps.shutdownContainer(context.Background(), aps.config.AvsAddress, container); err != nil {
+					errs = append(errs, fmt.Errorf("failed to shutdown current container: %w", err))
+				}
 			}
-			aps.cleanupTaskWaitGroup(currentContainer.performerID)
-			aps.currentContainer.Store((*PerformerContainer)(nil))
+			aps.cleanupTaskWaitGroup(currentPerformer.performerID)
+			aps.currentPerformer.Store((*PerformerReplicas)(nil))
 		}
 	}
 
-	if aps.nextContainer != nil {
-		aps.logger.Info("Shutting down next container",
+	if aps.nextPerformer != nil {
+		aps.logger.Info("Shutting down next performer",
 			zap.String("avsAddress", aps.config.AvsAddress),
-			zap.String("performerID", aps.nextContainer.performerID),
-			zap.String("containerID", aps.nextContainer.info.ID),
+			zap.String("performerID", aps.nextPerformer.performerID),
+			zap.Int("replicas", len(aps.nextPerformer.containers)),
 		)
-		aps.waitForTaskCompletion(aps.nextContainer.performerID)
-		if err := aps.shutdownContainer(context.Background(), aps.config.AvsAddress, aps.nextContainer); err != nil {
-			errs = append(errs, fmt.Errorf("failed to shutdown next container: %w", err))
+		aps.waitForTaskCompletion(aps.nextPerformer.performerID)
+		for _, container := range aps.nextPerformer.containers {
+			if err := aps.shutdownContainer(context.Background(), aps.config.AvsAddress, container); err != nil {
+				errs = append(errs, fmt.Errorf("failed to shutdown next container: %w", err))
+			}
 		}
-		aps.cleanupTaskWaitGroup(aps.nextContainer.performerID)
-		aps.nextContainer = nil
+		aps.cleanupTaskWaitGroup(aps.nextPerformer.performerID)
+		aps.nextPerformer = nil
 	}
 
 	if len(errs) > 0 {
@@ -925,26 +1017,28 @@ func (aps *AvsContainerPerformer) Shutdown() error {
 	return nil
 }
 
-// ListPerformers returns information about the current and next containers for this AVS performer
+// ListPerformers returns information about the current and next performers for this AVS performer
 func (aps *AvsContainerPerformer) ListPerformers() []avsPerformer.PerformerMetadata {
 	aps.performerContainersMu.Lock()
 	defer aps.performerContainersMu.Unlock()
 
 	var performers []avsPerformer.PerformerMetadata
 
-	// Add current container info if exists
-	if current := aps.currentContainer.Load(); current != nil {
-		currentContainer, ok := current.(*PerformerContainer)
-		if !ok || currentContainer == nil || currentContainer.info == nil {
-			aps.logger.Error("Invalid type in currentContainer atomic.Value during listing")
+	// Add current performer info if exists
+	if current := aps.currentPerformer.Load(); current != nil {
+		currentPerformer, ok := current.(*PerformerReplicas)
+		if !ok {
+			aps.logger.Error("Invalid type in currentPerformer atomic.Value during listing")
 			return performers
 		}
-		performers = append(performers, convertPerformerContainer(aps.config.AvsAddress, currentContainer))
+		if currentPerformer != nil && len(currentPerformer.containers) > 0 {
+			performers = append(performers, convertPerformerReplicas(aps.config.AvsAddress, currentPerformer))
+		}
 	}
 
-	// Add next container info if exists
-	if aps.nextContainer != nil {
-		performers = append(performers, convertPerformerContainer(aps.config.AvsAddress, aps.nextContainer))
+	// Add next performer info if exists
+	if aps.nextPerformer != nil {
+		performers = append(performers, convertPerformerReplicas(aps.config.AvsAddress, aps.nextPerformer))
 	}
 
 	return performers
@@ -981,9 +1075,9 @@ func (aps *AvsContainerPerformer) cleanupTaskWaitGroup(performerID string) {
 	delete(aps.taskWaitGroups, performerID)
 }
 
-// startDrainAndRemove initiates draining of a performer container in a separate goroutine
-func (aps *AvsContainerPerformer) startDrainAndRemove(container *PerformerContainer) {
-	performerID := container.performerID
+// startDrainAndRemove initiates draining of a performer's replica containers in a separate goroutine
+func (aps *AvsContainerPerformer) startDrainAndRemove(performer *PerformerReplicas) {
+	performerID := performer.performerID
 
 	// Check if already draining
 	aps.drainingPerformersMu.Lock()
@@ -1000,37 +1094,43 @@ func (aps *AvsContainerPerformer) startDrainAndRemove(container *PerformerContai
 	go func() {
 		aps.logger.Info("Starting performer drain",
 			zap.String("performerID", performerID),
-			zap.String("containerID", container.info.ID),
+			zap.Int("replicas", len(performer.containers)),
 		)
 
 		// Wait for all tasks to complete
 		aps.waitForTaskCompletion(performerID)
 
-		aps.logger.Info("Performer drained, removing container",
-			zap.String("performerID", performerID),
-			zap.String("containerID", container.info.ID),
-		)
-
-		// Stop liveness monitoring
-		aps.containerManager.StopLivenessMonitoring(container.info.ID)
-
-		// Stop and remove container
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		if err := aps.containerManager.Stop(ctx, container.info.ID, 10*time.Second); err != nil {
-			aps.logger.Warn("Failed to stop drained container",
+		for _, container := range performer.containers {
+			aps.logger.Info("Performer drained, removing container",
 				zap.String("performerID", performerID),
+				zap.Int("replica", container.replica),
 				zap.String("containerID", container.info.ID),
-				zap.Error(err),
-			)
-		}
-		if err := aps.containerManager.Remove(ctx, container.info.ID, true); err != nil {
-			aps.logger.Warn("Failed to remove drained container",
-				zap.String("performerID", performerID),
-				zap.String("containerID", container.info.ID),
-				zap.Error(err),
 			)
+
+			// Stop status and liveness monitoring
+			if container.statusCancel != nil {
+				container.statusCancel()
+			}
+			aps.containerManager.StopLivenessMonitoring(container.info.ID)
+
+			// Stop and remove container
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			if err := aps.containerManager.Stop(ctx, container.info.ID, 10*time.Second); err != nil {
+				aps.logger.Warn("Failed to stop drained container",
+					zap.String("performerID", performerID),
+					zap.String("containerID", container.info.ID),
+					zap.Error(err),
+				)
+			}
+			if err := aps.containerManager.Remove(ctx, container.info.ID, true); err != nil {
+				aps.logger.Warn("Failed to remove drained container",
+					zap.String("performerID", performerID),
+					zap.String("containerID", container.info.ID),
+					zap.Error(err),
+				)
+			}
+			cancel()
 		}
-		cancel()
 
 		// Remove from draining set and clean up WaitGroup
 		aps.drainingPerformersMu.Lock()
@@ -1045,101 +1145,92 @@ func (aps *AvsContainerPerformer) startDrainAndRemove(container *PerformerContai
 	}()
 }
 
-// performPeriodicApplicationHealthChecks checks application health for containers that are Docker-healthy
-func (aps *AvsContainerPerformer) performPeriodicApplicationHealthChecks(ctx context.Context) {
+// performPeriodicApplicationHealthCheck checks application health of a replica container while it is Docker-healthy.
+// The performer is reported healthy once every one of its replicas passes.
+func (aps *AvsContainerPerformer) performPeriodicApplicationHealthCheck(ctx context.Context, container *PerformerContainer) {
 	aps.performerContainersMu.Lock()
 	defer aps.performerContainersMu.Unlock()
+	defer container.refreshRotation()
 
-	// Create a slice of containers to check
-	var containersToCheck []*PerformerContainer
-
-	if current := aps.currentContainer.Load(); current != nil {
-		currentContainer := current.(*PerformerContainer)
-		if currentContainer.performerHealth.ContainerIsHealthy && currentContainer.client != nil {
-			containersToCheck = append(containersToCheck, currentContainer)
-		}
+	if !container.performerHealth.ContainerIsHealthy || container.client == nil {
+		return
 	}
 
-	if aps.nextContainer != nil && aps.nextContainer.performerHealth.ContainerIsHealthy && aps.nextContainer.client != nil {
-		containersToCheck = append(containersToCheck, aps.nextContainer)
-	}
+	containerID := container.info.ID
 
-	// Process all containers with the same logic
-	for _, container := range containersToCheck {
-		containerID := container.info.ID
+	// Perform the application health check
+	err := aps.checkApplicationHealth(ctx, container)
+	container.performerHealth.LastHealthCheck = time.Now()
 
-		// Perform the application health check
-		err := aps.checkApplicationHealth(ctx, container)
-		container.performerHealth.LastHealthCheck = time.Now()
+	if err != nil {
+		// Health check failed
+		container.performerHealth.ApplicationIsHealthy = false
+		container.performerHealth.ConsecutiveApplicationHealthFailures++
 
-		if err != nil {
-			// Health check failed
-			container.performerHealth.ApplicationIsHealthy = false
-			container.performerHealth.ConsecutiveApplicationHealthFailures++
+		aps.logger.Warn("Application health check failed",
+			zap.String("avsAddress", aps.config.AvsAddress),
+			zap.String("containerID", containerID),
+			zap.Int("replica", container.replica),
+			zap.Error(err),
+			zap.Int("consecutiveFailures", container.performerHealth.ConsecutiveApplicationHealthFailures),
+		)
+
+		// Handle consecutive failures
+		if container.performerHealth.ConsecutiveApplicationHealthFailures >= maxConsecutiveApplicationHealthFailures {
+			consecutiveFailures := container.performerHealth.ConsecutiveApplicationHealthFailures
+			container.performerHealth.ConsecutiveApplicationHealthFailures = 0
 
-			aps.logger.Warn("Application health check failed",
+			aps.logger.Error("Container application health failed multiple times, triggering restart",
 				zap.String("avsAddress", aps.config.AvsAddress),
 				zap.String("containerID", containerID),
-				zap.Error(err),
-				zap.Int("consecutiveFailures", container.performerHealth.ConsecutiveApplicationHealthFailures),
+				zap.Int("consecutiveFailures", consecutiveFailures),
 			)
 
-			// Handle consecutive failures
-			if container.performerHealth.ConsecutiveApplicationHealthFailures >= maxConsecutiveApplicationHealthFailures {
-				consecutiveFailures := container.performerHealth.ConsecutiveApplicationHealthFailures
-				container.performerHealth.ConsecutiveApplicationHealthFailures = 0
-
-				aps.logger.Error("Container application health failed multiple times, triggering restart",
-					zap.String("avsAddress", aps.config.AvsAddress),
-					zap.String("containerID", containerID),
-					zap.Int("consecutiveFailures", consecutiveFailures),
-				)
-
-				// Send unhealthy status event
-				if container.statusChan != nil {
-					select {
-					case container.statusChan <- avsPerformer.PerformerStatusEvent{
-						Status:      avsPerformer.PerformerUnhealthy,
-						PerformerID: container.performerID,
-						Message:     fmt.Sprintf("Container unhealthy after %d consecutive health check failures", consecutiveFailures),
-						Timestamp:   time.Now(),
-					}:
-						aps.logger.Info("Sent unhealthy status event",
-							zap.String("avsAddress", aps.config.AvsAddress),
-							zap.String("performerID", container.performerID),
-						)
-					default:
-					}
-				}
-
-				if restartErr := aps.TriggerContainerRestart(container, fmt.Sprintf("application health check failed %d consecutive times", consecutiveFailures)); restartErr != nil {
-					aps.logger.Error("Failed to trigger container restart",
-						zap.String("avsAddress", aps.config.AvsAddress),
-						zap.String("containerID", containerID),
-						zap.Error(restartErr),
-					)
-				}
-			}
-		} else {
-			// Health check succeeded
-			container.performerHealth.ApplicationIsHealthy = true
-			container.performerHealth.ConsecutiveApplicationHealthFailures = 0
-
+			// Send unhealthy status event
 			if container.statusChan != nil {
 				select {
 				case container.statusChan <- avsPerformer.PerformerStatusEvent{
-					Status:      avsPerformer.PerformerHealthy,
+					Status:      avsPerformer.PerformerUnhealthy,
 					PerformerID: container.performerID,
-					Message:     "Container is healthy and ready",
+					Message:     fmt.Sprintf("Container of replica %d unhealthy after %d consecutive health check failures", container.replica, consecutiveFailures),
 					Timestamp:   time.Now(),
 				}:
-					aps.logger.Info("Sent healthy status event",
+					aps.logger.Info("Sent unhealthy status event",
 						zap.String("avsAddress", aps.config.AvsAddress),
 						zap.String("performerID", container.performerID),
 					)
 				default:
 				}
 			}
+
+			if restartErr := aps.TriggerContainerRestart(container, fmt.Sprintf("application health check failed %d consecutive times", consecutiveFailures)); restartErr != nil {
+				aps.logger.Error("Failed to trigger container restart",
+					zap.String("avsAddress", aps.config.AvsAddress),
+					zap.String("containerID", containerID),
+					zap.Error(restartErr),
+				)
+			}
+		}
+		return
+	}
+
+	// Health check succeeded
+	container.performerHealth.ApplicationIsHealthy = true
+	container.performerHealth.ConsecutiveApplicationHealthFailures = 0
+
+	if container.statusChan != nil && container.performer.allHealthy() {
+		select {
+		case container.statusChan <- avsPerformer.PerformerStatusEvent{
+			Status:      avsPerformer.PerformerHealthy,
+			PerformerID: container.performerID,
+			Message:     fmt.Sprintf("All %d containers are healthy and ready", len(container.performer.containers)),
+			Timestamp:   time.Now(),
+		}:
+			aps.logger.Info("Sent healthy status event",
+				zap.String("avsAddress", aps.config.AvsAddress),
+				zap.String("performerID", container.performerID),
+			)
+		default:
 		}
 	}
 }
@@ -1285,19 +1376,33 @@ func (aps *AvsContainerPerformer) waitForHealthy(ctx context.Context, statusChan
 	}
 }
 
-func convertPerformerContainer(avsAddress string, container *PerformerContainer) avsPerformer.PerformerMetadata {
-	return avsPerformer.PerformerMetadata{
-		PerformerID:        container.performerID,
+// convertPerformerReplicas reports a performer as healthy only if all of its replicas are, and
+// uses the first replica's container as its resource
+func convertPerformerReplicas(avsAddress string, performer *PerformerReplicas) avsPerformer.PerformerMetadata {
+	metadata := avsPerformer.PerformerMetadata{
+		PerformerID:        performer.performerID,
 		AvsAddress:         avsAddress,
-		Status:             container.status,
-		ArtifactRegistry:   container.image.Repository,
-		ArtifactTag:        container.image.Tag,
-		ArtifactDigest:     container.image.Digest,
-		ContainerHealthy:   container.performerHealth.ContainerIsHealthy,
-		ApplicationHealthy: container.performerHealth.ApplicationIsHealthy,
-		LastHealthCheck:    container.performerHealth.LastHealthCheck,
-		ResourceID:         container.info.ID,
+		Status:             performer.status,
+		ArtifactRegistry:   performer.image.Repository,
+		ArtifactTag:        performer.image.Tag,
+		ArtifactDigest:     performer.image.Digest,
+		ContainerHealthy:   true,
+		ApplicationHealthy: true,
+		ResourceID:         performer.containers[0].info.ID,
+		Replicas:           len(performer.containers),
+	}
+	for _, container := range performer.containers {
+		health := container.performerHealth
+		metadata.ContainerHealthy = metadata.ContainerHealthy && health.ContainerIsHealthy
+		metadata.ApplicationHealthy = metadata.ApplicationHealthy && health.ApplicationIsHealthy
+		if health.ContainerIsHealthy && health.ApplicationIsHealthy {
+			metadata.HealthyReplicas++
+		}
+		if health.LastHealthCheck.After(metadata.LastHealthCheck) {
+			metadata.LastHealthCheck = health.LastHealthCheck
+		}
 	}
+	return metadata
 }
 
 func (aps *AvsContainerPerformer) RehydrateFromState(ctx context.Context, state *storage.PerformerState) error {
@@ -1344,8 +1449,21 @@ func (aps *AvsContainerPerformer) RehydrateFromState(ctx context.Context, state
 	// Create a child context for managing status channel lifecycle
 	statusCtx, statusCancel := context.WithCancel(ctx)
 
+	// Only the first replica's container is persisted, so a rehydrated performer has a single replica
+	performer := &PerformerReplicas{
+		performerID: state.PerformerId,
+		image: avsPerformer.PerformerImage{
+			Repository: state.ArtifactRegistry,
+			Tag:        state.ArtifactTag,
+			Digest:     state.ArtifactDigest,
+			Envs:       envs,
+		},
+		status:     avsPerformer.PerformerResourceStatus(state.Status),
+		statusChan: make(chan avsPerformer.PerformerStatusEvent, 10),
+	}
 	container := &PerformerContainer{
 		performerID: state.PerformerId,
+		performer:   performer,
 		info:        containerInfo,
 		client:      perfClient,
 		eventChan:   eventChan,
@@ -1354,20 +1472,16 @@ func (aps *AvsContainerPerformer) RehydrateFromState(ctx context.Context, state
 			ApplicationIsHealthy: state.ApplicationHealthy,
 			LastHealthCheck:      state.LastHealthCheck,
 		},
-		statusChan:    make(chan avsPerformer.PerformerStatusEvent, 10),
+		statusChan:    performer.statusChan,
 		statusCancel:  statusCancel,
 		statusContext: statusCtx,
-		image: avsPerformer.PerformerImage{
-			Repository: state.ArtifactRegistry,
-			Tag:        state.ArtifactTag,
-			Digest:     state.ArtifactDigest,
-			Envs:       envs,
-		},
-		status: avsPerformer.PerformerResourceStatus(state.Status),
+		image:         performer.image,
 	}
+	container.refreshRotation()
+	performer.containers = []*PerformerContainer{container}
 
 	aps.performerContainersMu.Lock()
-	aps.currentContainer.Store(container)
+	aps.currentPerformer.Store(performer)
 	aps.performerContainersMu.Unlock()
 
 	aps.taskWaitGroupsMu.Lock()
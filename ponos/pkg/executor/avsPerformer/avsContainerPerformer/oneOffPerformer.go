@@ -0,0 +1,251 @@
+package avsContainerPerformer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/avsPerformerClient"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/containerManager"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/executor/avsPerformer"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/performerTask"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// AvsContainerOneOffPerformer runs every task in a fresh container that is removed once the task
+// is done, so no state carries over between tasks
+type AvsContainerOneOffPerformer struct {
+	config *avsPerformer.AvsPerformerConfig
+	logger *zap.Logger
+
+	containerManager containerManager.ContainerManager
+	images           *avsPerformer.OneOffImages
+	memoryLimit      int64
+	nanoCPUs         int64
+
+	// Task tracking
+	tasksMu      sync.Mutex
+	runningTasks int
+	tasksWg      sync.WaitGroup
+	shuttingDown bool
+}
+
+// NewAvsContainerOneOffPerformerWithContainerManager creates a new AvsContainerOneOffPerformer with the provided container manager
+func NewAvsContainerOneOffPerformerWithContainerManager(
+	config *avsPerformer.AvsPerformerConfig,
+	logger *zap.Logger,
+	containerManager containerManager.ContainerManager,
+) (*AvsContainerOneOffPerformer, error) {
+	cpu, memory, err := config.OneOffResourceLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	return &AvsContainerOneOffPerformer{
+		config:           config,
+		logger:           logger,
+		containerManager: containerManager,
+		images:           avsPerformer.NewOneOffImages(config.AvsAddress),
+		memoryLimit:      memory.Value(),
+		nanoCPUs:         cpu.MilliValue() * 1_000_000,
+	}, nil
+}
+
+// NewAvsContainerOneOffPerformer creates a new AvsContainerOneOffPerformer
+func NewAvsContainerOneOffPerformer(
+	config *avsPerformer.AvsPerformerConfig,
+	logger *zap.Logger,
+) (*AvsContainerOneOffPerformer, error) {
+	containerMgr, err := containerManager.NewDockerContainerManager(
+		containerManager.DefaultContainerManagerConfig(),
+		logger,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create container manager for executor: %v", err)
+	}
+
+	return NewAvsContainerOneOffPerformerWithContainerManager(config, logger, containerMgr)
+}
+
+func (aop *AvsContainerOneOffPerformer) Initialize(ctx context.Context) error {
+	// Without an image, tasks are rejected until one is deployed
+	if aop.config.Image.Repository == "" || aop.config.Image.Tag == "" {
+		aop.logger.Info("Starting one-off performer without an image.",
+			zap.String("avsAddress", aop.config.AvsAddress),
+		)
+		return nil
+	}
+
+	_, err := aop.images.Deploy(aop.config.Image)
+	return err
+}
+
+// startTask registers a task so Shutdown waits for it, and returns the image to run it with
+func (aop *AvsContainerOneOffPerformer) startTask() (string, avsPerformer.PerformerImage, error) {
+	aop.tasksMu.Lock()
+	defer aop.tasksMu.Unlock()
+
+	if aop.shuttingDown {
+		return "", avsPerformer.PerformerImage{}, fmt.Errorf("one-off performer is shutting down")
+	}
+
+	performerID, image, ok := aop.images.Current()
+	if !ok {
+		return "", avsPerformer.PerformerImage{}, fmt.Errorf("no current performer image available to execute task")
+	}
+
+	aop.runningTasks++
+	aop.tasksWg.Add(1)
+	return performerID, image, nil
+}
+
+func (aop *AvsContainerOneOffPerformer) finishTask() {
+	aop.tasksMu.Lock()
+	aop.runningTasks--
+	aop.tasksMu.Unlock()
+	aop.tasksWg.Done()
+}
+
+// RunTask starts a container from the current image, executes the task on it and removes the container
+func (aop *AvsContainerOneOffPerformer) RunTask(ctx context.Context, task *performerTask.PerformerTask) (*performerTask.PerformerTaskResult, error) {
+	performerID, image, err := aop.startTask()
+	if err != nil {
+		return nil, err
+	}
+	defer aop.finishTask()
+
+	ctx, cancel := context.WithTimeout(ctx, aop.config.OneOffTaskTimeout())
+	defer cancel()
+
+	containerConfig := containerManager.CreateDefaultContainerConfig(
+		aop.config.AvsAddress,
+		image.Repository,
+		image.Tag,
+		image.Digest,
+		internalContainerPort,
+		aop.config.PerformerNetworkName,
+		buildDockerEnvs(image),
+	)
+	// Tasks run concurrently, so the hostname needs more than a timestamp to be unique
+	containerConfig.Hostname = fmt.Sprintf("avs-performer-%s-%s",
+		containerManager.HashAvsAddress(aop.config.AvsAddress), uuid.New().String()[:8])
+	containerConfig.AutoRemove = false
+	containerConfig.MemoryLimit = aop.memoryLimit
+	containerConfig.NanoCPUs = aop.nanoCPUs
+
+	containerInfo, err := aop.containerManager.Create(ctx, containerConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create task container")
+	}
+	defer aop.removeContainer(containerInfo.ID, task.TaskID)
+
+	if err := aop.containerManager.Start(ctx, containerInfo.ID); err != nil {
+		return nil, errors.Wrap(err, "failed to start task container")
+	}
+	if err := aop.containerManager.WaitForRunning(ctx, containerInfo.ID, defaultRunningWaitTimeout); err != nil {
+		return nil, errors.Wrap(err, "failed to wait for task container to be running")
+	}
+
+	updatedInfo, err := aop.containerManager.Inspect(ctx, containerInfo.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to inspect task container")
+	}
+	endpoint, err := containerManager.GetContainerEndpoint(updatedInfo, internalContainerPort, containerConfig.NetworkName)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get task container endpoint")
+	}
+
+	conn, err := clients.NewGrpcClientWithRetry(endpoint, false, clients.DefaultRetryConfig())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to task container")
+	}
+	defer conn.Close()
+
+	perfClient, err := avsPerformerClient.NewAvsPerformerClientWithConn(conn)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create performer client")
+	}
+
+	aop.logger.Sugar().Infow("Running task in one-off container",
+		zap.String("avsAddress", aop.config.AvsAddress),
+		zap.String("performerID", performerID),
+		zap.String("taskID", task.TaskID),
+		zap.String("containerID", containerInfo.ID),
+		zap.String("endpoint", endpoint),
+	)
+
+	result, err := avsPerformer.RunOneOffTask(ctx, perfClient, task)
+	if err != nil {
+		aop.logger.Sugar().Errorw("One-off performer failed to handle task",
+			zap.String("avsAddress", aop.config.AvsAddress),
+			zap.String("performerID", performerID),
+			zap.String("taskID", task.TaskID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	aop.logger.Sugar().Infow("One-off performer handled task",
+		zap.String("performerID", performerID),
+		zap.String("taskID", task.TaskID),
+	)
+	return result, nil
+}
+
+// removeContainer removes a task container using a fresh context, since the task's context may
+// already be cancelled or timed out
+func (aop *AvsContainerOneOffPerformer) removeContainer(containerID string, taskID string) {
+	cleanupCtx, cleanupCancel := context.WithTimeout(context.Background(), defaultCleanupTimeout)
+	defer cleanupCancel()
+
+	if err := aop.containerManager.Remove(cleanupCtx, containerID, true); err != nil {
+		aop.logger.Error("Failed to remove task container",
+			zap.String("containerID", containerID),
+			zap.String("taskID", taskID),
+			zap.Error(err),
+		)
+	}
+}
+
+func (aop *AvsContainerOneOffPerformer) Deploy(ctx context.Context, image avsPerformer.PerformerImage) (*avsPerformer.DeploymentResult, error) {
+	return aop.images.Deploy(image)
+}
+
+func (aop *AvsContainerOneOffPerformer) CreatePerformer(ctx context.Context, image avsPerformer.PerformerImage) (*avsPerformer.PerformerCreationResult, error) {
+	return aop.images.Stage(image)
+}
+
+func (aop *AvsContainerOneOffPerformer) PromotePerformer(ctx context.Context, performerID string) error {
+	return aop.images.Promote(performerID)
+}
+
+func (aop *AvsContainerOneOffPerformer) RemovePerformer(ctx context.Context, performerID string) error {
+	return aop.images.Remove(performerID)
+}
+
+func (aop *AvsContainerOneOffPerformer) ListPerformers() []avsPerformer.PerformerMetadata {
+	aop.tasksMu.Lock()
+	runningTasks := aop.runningTasks
+	aop.tasksMu.Unlock()
+
+	return aop.images.List(runningTasks)
+}
+
+// Shutdown rejects new tasks and waits for running ones, which remove their own containers
+func (aop *AvsContainerOneOffPerformer) Shutdown() error {
+	aop.tasksMu.Lock()
+	aop.shuttingDown = true
+	aop.tasksMu.Unlock()
+
+	aop.logger.Info("Waiting for one-off tasks to complete",
+		zap.String("avsAddress", aop.config.AvsAddress),
+	)
+	aop.tasksWg.Wait()
+
+	aop.logger.Info("One-off performer shutdown completed",
+		zap.String("avsAddress", aop.config.AvsAddress),
+	)
+	return nil
+}
@@ -68,20 +68,23 @@ func TestAvsKubernetesPerformer_PerformerResourceConnectionManager(t *testing.T)
 	performer := &PerformerResource{
 		performerID: "test-performer-123",
 		avsAddress:  "0xtest123",
-		grpcConn:    grpcConn,
-		client:      client,
-		endpoint:    "localhost:9090",
-		statusChan:  make(chan avsPerformer.PerformerStatusEvent, 10),
-		createdAt:   time.Now(),
+		replicas: []*PerformerReplica{{
+			name:     "test-performer-123",
+			grpcConn: grpcConn,
+			client:   client,
+			endpoint: "localhost:9090",
+		}},
+		statusChan: make(chan avsPerformer.PerformerStatusEvent, 10),
+		createdAt:  time.Now(),
 	}
 
 	// Test gRPC connection is set
-	if performer.grpcConn == nil {
+	if performer.replicas[0].grpcConn == nil {
 		t.Error("Expected grpcConn to be set")
 	}
 
 	// Test clients are set
-	if performer.client == nil {
+	if performer.replicas[0].client == nil {
 		t.Error("Expected client to be set")
 	}
 
@@ -108,8 +111,11 @@ func TestAvsKubernetesPerformer_TaskExecutionWithRetry(t *testing.T) {
 
 	performer := &PerformerResource{
 		performerID: "test-performer-task",
-		grpcConn:    grpcConn,
-		client:      performerV1.NewPerformerServiceClient(grpcConn),
+		replicas: []*PerformerReplica{{
+			name:     "test-performer-task",
+			grpcConn: grpcConn,
+			client:   performerV1.NewPerformerServiceClient(grpcConn),
+		}},
 	}
 
 	// Create AvsKubernetesPerformer
@@ -164,8 +170,11 @@ func TestAvsKubernetesPerformer_CircuitBreakerIntegration(t *testing.T) {
 
 	performer := &PerformerResource{
 		performerID: "test-performer-circuit",
-		grpcConn:    grpcConn,
-		client:      performerV1.NewPerformerServiceClient(grpcConn),
+		replicas: []*PerformerReplica{{
+			name:     "test-performer-circuit",
+			grpcConn: grpcConn,
+			client:   performerV1.NewPerformerServiceClient(grpcConn),
+		}},
 	}
 
 	// Create AvsKubernetesPerformer
@@ -217,17 +226,20 @@ func TestAvsKubernetesPerformer_ConnectionCleanup(t *testing.T) {
 
 	performer := &PerformerResource{
 		performerID: "test-performer-cleanup",
-		grpcConn:    grpcConn,
-		statusChan:  make(chan avsPerformer.PerformerStatusEvent, 10),
+		replicas: []*PerformerReplica{{
+			name:     "test-performer-cleanup",
+			grpcConn: grpcConn,
+		}},
+		statusChan: make(chan avsPerformer.PerformerStatusEvent, 10),
 	}
 
 	// Close gRPC connection
-	if err := performer.grpcConn.Close(); err != nil {
+	if err := performer.replicas[0].grpcConn.Close(); err != nil {
 		t.Errorf("Failed to close gRPC connection: %v", err)
 	}
 
 	// Connection should be closed
-	state := performer.grpcConn.GetState()
+	state := performer.replicas[0].grpcConn.GetState()
 	if state != connectivity.Shutdown {
 		t.Errorf("Expected connection state to be Shutdown, got %v", state)
 	}
@@ -0,0 +1,368 @@
+package avsKubernetesPerformer
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/avsPerformerClient"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/executor/avsPerformer"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/kubernetesManager"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/performerTask"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	jobPodPollInterval    = 1 * time.Second
+	jobCleanupTimeout     = 30 * time.Second
+	jobTTLAfterFinished   = int32(300) // Lets Kubernetes remove Jobs the executor failed to delete
+	jobContainerName      = "performer"
+	jobNameLabel          = "job-name"
+	jobPerformerAppLabel  = "hourglass-one-off-performer"
+	jobPerformerAVSLabel  = "hourglass.eigenlayer.io/avs"
+	jobPerformerTaskLabel = "hourglass.eigenlayer.io/task"
+	maxLabelValueLength   = 63
+)
+
+// AvsKubernetesJobPerformer runs every task in its own Kubernetes Job, which is deleted once the
+// task is done, so no state carries over between tasks
+type AvsKubernetesJobPerformer struct {
+	config     *avsPerformer.AvsPerformerConfig
+	logger     *zap.Logger
+	kubernetes kubernetes.Interface
+	namespace  string
+
+	images      *avsPerformer.OneOffImages
+	cpuLimit    resource.Quantity
+	memoryLimit resource.Quantity
+
+	// Task tracking
+	tasksMu      sync.Mutex
+	runningTasks int
+	tasksWg      sync.WaitGroup
+	shuttingDown bool
+}
+
+// NewAvsKubernetesJobPerformer creates a new Kubernetes Job based one-off performer
+func NewAvsKubernetesJobPerformer(
+	config *avsPerformer.AvsPerformerConfig,
+	kubernetesConfig *kubernetesManager.Config,
+	logger *zap.Logger,
+) (*AvsKubernetesJobPerformer, error) {
+	clientWrapper, err := kubernetesManager.NewClientWrapper(kubernetesConfig, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	return NewAvsKubernetesJobPerformerWithClient(config, clientWrapper.Kubernetes, kubernetesConfig.Namespace, logger)
+}
+
+// NewAvsKubernetesJobPerformerWithClient creates a new AvsKubernetesJobPerformer with the provided clientset
+func NewAvsKubernetesJobPerformerWithClient(
+	config *avsPerformer.AvsPerformerConfig,
+	kubernetesClient kubernetes.Interface,
+	namespace string,
+	logger *zap.Logger,
+) (*AvsKubernetesJobPerformer, error) {
+	cpu, memory, err := config.OneOffResourceLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	return &AvsKubernetesJobPerformer{
+		config:      config,
+		logger:      logger,
+		kubernetes:  kubernetesClient,
+		namespace:   namespace,
+		images:      avsPerformer.NewOneOffImages(config.AvsAddress),
+		cpuLimit:    cpu,
+		memoryLimit: memory,
+	}, nil
+}
+
+// Initialize deploys the configured image, if there is one
+func (akj *AvsKubernetesJobPerformer) Initialize(ctx context.Context) error {
+	if akj.config.Image.Repository == "" || akj.config.Image.Tag == "" {
+		akj.logger.Info("Starting Kubernetes one-off performer without an image.",
+			zap.String("avsAddress", akj.config.AvsAddress),
+		)
+		return nil
+	}
+
+	_, err := akj.images.Deploy(akj.config.Image)
+	return err
+}
+
+// startTask registers a task so Shutdown waits for it, and returns the image to run it with
+func (akj *AvsKubernetesJobPerformer) startTask() (string, avsPerformer.PerformerImage, error) {
+	akj.tasksMu.Lock()
+	defer akj.tasksMu.Unlock()
+
+	if akj.shuttingDown {
+		return "", avsPerformer.PerformerImage{}, fmt.Errorf("one-off performer is shutting down")
+	}
+
+	performerID, image, ok := akj.images.Current()
+	if !ok {
+		return "", avsPerformer.PerformerImage{}, fmt.Errorf("no current performer image available to execute task")
+	}
+
+	akj.runningTasks++
+	akj.tasksWg.Add(1)
+	return performerID, image, nil
+}
+
+func (akj *AvsKubernetesJobPerformer) finishTask() {
+	akj.tasksMu.Lock()
+	akj.runningTasks--
+	akj.tasksMu.Unlock()
+	akj.tasksWg.Done()
+}
+
+// buildJob builds the Job that runs a single task with the image
+func (akj *AvsKubernetesJobPerformer) buildJob(name string, taskID string, image avsPerformer.PerformerImage) *batchv1.Job {
+	envMap, envVarSources := buildEnvironment(akj.config.AvsAddress, image)
+	env := make([]corev1.EnvVar, 0, len(envMap)+len(envVarSources))
+	for _, key := range slices.Sorted(maps.Keys(envMap)) {
+		env = append(env, corev1.EnvVar{Name: key, Value: envMap[key]})
+	}
+	for _, source := range envVarSources {
+		envVar := corev1.EnvVar{Name: source.Name, ValueFrom: &corev1.EnvVarSource{}}
+		if ref := source.ValueFrom.SecretKeyRef; ref != nil {
+			envVar.ValueFrom.SecretKeyRef = &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+				Key:                  ref.Key,
+			}
+		}
+		if ref := source.ValueFrom.ConfigMapKeyRef; ref != nil {
+			envVar.ValueFrom.ConfigMapKeyRef = &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+				Key:                  ref.Key,
+			}
+		}
+		env = append(env, envVar)
+	}
+
+	imageRef := fmt.Sprintf("%s:%s", image.Repository, image.Tag)
+	if image.Digest != "" {
+		imageRef = fmt.Sprintf("%s@%s", image.Repository, image.Digest)
+	}
+
+	limits := corev1.ResourceList{}
+	if !akj.cpuLimit.IsZero() {
+		limits[corev1.ResourceCPU] = akj.cpuLimit
+	}
+	if !akj.memoryLimit.IsZero() {
+		limits[corev1.ResourceMemory] = akj.memoryLimit
+	}
+
+	labels := map[string]string{
+		"app":                jobPerformerAppLabel,
+		jobPerformerAVSLabel: akj.config.AvsAddress,
+	}
+	if len(taskID) <= maxLabelValueLength {
+		labels[jobPerformerTaskLabel] = taskID
+	}
+
+	backoffLimit := int32(0)
+	ttlAfterFinished := jobTTLAfterFinished
+	activeDeadline := int64(akj.config.OneOffTaskTimeout().Seconds())
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: akj.namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            &backoffLimit,
+			ActiveDeadlineSeconds:   &activeDeadline,
+			TTLSecondsAfterFinished: &ttlAfterFinished,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ServiceAccountName: image.ServiceAccountName,
+					Containers: []corev1.Container{
+						{
+							Name:            jobContainerName,
+							Image:           imageRef,
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Ports: []corev1.ContainerPort{
+								{Name: "grpc", ContainerPort: defaultGRPCPort, Protocol: corev1.ProtocolTCP},
+							},
+							Env:       env,
+							Resources: corev1.ResourceRequirements{Limits: limits},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// waitForJobPod waits until the pod of the Job is running and returns its gRPC endpoint
+func (akj *AvsKubernetesJobPerformer) waitForJobPod(ctx context.Context, jobName string) (string, error) {
+	ticker := time.NewTicker(jobPodPollInterval)
+	defer ticker.Stop()
+
+	for {
+		pods, err := akj.kubernetes.CoreV1().Pods(akj.namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", jobNameLabel, jobName),
+		})
+		if err != nil {
+			akj.logger.Debug("Failed to list pods of task job", zap.String("job", jobName), zap.Error(err))
+		} else {
+			for _, pod := range pods.Items {
+				switch pod.Status.Phase {
+				case corev1.PodFailed, corev1.PodSucceeded:
+					return "", fmt.Errorf("pod %s of task job exited before running the task: %s", pod.Name, pod.Status.Phase)
+				case corev1.PodRunning:
+					if pod.Status.PodIP != "" {
+						return fmt.Sprintf("%s:%d", pod.Status.PodIP, defaultGRPCPort), nil
+					}
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("pod of task job %s did not start: %w", jobName, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// deleteJob deletes a task Job and its pod using a fresh context, since the task's context may
+// already be cancelled or timed out
+func (akj *AvsKubernetesJobPerformer) deleteJob(jobName string, taskID string) {
+	cleanupCtx, cleanupCancel := context.WithTimeout(context.Background(), jobCleanupTimeout)
+	defer cleanupCancel()
+
+	propagation := metav1.DeletePropagationBackground
+	err := akj.kubernetes.BatchV1().Jobs(akj.namespace).Delete(cleanupCtx, jobName, metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	})
+	if err != nil {
+		akj.logger.Error("Failed to delete task job",
+			zap.String("job", jobName),
+			zap.String("taskID", taskID),
+			zap.Error(err),
+		)
+	}
+}
+
+// RunTask starts a Job from the current image, executes the task on its pod and deletes the Job
+func (akj *AvsKubernetesJobPerformer) RunTask(ctx context.Context, task *performerTask.PerformerTask) (*performerTask.PerformerTaskResult, error) {
+	performerID, image, err := akj.startTask()
+	if err != nil {
+		return nil, err
+	}
+	defer akj.finishTask()
+
+	ctx, cancel := context.WithTimeout(ctx, akj.config.OneOffTaskTimeout())
+	defer cancel()
+
+	jobName := fmt.Sprintf("performer-task-%s", uuid.New().String())
+	job, err := akj.kubernetes.BatchV1().Jobs(akj.namespace).Create(ctx, akj.buildJob(jobName, task.TaskID, image), metav1.CreateOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create task job")
+	}
+	defer akj.deleteJob(job.Name, task.TaskID)
+
+	endpoint, err := akj.waitForJobPod(ctx, job.Name)
+	if err != nil {
+		return nil, err
+	}
+	if akj.config.EndpointOverride != "" {
+		endpoint = akj.config.EndpointOverride
+	}
+
+	// Pod IPs have no certificate to verify, so the connection is plaintext like Docker performers
+	conn, err := clients.NewGrpcClientWithRetry(endpoint, false, clients.DefaultRetryConfig())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to task pod")
+	}
+	defer conn.Close()
+
+	perfClient, err := avsPerformerClient.NewAvsPerformerClientWithConn(conn)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create performer client")
+	}
+
+	akj.logger.Sugar().Infow("Running task in Kubernetes job",
+		zap.String("avsAddress", akj.config.AvsAddress),
+		zap.String("performerID", performerID),
+		zap.String("taskID", task.TaskID),
+		zap.String("job", job.Name),
+		zap.String("endpoint", endpoint),
+	)
+
+	result, err := avsPerformer.RunOneOffTask(ctx, perfClient, task)
+	if err != nil {
+		akj.logger.Sugar().Errorw("Kubernetes one-off performer failed to handle task",
+			zap.String("avsAddress", akj.config.AvsAddress),
+			zap.String("performerID", performerID),
+			zap.String("taskID", task.TaskID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	akj.logger.Sugar().Infow("Kubernetes one-off performer handled task",
+		zap.String("performerID", performerID),
+		zap.String("taskID", task.TaskID),
+	)
+	return result, nil
+}
+
+func (akj *AvsKubernetesJobPerformer) Deploy(ctx context.Context, image avsPerformer.PerformerImage) (*avsPerformer.DeploymentResult, error) {
+	return akj.images.Deploy(image)
+}
+
+func (akj *AvsKubernetesJobPerformer) CreatePerformer(ctx context.Context, image avsPerformer.PerformerImage) (*avsPerformer.PerformerCreationResult, error) {
+	return akj.images.Stage(image)
+}
+
+func (akj *AvsKubernetesJobPerformer) PromotePerformer(ctx context.Context, performerID string) error {
+	return akj.images.Promote(performerID)
+}
+
+func (akj *AvsKubernetesJobPerformer) RemovePerformer(ctx context.Context, performerID string) error {
+	return akj.images.Remove(performerID)
+}
+
+func (akj *AvsKubernetesJobPerformer) ListPerformers() []avsPerformer.PerformerMetadata {
+	akj.tasksMu.Lock()
+	runningTasks := akj.runningTasks
+	akj.tasksMu.Unlock()
+
+	return akj.images.List(runningTasks)
+}
+
+// Shutdown rejects new tasks and waits for running ones, which delete their own Jobs
+func (akj *AvsKubernetesJobPerformer) Shutdown() error {
+	akj.tasksMu.Lock()
+	akj.shuttingDown = true
+	akj.tasksMu.Unlock()
+
+	akj.logger.Info("Waiting for Kubernetes one-off tasks to complete",
+		zap.String("avsAddress", akj.config.AvsAddress),
+	)
+	akj.tasksWg.Wait()
+
+	akj.logger.Info("Kubernetes one-off performer shutdown completed",
+		zap.String("avsAddress", akj.config.AvsAddress),
+	)
+	return nil
+}
@@ -0,0 +1,120 @@
+package avsKubernetesPerformer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/executor/avsPerformer"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/performerTask"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestJobPerformer(t *testing.T, config *avsPerformer.AvsPerformerConfig) (*AvsKubernetesJobPerformer, *fake.Clientset) {
+	clientset := fake.NewSimpleClientset()
+	performer, err := NewAvsKubernetesJobPerformerWithClient(config, clientset, "test-namespace", zaptest.NewLogger(t))
+	require.NoError(t, err)
+	return performer, clientset
+}
+
+func TestAvsKubernetesJobPerformer_New_InvalidLimits(t *testing.T) {
+	_, err := NewAvsKubernetesJobPerformerWithClient(
+		&avsPerformer.AvsPerformerConfig{AvsAddress: "0xavs", CPULimit: "two"},
+		fake.NewSimpleClientset(),
+		"test-namespace",
+		zaptest.NewLogger(t),
+	)
+	assert.Error(t, err)
+}
+
+func TestAvsKubernetesJobPerformer_BuildJob(t *testing.T) {
+	performer, _ := newTestJobPerformer(t, &avsPerformer.AvsPerformerConfig{
+		AvsAddress:  "0xavs",
+		TaskTimeout: 90 * time.Second,
+		CPULimit:    "500m",
+		MemoryLimit: "256Mi",
+	})
+
+	job := performer.buildJob("performer-task-1", "task-1", avsPerformer.PerformerImage{
+		Repository:         "avs",
+		Tag:                "v1",
+		ServiceAccountName: "performer-sa",
+		Envs: []config.AVSPerformerEnv{
+			{Name: "LOG_LEVEL", Value: "debug"},
+		},
+	})
+
+	assert.Equal(t, "performer-task-1", job.Name)
+	assert.Equal(t, "test-namespace", job.Namespace)
+	assert.Equal(t, "task-1", job.Labels[jobPerformerTaskLabel])
+	assert.Equal(t, int32(0), *job.Spec.BackoffLimit)
+	assert.Equal(t, int64(90), *job.Spec.ActiveDeadlineSeconds)
+
+	podSpec := job.Spec.Template.Spec
+	assert.Equal(t, corev1.RestartPolicyNever, podSpec.RestartPolicy)
+	assert.Equal(t, "performer-sa", podSpec.ServiceAccountName)
+	require.Len(t, podSpec.Containers, 1)
+
+	container := podSpec.Containers[0]
+	assert.Equal(t, "avs:v1", container.Image)
+	assert.Equal(t, "500m", container.Resources.Limits.Cpu().String())
+	assert.Equal(t, "256Mi", container.Resources.Limits.Memory().String())
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "AVS_ADDRESS", Value: "0xavs"},
+		{Name: "GRPC_PORT", Value: "8080"},
+		{Name: "LOG_LEVEL", Value: "debug"},
+	}, container.Env)
+}
+
+func TestAvsKubernetesJobPerformer_BuildJob_DefaultsAndDigest(t *testing.T) {
+	performer, _ := newTestJobPerformer(t, &avsPerformer.AvsPerformerConfig{AvsAddress: "0xavs"})
+
+	job := performer.buildJob("performer-task-1", "task-1", avsPerformer.PerformerImage{
+		Repository: "avs",
+		Tag:        "v1",
+		Digest:     "sha256:abc",
+	})
+
+	assert.Equal(t, int64(avsPerformer.DefaultOneOffTaskTimeout.Seconds()), *job.Spec.ActiveDeadlineSeconds)
+	assert.Equal(t, "avs@sha256:abc", job.Spec.Template.Spec.Containers[0].Image)
+	assert.Empty(t, job.Spec.Template.Spec.Containers[0].Resources.Limits)
+}
+
+func TestAvsKubernetesJobPerformer_RunTask_NoImage(t *testing.T) {
+	performer, clientset := newTestJobPerformer(t, &avsPerformer.AvsPerformerConfig{AvsAddress: "0xavs"})
+	require.NoError(t, performer.Initialize(context.Background()))
+
+	_, err := performer.RunTask(context.Background(), &performerTask.PerformerTask{TaskID: "task-1"})
+	assert.Error(t, err)
+
+	jobs, err := clientset.BatchV1().Jobs("test-namespace").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, jobs.Items)
+}
+
+func TestAvsKubernetesJobPerformer_RunTask_DeletesJobOnTimeout(t *testing.T) {
+	performer, clientset := newTestJobPerformer(t, &avsPerformer.AvsPerformerConfig{
+		AvsAddress:  "0xavs",
+		TaskTimeout: 100 * time.Millisecond,
+	})
+	_, err := performer.Deploy(context.Background(), avsPerformer.PerformerImage{Repository: "avs", Tag: "v1"})
+	require.NoError(t, err)
+
+	// The fake clientset never schedules a pod, so the task times out waiting for one
+	_, err = performer.RunTask(context.Background(), &performerTask.PerformerTask{TaskID: "task-1"})
+	assert.Error(t, err)
+
+	jobs, err := clientset.BatchV1().Jobs("test-namespace").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, jobs.Items)
+
+	require.NoError(t, performer.Shutdown())
+	_, err = performer.RunTask(context.Background(), &performerTask.PerformerTask{TaskID: "task-2"})
+	assert.Error(t, err)
+}
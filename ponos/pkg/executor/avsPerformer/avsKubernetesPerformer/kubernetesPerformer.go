@@ -14,6 +14,7 @@ import (
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/kubernetesManager"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/performerTask"
 	performerV1 "github.com/Layr-Labs/protocol-apis/gen/protos/eigenlayer/hourglass/v1/performer"
+	healthV1 "github.com/Layr-Labs/protocol-apis/gen/protos/grpc/health/v1"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -21,9 +22,10 @@ import (
 )
 
 const (
-	defaultDeploymentTimeout  = 5 * time.Minute // Kubernetes deployments can take longer
-	defaultRunningWaitTimeout = 5 * time.Minute // K8s pods need more time to start
-	defaultGRPCPort           = 8080
+	defaultDeploymentTimeout              = 5 * time.Minute // Kubernetes deployments can take longer
+	defaultRunningWaitTimeout             = 5 * time.Minute // K8s pods need more time to start
+	defaultGRPCPort                       = 8080
+	defaultApplicationHealthCheckInterval = 15 * time.Second
 )
 
 // PerformerLifecycleState represents the lifecycle state of a performer
@@ -43,15 +45,31 @@ type PerformerTaskState struct {
 
 // PerformerResource holds information about a Kubernetes performer
 type PerformerResource struct {
-	performerID string
-	avsAddress  string
-	image       avsPerformer.PerformerImage
-	status      avsPerformer.PerformerResourceStatus
-	client      performerV1.PerformerServiceClient
-	grpcConn    *grpc.ClientConn // Single gRPC connection
-	endpoint    string
-	statusChan  chan avsPerformer.PerformerStatusEvent
-	createdAt   time.Time
+	performerID  string
+	avsAddress   string
+	image        avsPerformer.PerformerImage
+	status       avsPerformer.PerformerResourceStatus
+	replicas     []*PerformerReplica
+	balancer     avsPerformer.ReplicaBalancer
+	healthCancel context.CancelFunc
+	statusChan   chan avsPerformer.PerformerStatusEvent
+	createdAt    time.Time
+}
+
+// PerformerReplica holds the connection to one Performer CRD of a performer
+type PerformerReplica struct {
+	name         string // Performer CRD name; the first replica is named after the performer
+	replica      int
+	client       performerV1.PerformerServiceClient
+	healthClient healthV1.HealthClient
+	grpcConn     *grpc.ClientConn // Single gRPC connection
+	endpoint     string
+	load         avsPerformer.ReplicaLoad
+}
+
+// Load returns the rotation and in-flight state used to balance tasks onto this replica
+func (pr *PerformerReplica) Load() *avsPerformer.ReplicaLoad {
+	return &pr.load
 }
 
 // AvsKubernetesPerformer implements IAvsPerformer using Kubernetes CRDs
@@ -101,6 +119,11 @@ func NewAvsKubernetesPerformer(
 	// Create CRD operations manager
 	crdOps := kubernetesManager.NewCRDOperations(clientWrapper.CRDClient, kubernetesConfig, logger)
 
+	// Set default health check interval if not specified
+	if config.ApplicationHealthCheckInterval == 0 {
+		config.ApplicationHealthCheckInterval = defaultApplicationHealthCheckInterval
+	}
+
 	return &AvsKubernetesPerformer{
 		config:              config,
 		kubernetesConfig:    kubernetesConfig,
@@ -137,6 +160,7 @@ func (akp *AvsKubernetesPerformer) Initialize(ctx context.Context) error {
 
 	performerResource.status = avsPerformer.PerformerResourceStatusInService
 	akp.currentPerformer.Store(performerResource)
+	akp.startHealthMonitoring(performerResource)
 
 	return nil
 }
@@ -149,13 +173,27 @@ func (akp *AvsKubernetesPerformer) generatePerformerID() string {
 	return fmt.Sprintf("performer-%s-%s", containerManager.HashAvsAddress(akp.config.AvsAddress), shortUUID)
 }
 
+// replicaName returns the Performer CRD name of a replica. The first replica keeps the performer ID
+// so single-replica performers are named as before.
+func replicaName(performerID string, replica int) string {
+	if replica == 0 {
+		return performerID
+	}
+	return fmt.Sprintf("%s-%d", performerID, replica)
+}
+
 // buildEnvironmentFromImage builds environment variables from the PerformerImage configuration
 func (akp *AvsKubernetesPerformer) buildEnvironmentFromImage(image avsPerformer.PerformerImage) (map[string]string, []kubernetesManager.EnvVarSource) {
+	return buildEnvironment(akp.config.AvsAddress, image)
+}
+
+// buildEnvironment builds the environment variables of a performer of the AVS running the image
+func buildEnvironment(avsAddress string, image avsPerformer.PerformerImage) (map[string]string, []kubernetesManager.EnvVarSource) {
 	envMap := make(map[string]string)
 	var envVarSources []kubernetesManager.EnvVarSource
 
 	// Add default environment variables
-	envMap["AVS_ADDRESS"] = akp.config.AvsAddress
+	envMap["AVS_ADDRESS"] = avsAddress
 	envMap["GRPC_PORT"] = fmt.Sprintf("%d", defaultGRPCPort)
 
 	// Process environment variables from image.Envs
@@ -198,19 +236,55 @@ func (akp *AvsKubernetesPerformer) buildEnvironmentFromImage(image avsPerformer.
 	return envMap, envVarSources
 }
 
-// createPerformerResource creates a new Kubernetes performer resource
+// createPerformerResource creates a new Kubernetes performer with the configured number of replicas.
+// If any replica fails to become ready, the replicas already created are deleted again.
 func (akp *AvsKubernetesPerformer) createPerformerResource(
 	ctx context.Context,
 	image avsPerformer.PerformerImage,
 ) (*PerformerResource, error) {
 	performerID := akp.generatePerformerID()
 
+	performerResource := &PerformerResource{
+		performerID: performerID,
+		avsAddress:  akp.config.AvsAddress,
+		image:       image,
+		status:      avsPerformer.PerformerResourceStatusStaged,
+		statusChan:  make(chan avsPerformer.PerformerStatusEvent, 10),
+		createdAt:   time.Now(),
+	}
+
+	for replica := 0; replica < akp.config.ReplicaCount(); replica++ {
+		performerReplica, err := akp.createPerformerReplica(ctx, performerID, replica, image)
+		if err != nil {
+			akp.deleteReplicas(ctx, performerResource)
+			return nil, err
+		}
+		performerResource.replicas = append(performerResource.replicas, performerReplica)
+	}
+
+	akp.logger.Info("Kubernetes performer resource created successfully",
+		zap.String("performerID", performerID),
+		zap.Int("replicas", len(performerResource.replicas)),
+	)
+
+	return performerResource, nil
+}
+
+// createPerformerReplica creates the Performer CRD of one replica and connects to it once it is ready
+func (akp *AvsKubernetesPerformer) createPerformerReplica(
+	ctx context.Context,
+	performerID string,
+	replica int,
+	image avsPerformer.PerformerImage,
+) (*PerformerReplica, error) {
+	name := replicaName(performerID, replica)
+
 	// Build environment variables and sources
 	envMap, envVarSources := akp.buildEnvironmentFromImage(image)
 
 	// Create Kubernetes CRD request
 	createRequest := &kubernetesManager.CreatePerformerRequest{
-		Name:               performerID,
+		Name:               name,
 		AVSAddress:         akp.config.AvsAddress,
 		Image:              fmt.Sprintf("%s:%s", image.Repository, image.Tag),
 		ImagePullPolicy:    "Never", // Use local images only for testing
@@ -226,6 +300,8 @@ func (akp *AvsKubernetesPerformer) createPerformerResource(
 
 	akp.logger.Info("Creating Kubernetes performer resource",
 		zap.String("performerID", performerID),
+		zap.Int("replica", replica),
+		zap.String("name", name),
 		zap.String("avsAddress", akp.config.AvsAddress),
 		zap.String("image", createRequest.Image),
 		zap.String("namespace", akp.kubernetesConfig.Namespace),
@@ -238,11 +314,12 @@ func (akp *AvsKubernetesPerformer) createPerformerResource(
 	}
 
 	// Wait for the performer to be ready
-	if err := akp.waitForPerformerReady(ctx, performerID); err != nil {
+	if err := akp.waitForPerformerReady(ctx, name); err != nil {
 		// Clean up on failure
-		if cleanupErr := akp.kubernetesManager.DeletePerformer(ctx, performerID); cleanupErr != nil {
+		if cleanupErr := akp.kubernetesManager.DeletePerformer(ctx, name); cleanupErr != nil {
 			akp.logger.Error("Failed to clean up performer after creation failure",
 				zap.String("performerID", performerID),
+				zap.String("name", name),
 				zap.Error(cleanupErr),
 			)
 		}
@@ -271,39 +348,122 @@ func (akp *AvsKubernetesPerformer) createPerformerResource(
 
 	akp.logger.Info("Creating gRPC client for performer",
 		zap.String("performerID", performerID),
+		zap.Int("replica", replica),
 		zap.String("endpoint", endpoint),
 	)
 	grpcConn, err := clients.NewGrpcClientWithRetry(endpoint, true, retryConfig)
 	if err != nil {
+		if cleanupErr := akp.kubernetesManager.DeletePerformer(ctx, name); cleanupErr != nil {
+			akp.logger.Error("Failed to clean up performer after connection failure",
+				zap.String("performerID", performerID),
+				zap.String("name", name),
+				zap.Error(cleanupErr),
+			)
+		}
 		return nil, fmt.Errorf("failed to create gRPC connection: %w", err)
 	}
 
-	// Create the client once for this performer
-	client := performerV1.NewPerformerServiceClient(grpcConn)
-
 	akp.logger.Info("Created gRPC client for performer",
 		zap.String("performerID", performerID),
+		zap.Int("replica", replica),
 		zap.String("endpoint", endpoint),
 	)
 
-	performerResource := &PerformerResource{
-		performerID: performerID,
-		avsAddress:  akp.config.AvsAddress,
-		image:       image,
-		status:      avsPerformer.PerformerResourceStatusStaged,
-		client:      client,
-		grpcConn:    grpcConn,
-		endpoint:    endpoint, // Use the potentially overridden endpoint
-		statusChan:  make(chan avsPerformer.PerformerStatusEvent, 10),
-		createdAt:   time.Now(),
+	// Create the clients once for this replica; the pod is ready, so it starts in rotation
+	performerReplica := &PerformerReplica{
+		name:         name,
+		replica:      replica,
+		client:       performerV1.NewPerformerServiceClient(grpcConn),
+		healthClient: healthV1.NewHealthClient(grpcConn),
+		grpcConn:     grpcConn,
+		endpoint:     endpoint, // Use the potentially overridden endpoint
 	}
+	performerReplica.load.SetInRotation(true)
 
-	akp.logger.Info("Kubernetes performer resource created successfully",
-		zap.String("performerID", performerID),
-		zap.String("endpoint", endpoint),
-	)
+	return performerReplica, nil
+}
 
-	return performerResource, nil
+// deleteReplicas closes the connections to and deletes the Performer CRDs of every replica,
+// returning the first deletion error
+func (akp *AvsKubernetesPerformer) deleteReplicas(ctx context.Context, performer *PerformerResource) error {
+	var firstErr error
+	for _, performerReplica := range performer.replicas {
+		// Close gRPC connection
+		if performerReplica.grpcConn != nil {
+			if err := performerReplica.grpcConn.Close(); err != nil {
+				akp.logger.Warn("Failed to close gRPC connection",
+					zap.String("performerID", performer.performerID),
+					zap.Int("replica", performerReplica.replica),
+					zap.Error(err),
+				)
+			}
+		}
+
+		if err := akp.kubernetesManager.DeletePerformer(ctx, performerReplica.name); err != nil {
+			akp.logger.Error("Failed to delete Kubernetes performer",
+				zap.String("performerID", performer.performerID),
+				zap.String("name", performerReplica.name),
+				zap.Error(err),
+			)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+// startHealthMonitoring periodically checks the application health of every replica of the performer,
+// dropping replicas that fail from rotation until they pass again
+func (akp *AvsKubernetesPerformer) startHealthMonitoring(performer *PerformerResource) {
+	ctx, cancel := context.WithCancel(context.Background())
+	performer.healthCancel = cancel
+
+	go func() {
+		ticker := time.NewTicker(akp.config.ApplicationHealthCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, performerReplica := range performer.replicas {
+					akp.checkReplicaHealth(ctx, performer.performerID, performerReplica)
+				}
+			}
+		}
+	}()
+}
+
+// stopHealthMonitoring stops the health checks started by startHealthMonitoring
+func (akp *AvsKubernetesPerformer) stopHealthMonitoring(performer *PerformerResource) {
+	if performer.healthCancel != nil {
+		performer.healthCancel()
+	}
+}
+
+// checkReplicaHealth performs a single application health check on a replica and updates its rotation
+func (akp *AvsKubernetesPerformer) checkReplicaHealth(ctx context.Context, performerID string, performerReplica *PerformerReplica) {
+	healthCtx, cancel := context.WithTimeout(ctx, 1*time.Second)
+	defer cancel()
+
+	_, err := performerReplica.healthClient.Check(healthCtx, &healthV1.HealthCheckRequest{})
+	wasInRotation := performerReplica.load.InRotation()
+	performerReplica.load.SetInRotation(err == nil)
+
+	if err != nil && wasInRotation {
+		akp.logger.Warn("Performer replica failed health check, removing from rotation",
+			zap.String("performerID", performerID),
+			zap.Int("replica", performerReplica.replica),
+			zap.Error(err),
+		)
+	} else if err == nil && !wasInRotation {
+		akp.logger.Info("Performer replica is healthy again, returning to rotation",
+			zap.String("performerID", performerID),
+			zap.Int("replica", performerReplica.replica),
+		)
+	}
 }
 
 // waitForPerformerReady waits for the performer to be ready
@@ -379,10 +539,12 @@ func (akp *AvsKubernetesPerformer) CreatePerformer(
 	// Always deploy as next performer
 	akp.nextPerformer = newPerformer
 	akp.nextPerformer.status = avsPerformer.PerformerResourceStatusStaged
+	akp.startHealthMonitoring(newPerformer)
 
 	akp.logger.Info("Kubernetes performer created successfully",
 		zap.String("performerID", newPerformer.performerID),
-		zap.String("endpoint", newPerformer.endpoint),
+		zap.String("endpoint", newPerformer.replicas[0].endpoint),
+		zap.Int("replicas", len(newPerformer.replicas)),
 	)
 
 	return &avsPerformer.PerformerCreationResult{
@@ -472,23 +634,10 @@ func (akp *AvsKubernetesPerformer) RemovePerformer(ctx context.Context, performe
 	if targetPerformer.statusChan != nil {
 		close(targetPerformer.statusChan)
 	}
+	akp.stopHealthMonitoring(targetPerformer)
 
-	// Close gRPC connection
-	if targetPerformer.grpcConn != nil {
-		if err := targetPerformer.grpcConn.Close(); err != nil {
-			akp.logger.Warn("Failed to close gRPC connection",
-				zap.String("performerID", performerID),
-				zap.Error(err),
-			)
-		}
-	}
-
-	// Delete the Kubernetes performer resource
-	if err := akp.kubernetesManager.DeletePerformer(ctx, performerID); err != nil {
-		akp.logger.Error("Failed to delete Kubernetes performer",
-			zap.String("performerID", performerID),
-			zap.Error(err),
-		)
+	// Close the gRPC connections and delete the Kubernetes performer resources
+	if err := akp.deleteReplicas(ctx, targetPerformer); err != nil {
 		return fmt.Errorf("failed to delete performer: %w", err)
 	}
 
@@ -499,7 +648,7 @@ func (akp *AvsKubernetesPerformer) RemovePerformer(ctx context.Context, performe
 	return nil
 }
 
-// RunTask executes a task on the current performer
+// RunTask executes a task on the replica of the current performer with the fewest tasks in flight
 func (akp *AvsKubernetesPerformer) RunTask(ctx context.Context, task *performerTask.PerformerTask) (*performerTask.PerformerTaskResult, error) {
 	akp.logger.Debug("Processing task", zap.String("taskID", task.TaskID))
 
@@ -510,7 +659,12 @@ func (akp *AvsKubernetesPerformer) RunTask(ctx context.Context, task *performerT
 	}
 
 	currentPerformer, ok := current.(*PerformerResource)
-	if !ok || currentPerformer == nil || currentPerformer.client == nil {
+	if !ok || currentPerformer == nil {
+		return nil, fmt.Errorf("no current performer available to execute task")
+	}
+
+	performerReplica, ok := avsPerformer.PickReplica(&currentPerformer.balancer, currentPerformer.replicas)
+	if !ok || performerReplica.client == nil {
 		return nil, fmt.Errorf("no current performer client available to execute task")
 	}
 
@@ -520,14 +674,18 @@ func (akp *AvsKubernetesPerformer) RunTask(ctx context.Context, task *performerT
 	}
 	defer akp.taskCompleted(currentPerformer.performerID)
 
+	performerReplica.load.TaskStarted()
+	defer performerReplica.load.TaskDone()
+
 	// Execute the task using the pre-created client
-	res, err := currentPerformer.client.ExecuteTask(ctx, &performerV1.TaskRequest{
+	res, err := performerReplica.client.ExecuteTask(ctx, &performerV1.TaskRequest{
 		TaskId:  []byte(task.TaskID),
 		Payload: task.Payload,
 	})
 	if err != nil {
 		akp.logger.Error("Performer failed to handle task",
 			zap.String("performerID", currentPerformer.performerID),
+			zap.Int("replica", performerReplica.replica),
 			zap.String("taskID", task.TaskID),
 			zap.Error(err),
 		)
@@ -536,6 +694,7 @@ func (akp *AvsKubernetesPerformer) RunTask(ctx context.Context, task *performerT
 
 	akp.logger.Debug("Performer handled task successfully",
 		zap.String("performerID", currentPerformer.performerID),
+		zap.Int("replica", performerReplica.replica),
 		zap.String("taskID", task.TaskID),
 	)
 
@@ -662,6 +821,13 @@ func (akp *AvsKubernetesPerformer) ListPerformers() []avsPerformer.PerformerMeta
 
 // convertPerformerResource converts a PerformerResource to PerformerMetadata
 func (akp *AvsKubernetesPerformer) convertPerformerResource(performer *PerformerResource) avsPerformer.PerformerMetadata {
+	healthyReplicas := 0
+	for _, performerReplica := range performer.replicas {
+		if performerReplica.load.InRotation() {
+			healthyReplicas++
+		}
+	}
+
 	return avsPerformer.PerformerMetadata{
 		PerformerID:        performer.performerID,
 		AvsAddress:         performer.avsAddress,
@@ -669,10 +835,12 @@ func (akp *AvsKubernetesPerformer) convertPerformerResource(performer *Performer
 		ArtifactRegistry:   performer.image.Repository,
 		ArtifactTag:        performer.image.Tag,
 		ArtifactDigest:     performer.image.Digest,
-		ContainerHealthy:   true, // Kubernetes handles health checks
-		ApplicationHealthy: true, // Kubernetes handles health checks
+		ContainerHealthy:   true,                                       // Kubernetes handles pod health checks
+		ApplicationHealthy: healthyReplicas == len(performer.replicas), // Every replica passes its application health check
 		LastHealthCheck:    time.Now(),
 		ResourceID:         performer.performerID, // In K8s, we use performer ID as resource ID
+		Replicas:           len(performer.replicas),
+		HealthyReplicas:    healthyReplicas,
 	}
 }
 
@@ -789,17 +957,8 @@ func (akp *AvsKubernetesPerformer) startDrainAndRemove(performer *PerformerResou
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		// Close gRPC connection
-		if performer.grpcConn != nil {
-			if err := performer.grpcConn.Close(); err != nil {
-				akp.logger.Warn("Failed to close gRPC connection during drain",
-					zap.String("performerID", performerID),
-					zap.Error(err),
-				)
-			}
-		}
-
-		if err := akp.kubernetesManager.DeletePerformer(ctx, performerID); err != nil {
+		akp.stopHealthMonitoring(performer)
+		if err := akp.deleteReplicas(ctx, performer); err != nil {
 			akp.logger.Error("Failed to remove drained performer",
 				zap.String("performerID", performerID),
 				zap.Error(err),
@@ -843,15 +1002,9 @@ func (akp *AvsKubernetesPerformer) Shutdown() error {
 			akp.logger.Error("Invalid type in currentPerformer atomic.Value during shutdown")
 			errs = append(errs, fmt.Errorf("invalid performer type stored in currentPerformer"))
 		}
-		// Close gRPC connection
-		if currentPerformer.grpcConn != nil {
-			if err := currentPerformer.grpcConn.Close(); err != nil {
-				errs = append(errs, fmt.Errorf("failed to close current performer gRPC connection: %w", err))
-			}
-		}
-
+		akp.stopHealthMonitoring(currentPerformer)
 		akp.waitForTaskCompletion(currentPerformer.performerID)
-		if err := akp.kubernetesManager.DeletePerformer(ctx, currentPerformer.performerID); err != nil {
+		if err := akp.deleteReplicas(ctx, currentPerformer); err != nil {
 			errs = append(errs, fmt.Errorf("failed to shutdown current performer: %w", err))
 		}
 		akp.cleanupTaskWaitGroup(currentPerformer.performerID)
@@ -860,15 +1013,9 @@ func (akp *AvsKubernetesPerformer) Shutdown() error {
 
 	// Shutdown next performer
 	if akp.nextPerformer != nil {
-		// Close gRPC connection
-		if akp.nextPerformer.grpcConn != nil {
-			if err := akp.nextPerformer.grpcConn.Close(); err != nil {
-				errs = append(errs, fmt.Errorf("failed to close next performer gRPC connection: %w", err))
-			}
-		}
-
+		akp.stopHealthMonitoring(akp.nextPerformer)
 		akp.waitForTaskCompletion(akp.nextPerformer.performerID)
-		if err := akp.kubernetesManager.DeletePerformer(ctx, akp.nextPerformer.performerID); err != nil {
+		if err := akp.deleteReplicas(ctx, akp.nextPerformer); err != nil {
 			errs = append(errs, fmt.Errorf("failed to shutdown next performer: %w", err))
 		}
 		akp.cleanupTaskWaitGroup(akp.nextPerformer.performerID)
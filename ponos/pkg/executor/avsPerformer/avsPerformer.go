@@ -69,6 +69,8 @@ type PerformerMetadata struct {
 	ContainerHealthy   bool
 	ApplicationHealthy bool
 	LastHealthCheck    time.Time
+	Replicas           int
+	HealthyReplicas    int
 }
 
 type AvsPerformerConfig struct {
@@ -79,6 +81,12 @@ type AvsPerformerConfig struct {
 	EndpointOverride               string        // Optional: Override the auto-detected endpoint (for testing when executor is outside cluster)
 	ApplicationHealthCheckInterval time.Duration // Interval for health checks on the application running in the performer container
 	ServiceAccountName             string
+	Replicas                       int // Number of identical performers tasks are spread across; defaults to 1
+
+	// One-off performers only
+	TaskTimeout time.Duration // How long a task may take, including starting its container; defaults to 5 minutes
+	CPULimit    string        // CPU limit of a task's container as a Kubernetes quantity, e.g. "500m"
+	MemoryLimit string        // Memory limit of a task's container as a Kubernetes quantity, e.g. "512Mi"
 }
 
 // DeploymentStatus represents the current state of a deployment
@@ -0,0 +1,236 @@
+package avsPerformer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/avsPerformerClient"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/performerTask"
+	performerV1 "github.com/Layr-Labs/protocol-apis/gen/protos/eigenlayer/hourglass/v1/performer"
+	healthV1 "github.com/Layr-Labs/protocol-apis/gen/protos/grpc/health/v1"
+	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	DefaultOneOffTaskTimeout = 5 * time.Minute
+	oneOffReadyPollInterval  = 500 * time.Millisecond
+)
+
+// OneOffTaskTimeout returns how long a task of a one-off performer may take
+func (c *AvsPerformerConfig) OneOffTaskTimeout() time.Duration {
+	if c.TaskTimeout <= 0 {
+		return DefaultOneOffTaskTimeout
+	}
+	return c.TaskTimeout
+}
+
+// OneOffResourceLimits parses the CPU and memory limits of a one-off performer's task containers.
+// A limit that is not set is returned as a zero quantity.
+func (c *AvsPerformerConfig) OneOffResourceLimits() (cpu resource.Quantity, memory resource.Quantity, err error) {
+	if c.CPULimit != "" {
+		if cpu, err = resource.ParseQuantity(c.CPULimit); err != nil {
+			return cpu, memory, fmt.Errorf("invalid CPU limit %q: %w", c.CPULimit, err)
+		}
+	}
+	if c.MemoryLimit != "" {
+		if memory, err = resource.ParseQuantity(c.MemoryLimit); err != nil {
+			return cpu, memory, fmt.Errorf("invalid memory limit %q: %w", c.MemoryLimit, err)
+		}
+	}
+	return cpu, memory, nil
+}
+
+// RunOneOffTask waits for a freshly started one-off performer to pass its health check and then
+// sends it the task. The performer is polled until ctx is done, which bounds how long it may take
+// to start.
+func RunOneOffTask(
+	ctx context.Context,
+	client *avsPerformerClient.PerformerClient,
+	task *performerTask.PerformerTask,
+) (*performerTask.PerformerTaskResult, error) {
+	ticker := time.NewTicker(oneOffReadyPollInterval)
+	defer ticker.Stop()
+
+	for {
+		healthCtx, cancel := context.WithTimeout(ctx, 1*time.Second)
+		_, err := client.HealthClient.Check(healthCtx, &healthV1.HealthCheckRequest{})
+		cancel()
+		if err == nil {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("one-off performer did not become ready: %w", err)
+		case <-ticker.C:
+		}
+	}
+
+	res, err := client.PerformerClient.ExecuteTask(ctx, &performerV1.TaskRequest{
+		TaskId:  []byte(task.TaskID),
+		Payload: task.Payload,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return performerTask.NewTaskResultFromResultProto(res), nil
+}
+
+type oneOffImage struct {
+	performerID string
+	image       PerformerImage
+	status      PerformerResourceStatus
+}
+
+// OneOffImages tracks the image a one-off performer starts task containers from. Deployments stage
+// an image in the next slot and promote it to current, like the slots of server performers; tasks
+// that are already running keep the image they started with.
+type OneOffImages struct {
+	avsAddress string
+
+	mu      sync.Mutex
+	current *oneOffImage
+	next    *oneOffImage
+}
+
+// NewOneOffImages creates empty image slots for the one-off performer of an AVS
+func NewOneOffImages(avsAddress string) *OneOffImages {
+	return &OneOffImages{avsAddress: avsAddress}
+}
+
+// Current returns the performer ID and image new tasks start from
+func (oi *OneOffImages) Current() (string, PerformerImage, bool) {
+	oi.mu.Lock()
+	defer oi.mu.Unlock()
+
+	if oi.current == nil {
+		return "", PerformerImage{}, false
+	}
+	return oi.current.performerID, oi.current.image, true
+}
+
+// Stage puts an image in the next slot. There is nothing to start until a task arrives, so the
+// returned status channel reports the performer healthy right away.
+func (oi *OneOffImages) Stage(image PerformerImage) (*PerformerCreationResult, error) {
+	oi.mu.Lock()
+	defer oi.mu.Unlock()
+
+	if oi.next != nil {
+		return nil, fmt.Errorf("a next performer already exists (ID: %s). Please remove it explicitly before creating a new one", oi.next.performerID)
+	}
+
+	oi.next = &oneOffImage{
+		performerID: fmt.Sprintf("one-off-%s-%s", oi.avsAddress, uuid.New().String()),
+		image:       image,
+		status:      PerformerResourceStatusStaged,
+	}
+
+	statusChan := make(chan PerformerStatusEvent, 1)
+	statusChan <- PerformerStatusEvent{
+		Status:      PerformerHealthy,
+		PerformerID: oi.next.performerID,
+		Message:     "One-off performer image staged",
+		Timestamp:   time.Now(),
+	}
+
+	return &PerformerCreationResult{
+		PerformerId: oi.next.performerID,
+		StatusChan:  statusChan,
+	}, nil
+}
+
+// Promote makes the staged image the one new tasks start from
+func (oi *OneOffImages) Promote(performerID string) error {
+	oi.mu.Lock()
+	defer oi.mu.Unlock()
+
+	if oi.current != nil && oi.current.performerID == performerID {
+		return nil
+	}
+	if oi.next == nil || oi.next.performerID != performerID {
+		return fmt.Errorf("performer %s is not in the next deployment slot", performerID)
+	}
+
+	oi.next.status = PerformerResourceStatusInService
+	oi.current = oi.next
+	oi.next = nil
+	return nil
+}
+
+// Remove empties the slot holding the performer. Removing the current image stops new tasks
+// from running until another image is deployed.
+func (oi *OneOffImages) Remove(performerID string) error {
+	oi.mu.Lock()
+	defer oi.mu.Unlock()
+
+	switch {
+	case oi.current != nil && oi.current.performerID == performerID:
+		oi.current = nil
+	case oi.next != nil && oi.next.performerID == performerID:
+		oi.next = nil
+	default:
+		return fmt.Errorf("performer with ID %s not found", performerID)
+	}
+	return nil
+}
+
+// Deploy stages the image and promotes it in one step
+func (oi *OneOffImages) Deploy(image PerformerImage) (*DeploymentResult, error) {
+	result := &DeploymentResult{
+		Id:        fmt.Sprintf("deployment-%s-%s", oi.avsAddress, uuid.New().String()),
+		Status:    DeploymentStatusPending,
+		Image:     image,
+		StartTime: time.Now(),
+	}
+
+	creationResult, err := oi.Stage(image)
+	if err == nil {
+		result.PerformerId = creationResult.PerformerId
+		err = oi.Promote(creationResult.PerformerId)
+	}
+	result.EndTime = time.Now()
+	if err != nil {
+		result.Status = DeploymentStatusFailed
+		result.Error = err
+		result.Message = fmt.Sprintf("Failed to deploy one-off performer: %v", err)
+		return result, err
+	}
+
+	result.Status = DeploymentStatusCompleted
+	result.Message = "One-off performer image deployed; tasks started from now on use it"
+	return result, nil
+}
+
+// List returns the current and next images as performers. runningTasks is reported as the
+// replicas of the current image, since every running task has a container of its own.
+func (oi *OneOffImages) List(runningTasks int) []PerformerMetadata {
+	oi.mu.Lock()
+	defer oi.mu.Unlock()
+
+	var performers []PerformerMetadata
+	for _, slot := range []*oneOffImage{oi.current, oi.next} {
+		if slot == nil {
+			continue
+		}
+		metadata := PerformerMetadata{
+			PerformerID:        slot.performerID,
+			AvsAddress:         oi.avsAddress,
+			Status:             slot.status,
+			ArtifactRegistry:   slot.image.Repository,
+			ArtifactTag:        slot.image.Tag,
+			ArtifactDigest:     slot.image.Digest,
+			ContainerHealthy:   true,
+			ApplicationHealthy: true,
+			LastHealthCheck:    time.Now(),
+		}
+		if slot == oi.current {
+			metadata.Replicas = runningTasks
+			metadata.HealthyReplicas = runningTasks
+		}
+		performers = append(performers, metadata)
+	}
+	return performers
+}
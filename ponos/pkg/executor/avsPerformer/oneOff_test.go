@@ -0,0 +1,104 @@
+package avsPerformer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOneOffTaskTimeout(t *testing.T) {
+	assert.Equal(t, DefaultOneOffTaskTimeout, (&AvsPerformerConfig{}).OneOffTaskTimeout())
+	assert.Equal(t, 30*time.Second, (&AvsPerformerConfig{TaskTimeout: 30 * time.Second}).OneOffTaskTimeout())
+}
+
+func TestOneOffResourceLimits(t *testing.T) {
+	cpu, memory, err := (&AvsPerformerConfig{CPULimit: "500m", MemoryLimit: "512Mi"}).OneOffResourceLimits()
+	require.NoError(t, err)
+	assert.Equal(t, int64(500), cpu.MilliValue())
+	assert.Equal(t, int64(512*1024*1024), memory.Value())
+
+	cpu, memory, err = (&AvsPerformerConfig{}).OneOffResourceLimits()
+	require.NoError(t, err)
+	assert.True(t, cpu.IsZero())
+	assert.True(t, memory.IsZero())
+
+	_, _, err = (&AvsPerformerConfig{MemoryLimit: "lots"}).OneOffResourceLimits()
+	assert.Error(t, err)
+}
+
+func TestOneOffImages(t *testing.T) {
+	image := PerformerImage{Repository: "avs", Tag: "v1"}
+
+	t.Run("has no image until one is deployed", func(t *testing.T) {
+		images := NewOneOffImages("0xavs")
+		_, _, ok := images.Current()
+		assert.False(t, ok)
+		assert.Empty(t, images.List(0))
+	})
+
+	t.Run("stages and promotes an image", func(t *testing.T) {
+		images := NewOneOffImages("0xavs")
+		result, err := images.Stage(image)
+		require.NoError(t, err)
+
+		event := <-result.StatusChan
+		assert.Equal(t, PerformerHealthy, event.Status)
+
+		_, _, ok := images.Current()
+		assert.False(t, ok)
+
+		require.NoError(t, images.Promote(result.PerformerId))
+		performerID, current, ok := images.Current()
+		assert.True(t, ok)
+		assert.Equal(t, result.PerformerId, performerID)
+		assert.Equal(t, image, current)
+
+		// Promoting the current image again is a no-op
+		assert.NoError(t, images.Promote(result.PerformerId))
+	})
+
+	t.Run("rejects a second staged image", func(t *testing.T) {
+		images := NewOneOffImages("0xavs")
+		_, err := images.Stage(image)
+		require.NoError(t, err)
+		_, err = images.Stage(image)
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects promoting an unknown performer", func(t *testing.T) {
+		images := NewOneOffImages("0xavs")
+		assert.Error(t, images.Promote("unknown"))
+	})
+
+	t.Run("deploys and lists the current image with its running tasks", func(t *testing.T) {
+		images := NewOneOffImages("0xavs")
+		result, err := images.Deploy(image)
+		require.NoError(t, err)
+		assert.Equal(t, DeploymentStatusCompleted, result.Status)
+
+		next, err := images.Stage(PerformerImage{Repository: "avs", Tag: "v2"})
+		require.NoError(t, err)
+
+		performers := images.List(2)
+		require.Len(t, performers, 2)
+		assert.Equal(t, result.PerformerId, performers[0].PerformerID)
+		assert.Equal(t, PerformerResourceStatusInService, performers[0].Status)
+		assert.Equal(t, 2, performers[0].Replicas)
+		assert.Equal(t, next.PerformerId, performers[1].PerformerID)
+		assert.Equal(t, PerformerResourceStatusStaged, performers[1].Status)
+		assert.Equal(t, "v2", performers[1].ArtifactTag)
+	})
+
+	t.Run("removes images", func(t *testing.T) {
+		images := NewOneOffImages("0xavs")
+		result, err := images.Deploy(image)
+		require.NoError(t, err)
+
+		require.NoError(t, images.Remove(result.PerformerId))
+		_, _, ok := images.Current()
+		assert.False(t, ok)
+		assert.Error(t, images.Remove(result.PerformerId))
+	})
+}
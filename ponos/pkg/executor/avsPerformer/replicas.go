@@ -0,0 +1,86 @@
+package avsPerformer
+
+import "sync/atomic"
+
+// ReplicaCount returns the number of replicas a performer is deployed with; anything below one means one
+func (c *AvsPerformerConfig) ReplicaCount() int {
+	if c.Replicas < 1 {
+		return 1
+	}
+	return c.Replicas
+}
+
+// ReplicaLoad tracks whether a performer replica is in rotation and how many tasks it is running
+type ReplicaLoad struct {
+	inRotation atomic.Bool
+	inFlight   atomic.Int64
+}
+
+// SetInRotation adds the replica to, or drops it from, the set of replicas that receive tasks
+func (rl *ReplicaLoad) SetInRotation(inRotation bool) {
+	rl.inRotation.Store(inRotation)
+}
+
+// InRotation reports whether the replica is healthy enough to receive tasks
+func (rl *ReplicaLoad) InRotation() bool {
+	return rl.inRotation.Load()
+}
+
+// InFlight returns the number of tasks the replica is running
+func (rl *ReplicaLoad) InFlight() int64 {
+	return rl.inFlight.Load()
+}
+
+// TaskStarted counts a task sent to the replica; call TaskDone once it returns
+func (rl *ReplicaLoad) TaskStarted() {
+	rl.inFlight.Add(1)
+}
+
+// TaskDone counts a task of the replica as finished
+func (rl *ReplicaLoad) TaskDone() {
+	rl.inFlight.Add(-1)
+}
+
+// BalancedReplica is a performer replica that tasks can be spread across
+type BalancedReplica interface {
+	Load() *ReplicaLoad
+}
+
+// ReplicaBalancer spreads tasks across the replicas of a performer
+type ReplicaBalancer struct {
+	rotation atomic.Uint64
+}
+
+// PickReplica returns the replica in rotation with the fewest tasks in flight. Ties are broken
+// round-robin so idle replicas share sequential tasks. If no replica is in rotation, every
+// replica is considered, so a performer whose health is not known yet still receives tasks.
+// The second return value is false if there are no replicas.
+func PickReplica[R BalancedReplica](balancer *ReplicaBalancer, replicas []R) (R, bool) {
+	var picked R
+	if len(replicas) == 0 {
+		return picked, false
+	}
+
+	inRotationOnly := false
+	for _, replica := range replicas {
+		if replica.Load().InRotation() {
+			inRotationOnly = true
+			break
+		}
+	}
+
+	offset := int(balancer.rotation.Add(1) % uint64(len(replicas)))
+	found := false
+	var fewest int64
+	for i := range replicas {
+		replica := replicas[(offset+i)%len(replicas)]
+		load := replica.Load()
+		if inRotationOnly && !load.InRotation() {
+			continue
+		}
+		if inFlight := load.InFlight(); !found || inFlight < fewest {
+			picked, fewest, found = replica, inFlight, true
+		}
+	}
+	return picked, found
+}
@@ -0,0 +1,99 @@
+package avsPerformer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testReplica struct {
+	name string
+	load ReplicaLoad
+}
+
+func (r *testReplica) Load() *ReplicaLoad {
+	return &r.load
+}
+
+func newTestReplicas(names ...string) []*testReplica {
+	replicas := make([]*testReplica, 0, len(names))
+	for _, name := range names {
+		replica := &testReplica{name: name}
+		replica.load.SetInRotation(true)
+		replicas = append(replicas, replica)
+	}
+	return replicas
+}
+
+func TestReplicaCount(t *testing.T) {
+	assert.Equal(t, 1, (&AvsPerformerConfig{}).ReplicaCount())
+	assert.Equal(t, 1, (&AvsPerformerConfig{Replicas: -2}).ReplicaCount())
+	assert.Equal(t, 3, (&AvsPerformerConfig{Replicas: 3}).ReplicaCount())
+}
+
+func TestPickReplica(t *testing.T) {
+	t.Run("picks the replica with the fewest tasks in flight", func(t *testing.T) {
+		replicas := newTestReplicas("a", "b", "c")
+		replicas[0].load.TaskStarted()
+		replicas[0].load.TaskStarted()
+		replicas[2].load.TaskStarted()
+
+		balancer := &ReplicaBalancer{}
+		for i := 0; i < 5; i++ {
+			picked, ok := PickReplica(balancer, replicas)
+			assert.True(t, ok)
+			assert.Equal(t, "b", picked.name)
+		}
+	})
+
+	t.Run("spreads sequential tasks across idle replicas", func(t *testing.T) {
+		replicas := newTestReplicas("a", "b", "c")
+
+		balancer := &ReplicaBalancer{}
+		picked := map[string]int{}
+		for i := 0; i < 6; i++ {
+			replica, ok := PickReplica(balancer, replicas)
+			assert.True(t, ok)
+			picked[replica.name]++
+		}
+		assert.Equal(t, map[string]int{"a": 2, "b": 2, "c": 2}, picked)
+	})
+
+	t.Run("skips replicas out of rotation", func(t *testing.T) {
+		replicas := newTestReplicas("a", "b", "c")
+		replicas[1].load.SetInRotation(false)
+		replicas[0].load.TaskStarted()
+		replicas[2].load.TaskStarted()
+
+		balancer := &ReplicaBalancer{}
+		for i := 0; i < 5; i++ {
+			picked, ok := PickReplica(balancer, replicas)
+			assert.True(t, ok)
+			assert.NotEqual(t, "b", picked.name)
+		}
+	})
+
+	t.Run("falls back to every replica if none is in rotation", func(t *testing.T) {
+		replicas := newTestReplicas("a", "b")
+		replicas[0].load.SetInRotation(false)
+		replicas[1].load.SetInRotation(false)
+		replicas[0].load.TaskStarted()
+
+		picked, ok := PickReplica(&ReplicaBalancer{}, replicas)
+		assert.True(t, ok)
+		assert.Equal(t, "b", picked.name)
+	})
+
+	t.Run("returns false without replicas", func(t *testing.T) {
+		_, ok := PickReplica(&ReplicaBalancer{}, []*testReplica{})
+		assert.False(t, ok)
+	})
+
+	t.Run("releases in-flight tasks when they finish", func(t *testing.T) {
+		replica := newTestReplicas("a")[0]
+		replica.load.TaskStarted()
+		replica.load.TaskStarted()
+		replica.load.TaskDone()
+		assert.Equal(t, int64(1), replica.load.InFlight())
+	})
+}
@@ -0,0 +1,168 @@
+package executor
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contractCaller"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/peering"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/util"
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/sync/singleflight"
+)
+
+// defaultChainStateCacheSize is the number of entries kept per kind of chain state
+const defaultChainStateCacheSize = 1024
+
+const (
+	cacheNameAvsConfig   = "avs_config"
+	cacheNameOperatorSet = "operator_set"
+	cacheNameCurveType   = "curve_type"
+)
+
+type avsConfigCacheKey struct {
+	avsAddress  string
+	blockNumber uint64
+}
+
+type operatorSetCacheKey struct {
+	operatorAddress string
+	avsAddress      string
+	operatorSetId   uint32
+	blockNumber     uint64
+}
+
+type curveTypeCacheKey struct {
+	avsAddress    string
+	operatorSetId uint32
+	blockNumber   uint64
+}
+
+// chainStateCache is a read-through cache of the L1 state a task is validated and signed
+// against. Everything it caches is read at the task's block number, so an entry never goes
+// stale; reads at block 0 (the latest block) are passed straight through. Concurrent misses
+// for the same key share a single fetch.
+type chainStateCache struct {
+	contractCaller contractCaller.IContractCaller
+
+	mu           sync.Mutex
+	avsConfigs   *util.LruCache[avsConfigCacheKey, *contractCaller.AVSConfig]
+	operatorSets *util.LruCache[operatorSetCacheKey, *peering.OperatorSet]
+	curveTypes   *util.LruCache[curveTypeCacheKey, config.CurveType]
+
+	fetches singleflight.Group
+}
+
+func newChainStateCache(cc contractCaller.IContractCaller, maxEntries int) *chainStateCache {
+	if maxEntries <= 0 {
+		maxEntries = defaultChainStateCacheSize
+	}
+	return &chainStateCache{
+		contractCaller: cc,
+		avsConfigs:     util.NewLruCache[avsConfigCacheKey, *contractCaller.AVSConfig](maxEntries),
+		operatorSets:   util.NewLruCache[operatorSetCacheKey, *peering.OperatorSet](maxEntries),
+		curveTypes:     util.NewLruCache[curveTypeCacheKey, config.CurveType](maxEntries),
+	}
+}
+
+// GetAVSConfig returns the AVS config at the block
+func (c *chainStateCache) GetAVSConfig(avsAddress string, blockNumber uint64) (*contractCaller.AVSConfig, error) {
+	if blockNumber == 0 {
+		return c.contractCaller.GetAVSConfig(avsAddress, blockNumber)
+	}
+	key := avsConfigCacheKey{avsAddress: strings.ToLower(avsAddress), blockNumber: blockNumber}
+	return readThrough(c, c.avsConfigs, cacheNameAvsConfig, key.avsAddress, key, func() (*contractCaller.AVSConfig, error) {
+		return c.contractCaller.GetAVSConfig(avsAddress, blockNumber)
+	})
+}
+
+// GetOperatorSetDetailsForOperator returns the operator's registration in the operator set at
+// the block. The curve type it carries is cached for GetOperatorSetCurveType as well.
+func (c *chainStateCache) GetOperatorSetDetailsForOperator(
+	operatorAddress common.Address,
+	avsAddress string,
+	operatorSetId uint32,
+	blockNumber uint64,
+) (*peering.OperatorSet, error) {
+	if blockNumber == 0 {
+		return c.contractCaller.GetOperatorSetDetailsForOperator(operatorAddress, avsAddress, operatorSetId, blockNumber)
+	}
+	key := operatorSetCacheKey{
+		operatorAddress: strings.ToLower(operatorAddress.Hex()),
+		avsAddress:      strings.ToLower(avsAddress),
+		operatorSetId:   operatorSetId,
+		blockNumber:     blockNumber,
+	}
+	return readThrough(c, c.operatorSets, cacheNameOperatorSet, key.avsAddress, key, func() (*peering.OperatorSet, error) {
+		opSet, err := c.contractCaller.GetOperatorSetDetailsForOperator(operatorAddress, avsAddress, operatorSetId, blockNumber)
+		if err != nil || opSet == nil {
+			return opSet, err
+		}
+		// the registration carries the operator set's curve type, which saves signing a lookup
+		if opSet.CurveType == config.CurveTypeBN254 || opSet.CurveType == config.CurveTypeECDSA {
+			c.mu.Lock()
+			c.curveTypes.Put(curveTypeCacheKey{avsAddress: key.avsAddress, operatorSetId: operatorSetId, blockNumber: blockNumber}, opSet.CurveType)
+			c.mu.Unlock()
+		}
+		return opSet, nil
+	})
+}
+
+// GetOperatorSetCurveType returns the curve type of the operator set at the block
+func (c *chainStateCache) GetOperatorSetCurveType(avsAddress string, operatorSetId uint32, blockNumber uint64) (config.CurveType, error) {
+	if blockNumber == 0 {
+		return c.contractCaller.GetOperatorSetCurveType(avsAddress, operatorSetId, blockNumber)
+	}
+	key := curveTypeCacheKey{avsAddress: strings.ToLower(avsAddress), operatorSetId: operatorSetId, blockNumber: blockNumber}
+	return readThrough(c, c.curveTypes, cacheNameCurveType, key.avsAddress, key, func() (config.CurveType, error) {
+		return c.contractCaller.GetOperatorSetCurveType(avsAddress, operatorSetId, blockNumber)
+	})
+}
+
+// readThrough returns the cached value for the key, calling fetch on a miss. Failed fetches
+// are not cached.
+func readThrough[K comparable, V any](c *chainStateCache, cache *util.LruCache[K, V], cacheName string, avsAddress string, key K, fetch func() (V, error)) (V, error) {
+	c.mu.Lock()
+	value, ok := cache.Get(key)
+	c.mu.Unlock()
+	if ok {
+		recordChainStateCacheRequest(avsAddress, cacheName, true)
+		return value, nil
+	}
+	recordChainStateCacheRequest(avsAddress, cacheName, false)
+
+	res, err, _ := c.fetches.Do(fmt.Sprintf("%s:%v", cacheName, key), func() (interface{}, error) {
+		// another fetch may have filled the entry since the lookup above
+		c.mu.Lock()
+		value, ok := cache.Get(key)
+		c.mu.Unlock()
+		if ok {
+			return value, nil
+		}
+
+		value, err := fetch()
+		if err != nil {
+			return value, err
+		}
+		c.mu.Lock()
+		cache.Put(key, value)
+		c.mu.Unlock()
+		return value, nil
+	})
+	if err != nil {
+		var zero V
+		return zero, err
+	}
+	return res.(V), nil
+}
+
+func recordChainStateCacheRequest(avsAddress string, cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	metrics.ExecutorChainStateCacheRequests.WithLabelValues(avsAddress, cache, result).Inc()
+}
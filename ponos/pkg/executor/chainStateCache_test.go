@@ -0,0 +1,103 @@
+package executor
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/mocks"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contractCaller"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/peering"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testCacheAvsAddress = "0xAbCdEf0000000000000000000000000000000000"
+	testCacheOperator   = "0x00000000000000000000000000000000000000aa"
+)
+
+func newTestChainStateCache(t *testing.T) (*chainStateCache, *mocks.MockIContractCaller) {
+	cc := mocks.NewMockIContractCaller(gomock.NewController(t))
+	return newChainStateCache(cc, 0), cc
+}
+
+func Test_ChainStateCache(t *testing.T) {
+	t.Run("reads the AVS config once per block", func(t *testing.T) {
+		cache, cc := newTestChainStateCache(t)
+		avsConfig := &contractCaller.AVSConfig{AggregatorOperatorSetId: 0, ExecutorOperatorSetIds: []uint32{1}}
+		cc.EXPECT().GetAVSConfig(testCacheAvsAddress, uint64(100)).Return(avsConfig, nil).Times(1)
+		cc.EXPECT().GetAVSConfig(testCacheAvsAddress, uint64(101)).Return(avsConfig, nil).Times(1)
+
+		for _, block := range []uint64{100, 100, 101, 100} {
+			res, err := cache.GetAVSConfig(testCacheAvsAddress, block)
+			require.NoError(t, err)
+			assert.Same(t, avsConfig, res)
+		}
+	})
+
+	t.Run("shares a fetch between concurrent misses", func(t *testing.T) {
+		cache, cc := newTestChainStateCache(t)
+		opSet := &peering.OperatorSet{OperatorSetID: 1, CurveType: config.CurveTypeECDSA}
+		cc.EXPECT().
+			GetOperatorSetDetailsForOperator(common.HexToAddress(testCacheOperator), testCacheAvsAddress, uint32(1), uint64(100)).
+			Return(opSet, nil).
+			Times(1)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				res, err := cache.GetOperatorSetDetailsForOperator(common.HexToAddress(testCacheOperator), testCacheAvsAddress, 1, 100)
+				assert.NoError(t, err)
+				assert.Same(t, opSet, res)
+			}()
+		}
+		wg.Wait()
+	})
+
+	t.Run("takes the curve type from a fetched operator set", func(t *testing.T) {
+		cache, cc := newTestChainStateCache(t)
+		cc.EXPECT().
+			GetOperatorSetDetailsForOperator(gomock.Any(), testCacheAvsAddress, uint32(1), uint64(100)).
+			Return(&peering.OperatorSet{OperatorSetID: 1, CurveType: config.CurveTypeBN254}, nil).
+			Times(1)
+		cc.EXPECT().GetOperatorSetCurveType(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := cache.GetOperatorSetDetailsForOperator(common.HexToAddress(testCacheOperator), testCacheAvsAddress, 1, 100)
+		require.NoError(t, err)
+
+		curveType, err := cache.GetOperatorSetCurveType(testCacheAvsAddress, 1, 100)
+		require.NoError(t, err)
+		assert.Equal(t, config.CurveTypeBN254, curveType)
+	})
+
+	t.Run("does not cache failed reads", func(t *testing.T) {
+		cache, cc := newTestChainStateCache(t)
+		gomock.InOrder(
+			cc.EXPECT().GetOperatorSetCurveType(testCacheAvsAddress, uint32(1), uint64(100)).Return(config.CurveTypeUnknown, assert.AnError),
+			cc.EXPECT().GetOperatorSetCurveType(testCacheAvsAddress, uint32(1), uint64(100)).Return(config.CurveTypeECDSA, nil),
+		)
+
+		_, err := cache.GetOperatorSetCurveType(testCacheAvsAddress, 1, 100)
+		require.ErrorIs(t, err, assert.AnError)
+		for i := 0; i < 3; i++ {
+			curveType, err := cache.GetOperatorSetCurveType(testCacheAvsAddress, 1, 100)
+			require.NoError(t, err)
+			assert.Equal(t, config.CurveTypeECDSA, curveType)
+		}
+	})
+
+	t.Run("passes reads of the latest block through", func(t *testing.T) {
+		cache, cc := newTestChainStateCache(t)
+		cc.EXPECT().GetAVSConfig(testCacheAvsAddress, uint64(0)).Return(&contractCaller.AVSConfig{}, nil).Times(2)
+
+		for i := 0; i < 2; i++ {
+			_, err := cache.GetAVSConfig(testCacheAvsAddress, 0)
+			require.NoError(t, err)
+		}
+	})
+}
@@ -17,14 +17,19 @@ import (
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/executor/executorConfig"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/executor/storage"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/kubernetesManager"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/peering"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/rpcServer"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/signer"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/util"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const performerHealthReportInterval = 10 * time.Second
+
 type Executor struct {
 	logger              *zap.Logger
 	config              *executorConfig.ExecutorConfig
@@ -42,6 +47,12 @@ type Executor struct {
 	store storage.ExecutorStore
 
 	authVerifier *auth.Verifier
+
+	// chainState caches the L1 state tasks are validated and signed against
+	chainState *chainStateCache
+
+	// admissions holds the admission limits of each configured AVS, keyed by lowercased address
+	admissions map[string]*taskAdmission
 }
 
 func NewExecutorWithRpcServers(
@@ -104,6 +115,12 @@ func NewExecutor(
 		verifier = auth.NewVerifier(tokenManager, authSigner)
 	}
 
+	admissions := make(map[string]*taskAdmission, len(config.AvsPerformers))
+	for _, avs := range config.AvsPerformers {
+		avsAddress := strings.ToLower(avs.AvsAddress)
+		admissions[avsAddress] = newTaskAdmission(avsAddress, avs.Admission)
+	}
+
 	return &Executor{
 		logger:              logger,
 		config:              config,
@@ -117,10 +134,16 @@ func NewExecutor(
 		l1ContractCaller:    l1ContractCaller,
 		store:               store,
 		authVerifier:        verifier,
+		chainState:          newChainStateCache(l1ContractCaller, defaultChainStateCacheSize),
+		admissions:          admissions,
 	}
 }
 
 func (e *Executor) Initialize(ctx context.Context) error {
+	if err := e.verifyTaskMessageHash(ctx); err != nil {
+		return err
+	}
+
 	e.logger.Sugar().Infow("Initializing AVS performers")
 
 	if err := e.rehydratePerformersFromStorage(ctx); err != nil {
@@ -217,6 +240,48 @@ func (e *Executor) Initialize(ctx context.Context) error {
 				)
 			}
 
+		case string(avsPerformer.AvsProcessTypeOneOff):
+			performer, err := e.createPerformer(avs, avsAddress)
+			if err != nil {
+				return fmt.Errorf("failed to create AVS performer for %s: %v", avs.ProcessType, err)
+			}
+
+			if err := performer.Initialize(ctx); err != nil {
+				return err
+			}
+
+			// One-off performers start a container per task, so deploying only selects the image
+			// and there is no running performer state to persist
+			if avs.Image != nil {
+				var serviceAccountName string
+				if avs.Kubernetes != nil && avs.Kubernetes.ServiceAccountName != "" {
+					serviceAccountName = avs.Kubernetes.ServiceAccountName
+				}
+
+				result, err := performer.Deploy(ctx, avsPerformer.PerformerImage{
+					Repository:         avs.Image.Repository,
+					Tag:                avs.Image.Tag,
+					Envs:               avs.Envs,
+					ServiceAccountName: serviceAccountName,
+				})
+				if err != nil {
+					e.logger.Sugar().Errorw("Failed to deploy one-off performer during startup",
+						zap.String("avsAddress", avsAddress),
+						zap.String("deploymentMode", string(avs.DeploymentMode)),
+						zap.Error(err),
+					)
+					return err
+				}
+				e.logger.Sugar().Infow("AVS one-off performer deployed successfully",
+					zap.String("avsAddress", avsAddress),
+					zap.String("deploymentMode", string(avs.DeploymentMode)),
+					zap.String("deploymentId", result.Id),
+					zap.String("performerId", result.PerformerId),
+				)
+			}
+
+			e.avsPerformers.Store(avsAddress, performer)
+
 		default:
 			e.logger.Sugar().Errorw("Unsupported AVS performer process type",
 				zap.String("avsAddress", avsAddress),
@@ -382,14 +447,18 @@ func (e *Executor) createPerformer(avs *executorConfig.AvsPerformerConfig, avsAd
 
 // createDockerPerformer creates a Docker-based AVS performer
 func (e *Executor) createDockerPerformer(avs *executorConfig.AvsPerformerConfig, avsAddress string) (avsPerformer.IAvsPerformer, error) {
-	return avsContainerPerformer.NewAvsContainerPerformer(
-		&avsPerformer.AvsPerformerConfig{
-			AvsAddress:           avsAddress,
-			ProcessType:          avsPerformer.AvsProcessType(avs.ProcessType),
-			PerformerNetworkName: e.config.PerformerNetworkName,
-		},
-		e.logger,
-	)
+	performerConfig := &avsPerformer.AvsPerformerConfig{
+		AvsAddress:           avsAddress,
+		ProcessType:          avsPerformer.AvsProcessType(avs.ProcessType),
+		PerformerNetworkName: e.config.PerformerNetworkName,
+		Replicas:             avs.Replicas,
+	}
+
+	if performerConfig.ProcessType == avsPerformer.AvsProcessTypeOneOff {
+		applyOneOffConfig(performerConfig, avs.OneOff)
+		return avsContainerPerformer.NewAvsContainerOneOffPerformer(performerConfig, e.logger)
+	}
+	return avsContainerPerformer.NewAvsContainerPerformer(performerConfig, e.logger)
 }
 
 // createKubernetesPerformer creates a Kubernetes-based AVS performer
@@ -412,15 +481,28 @@ func (e *Executor) createKubernetesPerformer(avs *executorConfig.AvsPerformerCon
 		endpointOverride = avs.Kubernetes.EndpointOverride
 	}
 
-	return avsKubernetesPerformer.NewAvsKubernetesPerformer(
-		&avsPerformer.AvsPerformerConfig{
-			AvsAddress:       avsAddress,
-			ProcessType:      avsPerformer.AvsProcessType(avs.ProcessType),
-			EndpointOverride: endpointOverride,
-		},
-		kubernetesConfig,
-		e.logger,
-	)
+	performerConfig := &avsPerformer.AvsPerformerConfig{
+		AvsAddress:       avsAddress,
+		ProcessType:      avsPerformer.AvsProcessType(avs.ProcessType),
+		EndpointOverride: endpointOverride,
+		Replicas:         avs.Replicas,
+	}
+
+	if performerConfig.ProcessType == avsPerformer.AvsProcessTypeOneOff {
+		applyOneOffConfig(performerConfig, avs.OneOff)
+		return avsKubernetesPerformer.NewAvsKubernetesJobPerformer(performerConfig, kubernetesConfig, e.logger)
+	}
+	return avsKubernetesPerformer.NewAvsKubernetesPerformer(performerConfig, kubernetesConfig, e.logger)
+}
+
+// applyOneOffConfig copies the task timeout and resource limits of a one-off AVS into its performer config
+func applyOneOffConfig(performerConfig *avsPerformer.AvsPerformerConfig, oneOff *executorConfig.OneOffConfig) {
+	if oneOff == nil {
+		return
+	}
+	performerConfig.TaskTimeout = oneOff.TaskTimeoutDuration()
+	performerConfig.CPULimit = oneOff.CpuLimit
+	performerConfig.MemoryLimit = oneOff.MemoryLimit
 }
 
 func (e *Executor) Run(ctx context.Context) error {
@@ -437,6 +519,64 @@ func (e *Executor) Run(ctx context.Context) error {
 			return fmt.Errorf("failed to start management RPC server: %v", err)
 		}
 	}
+
+	go e.reportPerformerHealth(ctx)
+	return nil
+}
+
+// reportPerformerHealth periodically publishes the health of every performer as a metric
+func (e *Executor) reportPerformerHealth(ctx context.Context) {
+	ticker := time.NewTicker(performerHealthReportInterval)
+	defer ticker.Stop()
+
+	for {
+		e.recordPerformerHealth()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *Executor) recordPerformerHealth() {
+	// reset so that removed performers stop being reported
+	metrics.ExecutorPerformerHealthy.Reset()
+
+	e.avsPerformers.Range(func(key, value interface{}) bool {
+		avsAddress := key.(string)
+		for _, info := range value.(avsPerformer.IAvsPerformer).ListPerformers() {
+			healthy := 0.0
+			if info.ContainerHealthy && info.ApplicationHealthy {
+				healthy = 1
+			}
+			metrics.ExecutorPerformerHealthy.WithLabelValues(avsAddress, info.PerformerID).Set(healthy)
+		}
+		return true
+	})
+}
+
+// verifyTaskMessageHash checks that the task message hash computed locally when signing results
+// matches the one the TaskMailbox computes, so a changed hashing scheme fails startup instead of
+// every signature
+func (e *Executor) verifyTaskMessageHash(ctx context.Context) error {
+	taskHash := util.GetKeccak256Digest([]byte("hourglass-executor-message-hash-check"))
+	result := []byte("message hash check")
+
+	expected, err := e.l1ContractCaller.CalculateTaskMessageHash(ctx, taskHash, result)
+	if err != nil {
+		return fmt.Errorf("failed to get task message hash from the TaskMailbox: %w", err)
+	}
+
+	actual, err := util.CalculateTaskMessageHash(taskHash, result)
+	if err != nil {
+		return fmt.Errorf("failed to calculate task message hash: %w", err)
+	}
+
+	if actual != expected {
+		return fmt.Errorf("task message hash mismatch: computed %s, TaskMailbox returned %s",
+			hexutil.Encode(actual[:]), hexutil.Encode(expected[:]))
+	}
 	return nil
 }
 
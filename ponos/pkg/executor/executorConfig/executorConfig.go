@@ -8,7 +8,9 @@ import (
 	"time"
 
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
 	"github.com/spf13/viper"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/yaml"
 )
@@ -151,6 +153,98 @@ func (apc *AvsPerformerKubernetesConfig) Validate() error {
 	return nil
 }
 
+// OneOffConfig configures performers of the one-off process type, which run every task in a fresh
+// container or Kubernetes Job
+type OneOffConfig struct {
+	// TaskTimeout is how long a task may take, including starting its container, e.g. "2m"; defaults to 5m
+	TaskTimeout string `json:"taskTimeout,omitempty" yaml:"taskTimeout,omitempty"`
+
+	// CpuLimit and MemoryLimit cap the resources of a task's container, as Kubernetes quantities
+	// such as "500m" and "512Mi"
+	CpuLimit    string `json:"cpuLimit,omitempty" yaml:"cpuLimit,omitempty"`
+	MemoryLimit string `json:"memoryLimit,omitempty" yaml:"memoryLimit,omitempty"`
+}
+
+func (oc *OneOffConfig) Validate() error {
+	var allErrors field.ErrorList
+	if oc.TaskTimeout != "" {
+		if timeout, err := time.ParseDuration(oc.TaskTimeout); err != nil {
+			allErrors = append(allErrors, field.Invalid(field.NewPath("taskTimeout"), oc.TaskTimeout, err.Error()))
+		} else if timeout <= 0 {
+			allErrors = append(allErrors, field.Invalid(field.NewPath("taskTimeout"), oc.TaskTimeout, "taskTimeout must be positive"))
+		}
+	}
+	if oc.CpuLimit != "" {
+		if _, err := resource.ParseQuantity(oc.CpuLimit); err != nil {
+			allErrors = append(allErrors, field.Invalid(field.NewPath("cpuLimit"), oc.CpuLimit, err.Error()))
+		}
+	}
+	if oc.MemoryLimit != "" {
+		if _, err := resource.ParseQuantity(oc.MemoryLimit); err != nil {
+			allErrors = append(allErrors, field.Invalid(field.NewPath("memoryLimit"), oc.MemoryLimit, err.Error()))
+		}
+	}
+	if len(allErrors) > 0 {
+		return allErrors.ToAggregate()
+	}
+	return nil
+}
+
+// TaskTimeoutDuration returns the parsed task timeout, or zero if none is set
+func (oc *OneOffConfig) TaskTimeoutDuration() time.Duration {
+	timeout, err := time.ParseDuration(oc.TaskTimeout)
+	if err != nil {
+		return 0
+	}
+	return timeout
+}
+
+// AdmissionConfig limits the tasks of an AVS that an executor accepts, so a burst from one AVS
+// cannot starve the others or overload its performer
+type AdmissionConfig struct {
+	// MaxConcurrentTasks is how many tasks may run at once; 0 means unlimited
+	MaxConcurrentTasks int `json:"maxConcurrentTasks,omitempty" yaml:"maxConcurrentTasks,omitempty"`
+
+	// QueueDepth is how many tasks may wait for one of the running tasks to finish. Tasks that
+	// arrive while the queue is full are rejected right away.
+	QueueDepth int `json:"queueDepth,omitempty" yaml:"queueDepth,omitempty"`
+
+	// TaskTimeout bounds both the time a task may wait in the queue and the time it may run once
+	// admitted, e.g. "30s". Tasks still queued when it passes are rejected; no timeout is applied
+	// if not set.
+	TaskTimeout string `json:"taskTimeout,omitempty" yaml:"taskTimeout,omitempty"`
+}
+
+func (ac *AdmissionConfig) Validate() error {
+	var allErrors field.ErrorList
+	if ac.MaxConcurrentTasks < 0 {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("maxConcurrentTasks"), ac.MaxConcurrentTasks, "maxConcurrentTasks must not be negative"))
+	}
+	if ac.QueueDepth < 0 {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("queueDepth"), ac.QueueDepth, "queueDepth must not be negative"))
+	}
+	if ac.TaskTimeout != "" {
+		if timeout, err := time.ParseDuration(ac.TaskTimeout); err != nil {
+			allErrors = append(allErrors, field.Invalid(field.NewPath("taskTimeout"), ac.TaskTimeout, err.Error()))
+		} else if timeout <= 0 {
+			allErrors = append(allErrors, field.Invalid(field.NewPath("taskTimeout"), ac.TaskTimeout, "taskTimeout must be positive"))
+		}
+	}
+	if len(allErrors) > 0 {
+		return allErrors.ToAggregate()
+	}
+	return nil
+}
+
+// TaskTimeoutDuration returns the parsed task timeout, or zero if none is set
+func (ac *AdmissionConfig) TaskTimeoutDuration() time.Duration {
+	timeout, err := time.ParseDuration(ac.TaskTimeout)
+	if err != nil {
+		return 0
+	}
+	return timeout
+}
+
 type AvsPerformerConfig struct {
 	Image          *PerformerImage
 	ProcessType    string
@@ -158,6 +252,15 @@ type AvsPerformerConfig struct {
 	Envs           []config.AVSPerformerEnv
 	DeploymentMode DeploymentMode                `json:"deploymentMode" yaml:"deploymentMode"`
 	Kubernetes     *AvsPerformerKubernetesConfig `json:"kubernetes,omitempty" yaml:"kubernetes,omitempty"`
+
+	// Replicas is the number of identical performers tasks are spread across; defaults to 1
+	Replicas int `json:"replicas,omitempty" yaml:"replicas,omitempty"`
+
+	// OneOff configures the task containers of the one-off process type
+	OneOff *OneOffConfig `json:"oneOff,omitempty" yaml:"oneOff,omitempty"`
+
+	// Admission limits how many of the AVS's tasks run and wait at once; unlimited if not set
+	Admission *AdmissionConfig `json:"admission,omitempty" yaml:"admission,omitempty"`
 }
 
 func (ap *AvsPerformerConfig) Validate() error {
@@ -180,6 +283,10 @@ func (ap *AvsPerformerConfig) Validate() error {
 		}
 	}
 
+	if ap.Replicas < 0 {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("replicas"), ap.Replicas, "replicas must not be negative"))
+	}
+
 	// Validate deployment mode - default to docker if not specified
 	if ap.DeploymentMode == "" {
 		ap.DeploymentMode = DeploymentModeDocker
@@ -187,6 +294,18 @@ func (ap *AvsPerformerConfig) Validate() error {
 		allErrors = append(allErrors, field.Invalid(field.NewPath("deploymentMode"), ap.DeploymentMode, "deploymentMode must be one of [docker, kubernetes]"))
 	}
 
+	if ap.OneOff != nil {
+		if err := ap.OneOff.Validate(); err != nil {
+			allErrors = append(allErrors, field.Invalid(field.NewPath("oneOff"), ap.OneOff, err.Error()))
+		}
+	}
+
+	if ap.Admission != nil {
+		if err := ap.Admission.Validate(); err != nil {
+			allErrors = append(allErrors, field.Invalid(field.NewPath("admission"), ap.Admission, err.Error()))
+		}
+	}
+
 	// Validate Kubernetes config if in Kubernetes mode
 	if ap.DeploymentMode == DeploymentModeKubernetes && ap.Kubernetes != nil {
 		if err := ap.Kubernetes.Validate(); err != nil {
@@ -203,14 +322,39 @@ func (ap *AvsPerformerConfig) Validate() error {
 type Chain struct {
 	RpcUrl  string         `json:"rpcUrl" yaml:"rpcUrl"`
 	ChainId config.ChainId `json:"chainId" yaml:"chainId"`
+
+	// RpcUrls are additional endpoints. Contract calls and transactions go to the fastest
+	// healthy endpoint and fail over to the others when it errors
+	RpcUrls []string `json:"rpcUrls,omitempty" yaml:"rpcUrls,omitempty"`
+
+	// ChainDefinition declares the role and EigenLayer contract addresses of chains
+	// ponos has no built-in addresses for, or overrides the built-in ones
+	config.ChainDefinition `json:",inline" yaml:",inline"`
+}
+
+// RpcEndpoints returns every configured RPC endpoint, rpcUrl first
+func (c *Chain) RpcEndpoints() []string {
+	endpoints := make([]string, 0, len(c.RpcUrls)+1)
+	for _, u := range append([]string{c.RpcUrl}, c.RpcUrls...) {
+		if u != "" && !slices.Contains(endpoints, u) {
+			endpoints = append(endpoints, u)
+		}
+	}
+	return endpoints
 }
 
 // StorageConfig contains configuration for the storage layer
 type StorageConfig struct {
 	Type         string        `json:"type" yaml:"type"` // "memory" or "badger"
 	BadgerConfig *BadgerConfig `json:"badger,omitempty" yaml:"badger,omitempty"`
+
+	// ResultTTL is how long signed task results are kept to answer retried submissions, e.g. "1h"
+	ResultTTL string `json:"resultTtl,omitempty" yaml:"resultTtl,omitempty"`
 }
 
+// DefaultResultTTL is how long signed task results are kept when storage.resultTtl is not set
+const DefaultResultTTL = time.Hour
+
 // BadgerConfig contains configuration for BadgerDB storage
 type BadgerConfig struct {
 	// Directory where BadgerDB will store its data
@@ -247,6 +391,14 @@ func (sc *StorageConfig) Validate() error {
 		}
 	}
 
+	if sc.ResultTTL != "" {
+		if ttl, err := time.ParseDuration(sc.ResultTTL); err != nil {
+			allErrors = append(allErrors, field.Invalid(field.NewPath("resultTtl"), sc.ResultTTL, err.Error()))
+		} else if ttl <= 0 {
+			allErrors = append(allErrors, field.Invalid(field.NewPath("resultTtl"), sc.ResultTTL, "resultTtl must be positive"))
+		}
+	}
+
 	if len(allErrors) > 0 {
 		return allErrors.ToAggregate()
 	}
@@ -266,6 +418,19 @@ type ExecutorConfig struct {
 	Kubernetes               *KubernetesConfig         `json:"kubernetes,omitempty" yaml:"kubernetes,omitempty"`
 	Storage                  *StorageConfig            `json:"storage,omitempty" yaml:"storage,omitempty"`
 	AuthConfig               *auth.Config              `json:"authentication,omitempty" yaml:"authentication,omitempty"`
+	Metrics                  *metrics.Config           `json:"metrics,omitempty" yaml:"metrics,omitempty"`
+}
+
+// TaskResultTTL returns how long signed task results are kept to answer retried submissions
+func (ec *ExecutorConfig) TaskResultTTL() time.Duration {
+	if ec.Storage == nil || ec.Storage.ResultTTL == "" {
+		return DefaultResultTTL
+	}
+	ttl, err := time.ParseDuration(ec.Storage.ResultTTL)
+	if err != nil || ttl <= 0 {
+		return DefaultResultTTL
+	}
+	return ttl
 }
 
 func (ec *ExecutorConfig) Validate() error {
@@ -311,8 +476,18 @@ func (ec *ExecutorConfig) Validate() error {
 	if ec.L1Chain == nil {
 		allErrors = append(allErrors, field.Required(field.NewPath("l1Chain"), "l1Chain is required"))
 	} else {
-		if ec.L1Chain.RpcUrl == "" {
-			allErrors = append(allErrors, field.Required(field.NewPath("chain.rpcUrl"), "rpcUrl is required"))
+		if len(ec.L1Chain.RpcEndpoints()) == 0 {
+			allErrors = append(allErrors, field.Required(field.NewPath("chain.rpcUrl"), "rpcUrl or rpcUrls is required"))
+		}
+		if ec.L1Chain.ChainId == 0 {
+			allErrors = append(allErrors, field.Required(field.NewPath("l1Chain.chainId"), "chainId is required"))
+		} else {
+			if chainErrors := ec.L1Chain.ChainDefinition.Validate(ec.L1Chain.ChainId); len(chainErrors) > 0 {
+				allErrors = append(allErrors, field.Invalid(field.NewPath("l1Chain"), ec.L1Chain, chainErrors.ToAggregate().Error()))
+			}
+			if ec.L1Chain.RoleForChain(ec.L1Chain.ChainId) != config.ChainRoleL1 {
+				allErrors = append(allErrors, field.Invalid(field.NewPath("l1Chain.chainId"), ec.L1Chain.ChainId, "l1Chain must be a chain with the l1 role"))
+			}
 		}
 	}
 
@@ -323,6 +498,12 @@ func (ec *ExecutorConfig) Validate() error {
 		}
 	}
 
+	if ec.Metrics != nil {
+		if metricsErrors := ec.Metrics.Validate(); len(metricsErrors) > 0 {
+			allErrors = append(allErrors, field.Invalid(field.NewPath("metrics"), ec.Metrics, metricsErrors.ToAggregate().Error()))
+		}
+	}
+
 	if ec.ManagementServerGrpcPort == 0 {
 		ec.ManagementServerGrpcPort = ec.GrpcPort
 	}
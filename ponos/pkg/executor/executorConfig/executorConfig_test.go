@@ -7,6 +7,7 @@ import (
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
 )
 
 func Test_ExecutorConfig(t *testing.T) {
@@ -105,6 +106,149 @@ func TestDeploymentMode(t *testing.T) {
 	})
 }
 
+func TestAvsPerformerReplicas(t *testing.T) {
+	newConfig := func(replicas int) *AvsPerformerConfig {
+		return &AvsPerformerConfig{
+			AvsAddress:  "0x123",
+			ProcessType: "server",
+			Image: &PerformerImage{
+				Repository: "test/image",
+				Tag:        "v1.0.0",
+			},
+			Replicas: replicas,
+		}
+	}
+
+	t.Run("Should accept an unset or positive replica count", func(t *testing.T) {
+		require.NoError(t, newConfig(0).Validate())
+		require.NoError(t, newConfig(3).Validate())
+	})
+
+	t.Run("Should reject a negative replica count", func(t *testing.T) {
+		err := newConfig(-1).Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "replicas must not be negative")
+	})
+
+	t.Run("Should parse replicas from yaml", func(t *testing.T) {
+		var config AvsPerformerConfig
+		require.NoError(t, yaml.Unmarshal([]byte("avsAddress: \"0x123\"\nreplicas: 4\n"), &config))
+		assert.Equal(t, 4, config.Replicas)
+	})
+}
+
+func TestAvsPerformerOneOff(t *testing.T) {
+	newConfig := func(oneOff *OneOffConfig) *AvsPerformerConfig {
+		return &AvsPerformerConfig{
+			AvsAddress:  "0x123",
+			ProcessType: "one-off",
+			Image: &PerformerImage{
+				Repository: "test/image",
+				Tag:        "v1.0.0",
+			},
+			OneOff: oneOff,
+		}
+	}
+
+	t.Run("Should accept an unset or valid one-off config", func(t *testing.T) {
+		require.NoError(t, newConfig(nil).Validate())
+		require.NoError(t, newConfig(&OneOffConfig{TaskTimeout: "90s", CpuLimit: "500m", MemoryLimit: "512Mi"}).Validate())
+	})
+
+	t.Run("Should reject an invalid task timeout", func(t *testing.T) {
+		err := newConfig(&OneOffConfig{TaskTimeout: "soon"}).Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "taskTimeout")
+
+		err = newConfig(&OneOffConfig{TaskTimeout: "-1s"}).Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "taskTimeout must be positive")
+	})
+
+	t.Run("Should reject invalid resource limits", func(t *testing.T) {
+		err := newConfig(&OneOffConfig{CpuLimit: "two cores", MemoryLimit: "lots"}).Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cpuLimit")
+		assert.Contains(t, err.Error(), "memoryLimit")
+	})
+
+	t.Run("Should parse the one-off config from yaml", func(t *testing.T) {
+		var config AvsPerformerConfig
+		require.NoError(t, yaml.Unmarshal([]byte("avsAddress: \"0x123\"\nprocessType: one-off\noneOff:\n  taskTimeout: 2m\n  memoryLimit: 1Gi\n"), &config))
+		require.NotNil(t, config.OneOff)
+		assert.Equal(t, 2*time.Minute, config.OneOff.TaskTimeoutDuration())
+		assert.Equal(t, "1Gi", config.OneOff.MemoryLimit)
+	})
+}
+
+func TestAvsPerformerAdmission(t *testing.T) {
+	newConfig := func(admission *AdmissionConfig) *AvsPerformerConfig {
+		return &AvsPerformerConfig{
+			AvsAddress:  "0x123",
+			ProcessType: "server",
+			Image: &PerformerImage{
+				Repository: "test/image",
+				Tag:        "v1.0.0",
+			},
+			Admission: admission,
+		}
+	}
+
+	t.Run("Should accept an unset or valid admission config", func(t *testing.T) {
+		require.NoError(t, newConfig(nil).Validate())
+		require.NoError(t, newConfig(&AdmissionConfig{MaxConcurrentTasks: 4, QueueDepth: 16, TaskTimeout: "30s"}).Validate())
+	})
+
+	t.Run("Should reject negative limits", func(t *testing.T) {
+		err := newConfig(&AdmissionConfig{MaxConcurrentTasks: -1, QueueDepth: -1}).Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "maxConcurrentTasks must not be negative")
+		assert.Contains(t, err.Error(), "queueDepth must not be negative")
+	})
+
+	t.Run("Should reject an invalid task timeout", func(t *testing.T) {
+		err := newConfig(&AdmissionConfig{TaskTimeout: "soon"}).Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "taskTimeout")
+
+		err = newConfig(&AdmissionConfig{TaskTimeout: "0s"}).Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "taskTimeout must be positive")
+	})
+
+	t.Run("Should parse the admission config from yaml", func(t *testing.T) {
+		var config AvsPerformerConfig
+		require.NoError(t, yaml.Unmarshal([]byte("avsAddress: \"0x123\"\nadmission:\n  maxConcurrentTasks: 2\n  queueDepth: 8\n  taskTimeout: 45s\n"), &config))
+		require.NotNil(t, config.Admission)
+		assert.Equal(t, 2, config.Admission.MaxConcurrentTasks)
+		assert.Equal(t, 8, config.Admission.QueueDepth)
+		assert.Equal(t, 45*time.Second, config.Admission.TaskTimeoutDuration())
+	})
+}
+
+func TestStorageResultTTL(t *testing.T) {
+	t.Run("Should default the result TTL", func(t *testing.T) {
+		assert.Equal(t, DefaultResultTTL, (&ExecutorConfig{}).TaskResultTTL())
+		assert.Equal(t, DefaultResultTTL, (&ExecutorConfig{Storage: &StorageConfig{Type: "memory"}}).TaskResultTTL())
+	})
+
+	t.Run("Should use a configured result TTL", func(t *testing.T) {
+		sc := &StorageConfig{Type: "memory", ResultTTL: "30m"}
+		require.NoError(t, sc.Validate())
+		assert.Equal(t, 30*time.Minute, (&ExecutorConfig{Storage: sc}).TaskResultTTL())
+	})
+
+	t.Run("Should reject an invalid result TTL", func(t *testing.T) {
+		err := (&StorageConfig{Type: "memory", ResultTTL: "forever"}).Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "resultTtl")
+
+		err = (&StorageConfig{Type: "memory", ResultTTL: "0s"}).Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "resultTtl must be positive")
+	})
+}
+
 // TestKubernetesConfig tests the Kubernetes configuration
 func TestKubernetesConfig(t *testing.T) {
 	t.Run("Should create default kubernetes config", func(t *testing.T) {
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/executor/avsPerformer"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/executor/avsPerformer/avsContainerPerformer"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/executor/storage"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/performerTask"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/signer"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
@@ -39,11 +42,19 @@ func (e *Executor) SubmitTask(ctx context.Context, req *executorV1.TaskSubmissio
 
 	err := validateTaskSubmission(req)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	avsAddress := strings.ToLower(req.AvsAddress)
+	metrics.ExecutorTasksReceived.WithLabelValues(avsAddress).Inc()
+	inFlight := metrics.ExecutorTasksInFlight.WithLabelValues(avsAddress)
+	inFlight.Inc()
+	defer inFlight.Dec()
+
+	start := time.Now()
 	res, err := e.handleReceivedTask(ctx, req)
 	if err != nil {
+		metrics.ExecutorTasksFailed.WithLabelValues(avsAddress).Inc()
 		e.logger.Sugar().Errorw("Failed to handle received task",
 			"taskId", req.TaskId,
 			"avsAddress", req.AvsAddress,
@@ -51,6 +62,8 @@ func (e *Executor) SubmitTask(ctx context.Context, req *executorV1.TaskSubmissio
 		)
 		return nil, fmt.Errorf("failed to handle received task: %w", err)
 	}
+	metrics.ExecutorTasksCompleted.WithLabelValues(avsAddress).Inc()
+	metrics.ExecutorTaskDurationSeconds.WithLabelValues(avsAddress).Observe(time.Since(start).Seconds())
 
 	return res, nil
 }
@@ -288,25 +301,11 @@ func (e *Executor) handleReceivedTask(ctx context.Context, task *executorV1.Task
 
 	avsAddress := strings.ToLower(task.GetAvsAddress())
 	if avsAddress == "" {
-		return nil, fmt.Errorf("AVS address is empty")
-	}
-
-	processed, err := e.store.IsTaskProcessed(ctx, task.TaskId)
-	if err != nil {
-		e.logger.Sugar().Warnw("Failed to check if task is processed",
-			"taskId", task.TaskId,
-			"error", err,
-		)
-	} else if processed {
-		e.logger.Sugar().Warnw("Task already processed, skipping",
-			"taskId", task.TaskId,
-			"avsAddress", avsAddress,
-		)
-		return nil, fmt.Errorf("task %s already processed", task.TaskId)
+		return nil, status.Error(codes.InvalidArgument, "AVS address is empty")
 	}
 
 	if task.ExecutorAddress == "" {
-		return nil, fmt.Errorf("executor address is empty")
+		return nil, status.Error(codes.InvalidArgument, "executor address is empty")
 	}
 
 	if !strings.EqualFold(task.ExecutorAddress, e.config.Operator.Address) {
@@ -314,7 +313,7 @@ func (e *Executor) handleReceivedTask(ctx context.Context, task *executorV1.Task
 			"expected", e.config.Operator.Address,
 			"received", task.ExecutorAddress,
 		)
-		return nil, fmt.Errorf("task executor address mismatch: expected %s, got %s",
+		return nil, status.Errorf(codes.PermissionDenied, "task executor address mismatch: expected %s, got %s",
 			e.config.Operator.Address, task.ExecutorAddress)
 	}
 
@@ -327,19 +326,73 @@ func (e *Executor) handleReceivedTask(ctx context.Context, task *executorV1.Task
 			"taskId", task.TaskId,
 			"error", err,
 		)
-		return nil, fmt.Errorf("signature validation failed: %w", err)
+		return nil, status.Errorf(codes.Unauthenticated, "signature validation failed: %v", err)
+	}
+
+	// Retries and submissions from other aggregators get the result signed the first time around
+	inputHash := taskInputHash(task)
+	cached, err := e.store.GetTaskResult(ctx, task.TaskId, inputHash)
+	if err == nil {
+		e.logger.Sugar().Infow("Returning cached result for duplicate task submission",
+			zap.String("taskId", task.TaskId),
+			zap.String("avsAddress", avsAddress),
+			zap.String("aggregatorAddress", task.AggregatorAddress),
+		)
+		return taskResultFromRecord(cached), nil
+	} else if !errors.Is(err, storage.ErrNotFound) {
+		e.logger.Sugar().Warnw("Failed to look up cached task result",
+			"taskId", task.TaskId,
+			"error", err,
+		)
+	}
+
+	processed, err := e.store.IsTaskProcessed(ctx, task.TaskId)
+	if err != nil {
+		e.logger.Sugar().Warnw("Failed to check if task is processed",
+			"taskId", task.TaskId,
+			"error", err,
+		)
+	} else if processed {
+		e.logger.Sugar().Warnw("Task already processed and its result is no longer cached, skipping",
+			"taskId", task.TaskId,
+			"avsAddress", avsAddress,
+		)
+		return nil, status.Errorf(codes.AlreadyExists, "task %s already processed", task.TaskId)
 	}
 
 	value, ok := e.avsPerformers.Load(avsAddress)
 	if !ok {
-		return nil, fmt.Errorf("AVS performer not found for address %s", avsAddress)
+		return nil, status.Errorf(codes.FailedPrecondition, "AVS performer not found for address %s", avsAddress)
 	}
 	avsPerf := value.(avsPerformer.IAvsPerformer)
 
 	pt := performerTask.NewPerformerTaskFromTaskSubmissionProto(task)
-	e.inflightTasks.Store(task.TaskId, task)
+	if _, running := e.inflightTasks.LoadOrStore(task.TaskId, task); running {
+		return nil, status.Errorf(codes.Aborted, "task %s is already running; retry once it completes", task.TaskId)
+	}
 	defer e.inflightTasks.Delete(task.TaskId)
 
+	if admission, ok := e.admissions[avsAddress]; ok {
+		taskCtx, release, err := admission.admit(ctx)
+		if err != nil {
+			e.logger.Sugar().Warnw("Task not admitted",
+				"taskId", task.TaskId,
+				"avsAddress", avsAddress,
+				"error", err,
+			)
+			switch {
+			case errors.Is(err, errAdmissionQueueFull):
+				return nil, status.Errorf(codes.ResourceExhausted, "AVS %s has reached its task concurrency and queue limits", avsAddress)
+			case errors.Is(err, errAdmissionTimeout):
+				return nil, status.Errorf(codes.ResourceExhausted, "task %s was not admitted within %s", task.TaskId, admission.taskTimeout)
+			default:
+				return nil, status.FromContextError(err).Err()
+			}
+		}
+		defer release()
+		ctx = taskCtx
+	}
+
 	response, err := avsPerf.RunTask(ctx, pt)
 
 	if err != nil {
@@ -379,6 +432,13 @@ func (e *Executor) handleReceivedTask(ctx context.Context, task *executorV1.Task
 		Version:         1,
 	}
 
+	if err := e.store.SaveTaskResult(ctx, taskResultToRecord(task, inputHash, result), e.config.TaskResultTTL()); err != nil {
+		e.logger.Sugar().Warnw("Failed to cache task result",
+			"taskId", task.TaskId,
+			"error", err,
+		)
+	}
+
 	if err := e.store.MarkTaskProcessed(ctx, task.TaskId); err != nil {
 		e.logger.Sugar().Warnw("Failed to mark task as processed",
 			"taskId", task.TaskId,
@@ -392,7 +452,7 @@ func (e *Executor) handleReceivedTask(ctx context.Context, task *executorV1.Task
 // signResult creates both result signature (for aggregation) and auth signature (for identity)
 func (e *Executor) signResult(ctx context.Context, task *performerTask.PerformerTask, result *performerTask.PerformerTaskResult) ([]byte, []byte, error) {
 	// Get the curve type for the operator set using the task's block number for historical accuracy
-	curveType, err := e.l1ContractCaller.GetOperatorSetCurveType(task.Avs, task.OperatorSetId, task.TaskBlockNumber)
+	curveType, err := e.chainState.GetOperatorSetCurveType(task.Avs, task.OperatorSetId, task.TaskBlockNumber)
 	if err != nil {
 		e.logger.Error("Failed to get operator set curve type",
 			zap.String("avsAddress", task.Avs),
@@ -410,7 +470,7 @@ func (e *Executor) signResult(ctx context.Context, task *performerTask.Performer
 	var taskHash [32]byte
 	copy(taskHash[:], taskIdBytes)
 
-	outputDigestHash, err := e.l1ContractCaller.CalculateTaskMessageHash(ctx, taskHash, result.Result)
+	outputDigestHash, err := util.CalculateTaskMessageHash(taskHash, result.Result)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to calculate task hash: %w", err)
 	}
@@ -687,6 +747,48 @@ func (e *Executor) performerInfoToProto(info avsPerformer.PerformerMetadata) *ex
 		ApplicationHealthy: info.ApplicationHealthy,
 		LastHealthCheck:    info.LastHealthCheck.Format(time.RFC3339),
 		ContainerId:        info.ResourceID,
+		Replicas:           uint32(info.Replicas),
+		HealthyReplicas:    uint32(info.HealthyReplicas),
+	}
+}
+
+// GetAdmissionStats returns the admission limits of each configured AVS and how many of its tasks
+// were admitted and rejected under them
+func (e *Executor) GetAdmissionStats(ctx context.Context, req *executorV1.GetAdmissionStatsRequest) (*executorV1.GetAdmissionStatsResponse, error) {
+	e.logger.Info("Received get admission stats request",
+		zap.String("avsAddressFilter", req.GetAvsAddress()),
+	)
+
+	// Verify authentication
+	if err := auth.HandleAuthError(e.verifyAuth(req.Auth)); err != nil {
+		return nil, err
+	}
+
+	filterAddress := strings.ToLower(req.GetAvsAddress())
+	avsAddresses := slices.Sorted(maps.Keys(e.admissions))
+
+	stats := make([]*executorV1.AdmissionStats, 0, len(avsAddresses))
+	for _, avsAddress := range avsAddresses {
+		if filterAddress != "" && filterAddress != avsAddress {
+			continue
+		}
+		stats = append(stats, admissionStatsToProto(e.admissions[avsAddress].stats()))
+	}
+
+	return &executorV1.GetAdmissionStatsResponse{Stats: stats}, nil
+}
+
+func admissionStatsToProto(stats admissionStats) *executorV1.AdmissionStats {
+	return &executorV1.AdmissionStats{
+		AvsAddress:         stats.AvsAddress,
+		MaxConcurrentTasks: uint32(stats.MaxConcurrentTasks),
+		QueueDepth:         uint32(stats.QueueDepth),
+		TaskTimeoutSeconds: uint64(stats.TaskTimeout.Seconds()),
+		RunningTasks:       uint32(stats.Running),
+		QueuedTasks:        uint32(stats.Queued),
+		AdmittedTasks:      stats.Admitted,
+		RejectedQueueFull:  stats.RejectedQueueFull,
+		RejectedTimeout:    stats.RejectedTimeout,
 	}
 }
 
@@ -730,18 +832,18 @@ func (e *Executor) GetChallengeToken(ctx context.Context, req *executorV1.GetCha
 
 // validateOperatorInSet checks if the operator is in the specified operator set
 func (e *Executor) validateOperatorInSet(task *executorV1.TaskSubmission) error {
-	opSet, err := e.l1ContractCaller.GetOperatorSetDetailsForOperator(
+	opSet, err := e.chainState.GetOperatorSetDetailsForOperator(
 		common.HexToAddress(e.config.Operator.Address),
 		task.GetAvsAddress(),
 		task.OperatorSetId,
 		task.TaskBlockNumber,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to get operator set details: %w", err)
+		return status.Errorf(codes.Unavailable, "failed to get operator set details: %v", err)
 	}
 
 	if opSet == nil {
-		return fmt.Errorf("invalid task operator set")
+		return status.Error(codes.PermissionDenied, "invalid task operator set")
 	}
 
 	return nil
@@ -774,7 +876,7 @@ func (e *Executor) constructTaskSubmissionMessage(task *executorV1.TaskSubmissio
 // validateTaskSignature validates the signature of a task submission
 func (e *Executor) validateTaskSignature(task *executorV1.TaskSubmission) error {
 	// Get AVS config to find aggregator's operator set using historical block number
-	aggConfig, err := e.l1ContractCaller.GetAVSConfig(task.AvsAddress, task.TaskBlockNumber)
+	aggConfig, err := e.chainState.GetAVSConfig(task.AvsAddress, task.TaskBlockNumber)
 	if err != nil {
 		e.logger.Sugar().Errorw("Failed to get AVS config",
 			zap.String("avsAddress", task.AvsAddress),
@@ -788,7 +890,7 @@ func (e *Executor) validateTaskSignature(task *executorV1.TaskSubmission) error
 	}
 
 	// Get aggregator's operator set details using the task block number
-	aggOpSet, err := e.l1ContractCaller.GetOperatorSetDetailsForOperator(
+	aggOpSet, err := e.chainState.GetOperatorSetDetailsForOperator(
 		common.HexToAddress(task.AggregatorAddress),
 		task.AvsAddress,
 		aggConfig.AggregatorOperatorSetId,
@@ -121,6 +121,7 @@ func TestValidateOperatorInSet(t *testing.T) {
 			e := &Executor{
 				config:           execConfig,
 				l1ContractCaller: mockCaller,
+				chainState:       newChainStateCache(mockCaller, 0),
 				logger:           l,
 			}
 
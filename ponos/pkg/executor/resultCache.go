@@ -0,0 +1,59 @@
+package executor
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	executorV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/executor"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/executor/storage"
+)
+
+// taskInputHash hashes the parts of a task submission that determine its result. The aggregator
+// address and signature are left out, so every authorized aggregator submitting the same task gets
+// the same cached result.
+func taskInputHash(task *executorV1.TaskSubmission) string {
+	hasher := sha256.New()
+	hasher.Write([]byte(strings.ToLower(task.AvsAddress)))
+
+	var fixed [20]byte
+	binary.BigEndian.PutUint32(fixed[0:4], task.OperatorSetId)
+	binary.BigEndian.PutUint32(fixed[4:8], task.ReferenceTimestamp)
+	binary.BigEndian.PutUint64(fixed[8:16], task.TaskBlockNumber)
+	binary.BigEndian.PutUint32(fixed[16:20], task.Version)
+	hasher.Write(fixed[:])
+
+	hasher.Write(task.Payload)
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+// taskResultToRecord keys the signed result by the submitted task ID, which is what retries look it up by
+func taskResultToRecord(task *executorV1.TaskSubmission, inputHash string, result *executorV1.TaskResult) *storage.TaskResultRecord {
+	return &storage.TaskResultRecord{
+		TaskId:          task.TaskId,
+		InputHash:       inputHash,
+		AvsAddress:      result.AvsAddress,
+		OperatorAddress: result.OperatorAddress,
+		OperatorSetId:   result.OperatorSetId,
+		Output:          result.Output,
+		ResultSignature: result.ResultSignature,
+		AuthSignature:   result.AuthSignature,
+		Version:         result.Version,
+		CreatedAt:       time.Now(),
+	}
+}
+
+func taskResultFromRecord(record *storage.TaskResultRecord) *executorV1.TaskResult {
+	return &executorV1.TaskResult{
+		TaskId:          record.TaskId,
+		OperatorAddress: record.OperatorAddress,
+		Output:          record.Output,
+		ResultSignature: record.ResultSignature,
+		AvsAddress:      record.AvsAddress,
+		OperatorSetId:   record.OperatorSetId,
+		AuthSignature:   record.AuthSignature,
+		Version:         record.Version,
+	}
+}
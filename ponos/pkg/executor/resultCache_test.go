@@ -0,0 +1,75 @@
+package executor
+
+import (
+	"testing"
+
+	executorV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/executor"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestSubmission() *executorV1.TaskSubmission {
+	return &executorV1.TaskSubmission{
+		TaskId:             "0x0000000000000000000000000000000000000000000000000000000000000001",
+		AggregatorAddress:  "0x1111111111111111111111111111111111111111",
+		AvsAddress:         "0xAbCdEf0000000000000000000000000000000000",
+		Payload:            []byte("payload"),
+		Signature:          []byte("aggregator-signature"),
+		OperatorSetId:      1,
+		ReferenceTimestamp: 100,
+		ExecutorAddress:    "0x2222222222222222222222222222222222222222",
+		TaskBlockNumber:    42,
+		Version:            1,
+	}
+}
+
+func TestTaskInputHash(t *testing.T) {
+	base := taskInputHash(newTestSubmission())
+
+	t.Run("ignores the submitting aggregator", func(t *testing.T) {
+		task := newTestSubmission()
+		task.AggregatorAddress = "0x3333333333333333333333333333333333333333"
+		task.Signature = []byte("other-aggregator-signature")
+		assert.Equal(t, base, taskInputHash(task))
+	})
+
+	t.Run("ignores the case of the AVS address", func(t *testing.T) {
+		task := newTestSubmission()
+		task.AvsAddress = "0xabcdef0000000000000000000000000000000000"
+		assert.Equal(t, base, taskInputHash(task))
+	})
+
+	t.Run("changes with the task inputs", func(t *testing.T) {
+		mutations := map[string]func(task *executorV1.TaskSubmission){
+			"payload":         func(task *executorV1.TaskSubmission) { task.Payload = []byte("other") },
+			"operatorSetId":   func(task *executorV1.TaskSubmission) { task.OperatorSetId = 2 },
+			"taskBlockNumber": func(task *executorV1.TaskSubmission) { task.TaskBlockNumber = 43 },
+			"referenceTime":   func(task *executorV1.TaskSubmission) { task.ReferenceTimestamp = 101 },
+			"avsAddress":      func(task *executorV1.TaskSubmission) { task.AvsAddress = "0x4444444444444444444444444444444444444444" },
+		}
+		for name, mutate := range mutations {
+			task := newTestSubmission()
+			mutate(task)
+			assert.NotEqual(t, base, taskInputHash(task), name)
+		}
+	})
+}
+
+func TestTaskResultRecordRoundTrip(t *testing.T) {
+	task := newTestSubmission()
+	result := &executorV1.TaskResult{
+		TaskId:          task.TaskId,
+		OperatorAddress: task.ExecutorAddress,
+		Output:          []byte("output"),
+		ResultSignature: []byte("result-signature"),
+		AvsAddress:      "0xabcdef0000000000000000000000000000000000",
+		OperatorSetId:   task.OperatorSetId,
+		AuthSignature:   []byte("auth-signature"),
+		Version:         1,
+	}
+
+	record := taskResultToRecord(task, taskInputHash(task), result)
+	assert.Equal(t, task.TaskId, record.TaskId)
+	assert.Equal(t, taskInputHash(task), record.InputHash)
+	assert.True(t, proto.Equal(result, taskResultFromRecord(record)))
+}
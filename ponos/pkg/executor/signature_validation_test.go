@@ -55,6 +55,7 @@ func TestValidateTaskSignature_ValidECDSA(t *testing.T) {
 			},
 		},
 		l1ContractCaller: mockCaller,
+		chainState:       newChainStateCache(mockCaller, 0),
 		logger:           l,
 	}
 
@@ -98,6 +99,7 @@ func TestValidateTaskSignature_ValidBN254(t *testing.T) {
 			},
 		},
 		l1ContractCaller: mockCaller,
+		chainState:       newChainStateCache(mockCaller, 0),
 		logger:           l,
 	}
 
@@ -137,6 +139,7 @@ func TestValidateTaskSignature_InvalidSignature(t *testing.T) {
 			},
 		},
 		l1ContractCaller: mockCaller,
+		chainState:       newChainStateCache(mockCaller, 0),
 		logger:           l,
 	}
 
@@ -176,6 +179,7 @@ func TestValidateTaskSignature_WrongExecutor(t *testing.T) {
 			},
 		},
 		l1ContractCaller: mockCaller,
+		chainState:       newChainStateCache(mockCaller, 0),
 		logger:           l,
 	}
 
@@ -215,6 +219,7 @@ func TestValidateTaskSignature_TamperedPayload(t *testing.T) {
 			},
 		},
 		l1ContractCaller: mockCaller,
+		chainState:       newChainStateCache(mockCaller, 0),
 		logger:           l,
 	}
 
@@ -258,6 +263,7 @@ func TestValidateTaskSignature_WrongAggregator(t *testing.T) {
 			},
 		},
 		l1ContractCaller: mockCaller,
+		chainState:       newChainStateCache(mockCaller, 0),
 		logger:           l,
 	}
 
@@ -295,6 +301,7 @@ func TestValidateTaskSignature_MissingAVSConfig(t *testing.T) {
 			},
 		},
 		l1ContractCaller: mockCaller,
+		chainState:       newChainStateCache(mockCaller, 0),
 		logger:           l,
 	}
 
@@ -347,6 +354,7 @@ func TestHandleReceivedTask_ValidTaskAccepted(t *testing.T) {
 			},
 		},
 		l1ContractCaller: mockCaller,
+		chainState:       newChainStateCache(mockCaller, 0),
 		logger:           l,
 		avsPerformers:    &sync.Map{},
 		store:            memory.NewInMemoryExecutorStore(),
@@ -405,6 +413,7 @@ func TestHandleReceivedTask_InvalidTaskRejected(t *testing.T) {
 			},
 		},
 		l1ContractCaller: mockCaller,
+		chainState:       newChainStateCache(mockCaller, 0),
 		logger:           l,
 		avsPerformers:    &sync.Map{},
 		store:            memory.NewInMemoryExecutorStore(),
@@ -456,6 +465,7 @@ func TestSignResult_ECDSA(t *testing.T) {
 			},
 		},
 		l1ContractCaller: mockCaller,
+		chainState:       newChainStateCache(mockCaller, 0),
 		logger:           l,
 		ecdsaSigner:      NewECDSATestSigner(executorPrivKey),
 	}
@@ -516,6 +526,7 @@ func TestSignResult_BN254(t *testing.T) {
 			},
 		},
 		l1ContractCaller: mockCaller,
+		chainState:       newChainStateCache(mockCaller, 0),
 		logger:           l,
 		bn254Signer:      NewBN254TestSigner(executorPrivKey),
 	}
@@ -579,6 +590,7 @@ func TestSignResult_BindsToExecutor(t *testing.T) {
 			},
 		},
 		l1ContractCaller: mockCaller,
+		chainState:       newChainStateCache(mockCaller, 0),
 		logger:           l,
 		ecdsaSigner:      NewECDSATestSigner(executor1PrivKey),
 	}
@@ -591,6 +603,7 @@ func TestSignResult_BindsToExecutor(t *testing.T) {
 			},
 		},
 		l1ContractCaller: mockCaller,
+		chainState:       newChainStateCache(mockCaller, 0),
 		logger:           l,
 		ecdsaSigner:      NewECDSATestSigner(executor2PrivKey),
 	}
@@ -650,6 +663,7 @@ func TestSignResult_IncludesAllFields(t *testing.T) {
 			},
 		},
 		l1ContractCaller: mockCaller,
+		chainState:       newChainStateCache(mockCaller, 0),
 		logger:           l,
 		ecdsaSigner:      NewECDSATestSigner(executorPrivKey),
 	}
@@ -698,6 +712,7 @@ func TestSignResult_MissingSignerError(t *testing.T) {
 			},
 		},
 		l1ContractCaller: mockCaller,
+		chainState:       newChainStateCache(mockCaller, 0),
 		logger:           l,
 		ecdsaSigner:      nil, // No signer configured
 	}
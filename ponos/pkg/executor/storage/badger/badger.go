@@ -17,6 +17,7 @@ import (
 const (
 	prefixPerformer = "performer:%s"
 	prefixProcessed = "processed:%s" // processed tasks
+	prefixResult    = "result:%s:%s" // signed task results by task ID and input hash
 )
 
 // BadgerExecutorStore implements the ExecutorStore interface using BadgerDB
@@ -289,6 +290,74 @@ func (s *BadgerExecutorStore) IsTaskProcessed(ctx context.Context, taskId string
 	return true, nil
 }
 
+// SaveTaskResult stores a signed task result; BadgerDB drops it once the TTL passes
+func (s *BadgerExecutorStore) SaveTaskResult(ctx context.Context, result *storage.TaskResultRecord, ttl time.Duration) error {
+	s.mu.RLock()
+	if s.closed {
+		s.mu.RUnlock()
+		return storage.ErrStoreClosed
+	}
+	s.mu.RUnlock()
+
+	if result == nil {
+		return errors.New("task result is nil")
+	}
+	if result.TaskId == "" {
+		return fmt.Errorf("task ID cannot be empty")
+	}
+
+	key := fmt.Sprintf(prefixResult, result.TaskId, result.InputHash)
+	value, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task result: %w", err)
+	}
+
+	err = s.db.Update(func(txn *badgerv3.Txn) error {
+		return txn.SetEntry(badgerv3.NewEntry([]byte(key), value).WithTTL(ttl))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save task result: %w", err)
+	}
+
+	return nil
+}
+
+// GetTaskResult retrieves a signed task result that has not expired
+func (s *BadgerExecutorStore) GetTaskResult(ctx context.Context, taskId string, inputHash string) (*storage.TaskResultRecord, error) {
+	s.mu.RLock()
+	if s.closed {
+		s.mu.RUnlock()
+		return nil, storage.ErrStoreClosed
+	}
+	s.mu.RUnlock()
+
+	var result storage.TaskResultRecord
+	key := fmt.Sprintf(prefixResult, taskId, inputHash)
+
+	err := s.db.View(func(txn *badgerv3.Txn) error {
+		item, err := txn.Get([]byte(key))
+		if err != nil {
+			if errors.Is(err, badgerv3.ErrKeyNotFound) {
+				return storage.ErrNotFound
+			}
+			return err
+		}
+
+		return item.Value(func(val []byte) error {
+			return json.Unmarshal(val, &result)
+		})
+	})
+
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get task result: %w", err)
+	}
+
+	return &result, nil
+}
+
 // Close shuts down the store
 func (s *BadgerExecutorStore) Close() error {
 	s.mu.Lock()
@@ -3,7 +3,9 @@ package memory
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/executor/storage"
 )
@@ -13,11 +15,68 @@ type InMemoryExecutorStore struct {
 	mu              sync.RWMutex
 	closed          bool
 	performerStates map[string]*storage.PerformerState
+	taskResults     map[string]*cachedTaskResult
+
+	expiryTicker *time.Ticker
+	closeCh      chan struct{}
+}
+
+type cachedTaskResult struct {
+	record    *storage.TaskResultRecord
+	expiresAt time.Time
 }
 
+// taskResultExpiryInterval is how often expired task results are dropped
+const taskResultExpiryInterval = time.Minute
+
 // NewInMemoryExecutorStore creates a new in-memory executor store
 func NewInMemoryExecutorStore() *InMemoryExecutorStore {
-	return &InMemoryExecutorStore{performerStates: make(map[string]*storage.PerformerState)}
+	s := &InMemoryExecutorStore{
+		performerStates: make(map[string]*storage.PerformerState),
+		taskResults:     make(map[string]*cachedTaskResult),
+		closeCh:         make(chan struct{}),
+	}
+
+	// Start the routine dropping expired task results
+	s.expiryTicker = time.NewTicker(taskResultExpiryInterval)
+	go s.runTaskResultExpiry()
+
+	return s
+}
+
+// runTaskResultExpiry periodically drops expired task results, which bounds the memory they take
+func (s *InMemoryExecutorStore) runTaskResultExpiry() {
+	for {
+		select {
+		case <-s.expiryTicker.C:
+			s.dropExpiredTaskResults(time.Now())
+		case <-s.closeCh:
+			return
+		}
+	}
+}
+
+func (s *InMemoryExecutorStore) dropExpiredTaskResults(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, cached := range s.taskResults {
+		if !now.Before(cached.expiresAt) {
+			delete(s.taskResults, key)
+		}
+	}
+}
+
+func taskResultKey(taskId string, inputHash string) string {
+	return fmt.Sprintf("%s:%s", taskId, inputHash)
+}
+
+func copyTaskResult(result *storage.TaskResultRecord) *storage.TaskResultRecord {
+	resultCopy := *result
+	resultCopy.Output = slices.Clone(result.Output)
+	resultCopy.ResultSignature = slices.Clone(result.ResultSignature)
+	resultCopy.AuthSignature = slices.Clone(result.AuthSignature)
+	return &resultCopy
 }
 
 // SavePerformerState saves the state of a performer
@@ -128,6 +187,47 @@ func (s *InMemoryExecutorStore) IsTaskProcessed(ctx context.Context, taskId stri
 	return false, nil
 }
 
+// SaveTaskResult keeps a signed task result until its TTL passes
+func (s *InMemoryExecutorStore) SaveTaskResult(ctx context.Context, result *storage.TaskResultRecord, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return storage.ErrStoreClosed
+	}
+
+	if result == nil {
+		return fmt.Errorf("task result cannot be nil")
+	}
+
+	if result.TaskId == "" {
+		return fmt.Errorf("task ID cannot be empty")
+	}
+
+	s.taskResults[taskResultKey(result.TaskId, result.InputHash)] = &cachedTaskResult{
+		record:    copyTaskResult(result),
+		expiresAt: time.Now().Add(ttl),
+	}
+	return nil
+}
+
+// GetTaskResult retrieves a signed task result that has not expired
+func (s *InMemoryExecutorStore) GetTaskResult(ctx context.Context, taskId string, inputHash string) (*storage.TaskResultRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return nil, storage.ErrStoreClosed
+	}
+
+	cached, exists := s.taskResults[taskResultKey(taskId, inputHash)]
+	if !exists || !time.Now().Before(cached.expiresAt) {
+		return nil, storage.ErrNotFound
+	}
+
+	return copyTaskResult(cached.record), nil
+}
+
 // Close closes the store
 func (s *InMemoryExecutorStore) Close() error {
 	s.mu.Lock()
@@ -138,9 +238,12 @@ func (s *InMemoryExecutorStore) Close() error {
 	}
 
 	s.closed = true
+	s.expiryTicker.Stop()
+	close(s.closeCh)
 
 	// Clear all maps
 	s.performerStates = nil
+	s.taskResults = nil
 
 	return nil
 }
@@ -22,6 +22,12 @@ type ExecutorStore interface {
 	MarkTaskProcessed(ctx context.Context, taskId string) error
 	IsTaskProcessed(ctx context.Context, taskId string) (bool, error)
 
+	// SaveTaskResult keeps a signed task result for ttl, after which it is dropped
+	SaveTaskResult(ctx context.Context, result *TaskResultRecord, ttl time.Duration) error
+	// GetTaskResult returns the result saved for the task and input hash, or ErrNotFound if there is
+	// none or it expired
+	GetTaskResult(ctx context.Context, taskId string, inputHash string) (*TaskResultRecord, error)
+
 	Close() error
 }
 
@@ -50,3 +56,18 @@ type ProcessedTask struct {
 	TaskId      string    `json:"taskId"`
 	ProcessedAt time.Time `json:"processedAt"`
 }
+
+// TaskResultRecord is a signed task result, kept so that retried submissions of the same task are
+// answered with the same result instead of running the performer again
+type TaskResultRecord struct {
+	TaskId          string    `json:"taskId"`
+	InputHash       string    `json:"inputHash"`
+	AvsAddress      string    `json:"avsAddress"`
+	OperatorAddress string    `json:"operatorAddress"`
+	OperatorSetId   uint32    `json:"operatorSetId"`
+	Output          []byte    `json:"output"`
+	ResultSignature []byte    `json:"resultSignature"`
+	AuthSignature   []byte    `json:"authSignature"`
+	Version         uint32    `json:"version"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
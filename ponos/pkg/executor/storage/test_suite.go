@@ -20,6 +20,7 @@ func (s *TestSuite) Run(t *testing.T) {
 	t.Run("PerformerState", s.testPerformerState)
 	t.Run("Lifecycle", s.testLifecycle)
 	t.Run("ProcessedTasks", s.testProcessedTasks)
+	t.Run("TaskResults", s.testTaskResults)
 	t.Run("ConcurrentAccess", s.testConcurrentAccess)
 }
 
@@ -145,6 +146,59 @@ func (s *TestSuite) testProcessedTasks(t *testing.T) {
 	assert.Error(t, err, "empty task ID should return error")
 }
 
+func (s *TestSuite) testTaskResults(t *testing.T) {
+	store, err := s.NewStore()
+	require.NoError(t, err)
+	defer store.Close()
+
+	ctx := context.Background()
+
+	// Test retrieving a result that was never saved
+	_, err = store.GetTaskResult(ctx, "task-123", "hash-a")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	result := &TaskResultRecord{
+		TaskId:          "task-123",
+		InputHash:       "hash-a",
+		AvsAddress:      "0xavs123",
+		OperatorAddress: "0xoperator",
+		OperatorSetId:   1,
+		Output:          []byte("output"),
+		ResultSignature: []byte("result-signature"),
+		AuthSignature:   []byte("auth-signature"),
+		Version:         1,
+		CreatedAt:       time.Now().Truncate(time.Second),
+	}
+	err = store.SaveTaskResult(ctx, result, time.Hour)
+	require.NoError(t, err)
+
+	// Test retrieving the saved result
+	retrieved, err := store.GetTaskResult(ctx, "task-123", "hash-a")
+	require.NoError(t, err)
+	assert.Equal(t, result.Output, retrieved.Output)
+	assert.Equal(t, result.ResultSignature, retrieved.ResultSignature)
+	assert.Equal(t, result.AuthSignature, retrieved.AuthSignature)
+	assert.Equal(t, result.OperatorSetId, retrieved.OperatorSetId)
+	assert.True(t, result.CreatedAt.Equal(retrieved.CreatedAt))
+
+	// Test that a different input hash does not match
+	_, err = store.GetTaskResult(ctx, "task-123", "hash-b")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// Test that results expire
+	err = store.SaveTaskResult(ctx, &TaskResultRecord{TaskId: "task-456", InputHash: "hash-a"}, time.Second)
+	require.NoError(t, err)
+	_, err = store.GetTaskResult(ctx, "task-456", "hash-a")
+	require.NoError(t, err)
+	time.Sleep(2 * time.Second)
+	_, err = store.GetTaskResult(ctx, "task-456", "hash-a")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// Test validation
+	assert.Error(t, store.SaveTaskResult(ctx, nil, time.Hour))
+	assert.Error(t, store.SaveTaskResult(ctx, &TaskResultRecord{}, time.Hour))
+}
+
 func (s *TestSuite) testConcurrentAccess(t *testing.T) {
 	store, err := s.NewStore()
 	require.NoError(t, err)
@@ -0,0 +1,251 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const aggregatorSubsystem = "aggregator"
+
+var (
+	AggregatorTasksReceived = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "tasks_received_total",
+		Help:      "Tasks observed on chain and accepted into the task queue",
+	}, []string{"avs_address", "chain_id"})
+
+	AggregatorTasksReplayed = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "tasks_replayed_total",
+		Help:      "Tasks queued again by a replay of a block range",
+	}, []string{"avs_address", "chain_id"})
+
+	AggregatorTasksSubmitted = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "tasks_submitted_total",
+		Help:      "Off-chain tasks accepted from a task source other than the chain",
+	}, []string{"avs_address", "source"})
+
+	AggregatorTasksQueued = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "tasks_queued",
+		Help:      "Tasks waiting in the task queue for a worker",
+	}, []string{"avs_address"})
+
+	AggregatorTasksAwaitingDispatch = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "tasks_awaiting_dispatch",
+		Help:      "Tasks taken off the task queue and waiting, ordered by deadline, for a worker",
+	}, []string{"avs_address"})
+
+	AggregatorTasksInFlight = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "tasks_in_flight",
+		Help:      "Tasks currently being distributed, aggregated or submitted",
+	}, []string{"avs_address"})
+
+	AggregatorOperatorSetTasksInFlight = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "operator_set_tasks_in_flight",
+		Help:      "Tasks of an operator set currently being distributed, aggregated or submitted",
+	}, []string{"avs_address", "operator_set_id"})
+
+	AggregatorTaskWorkers = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "task_workers",
+		Help:      "Tasks the AVS can process in parallel",
+	}, []string{"avs_address"})
+
+	AggregatorTasksCompleted = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "tasks_completed_total",
+		Help:      "Tasks whose certificate was submitted successfully",
+	}, []string{"avs_address", "chain_id"})
+
+	AggregatorTasksFailed = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "tasks_failed_total",
+		Help:      "Tasks that could not be completed",
+	}, []string{"avs_address", "chain_id"})
+
+	AggregatorTasksExpired = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "tasks_expired_total",
+		Help:      "Tasks dropped because their deadline passed",
+	}, []string{"avs_address", "chain_id"})
+
+	AggregatorSignatureCollectionSeconds = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "signature_collection_seconds",
+		Help:      "Time from broadcasting a task to an operator until its signed result is received",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+	}, []string{"avs_address", "operator_address"})
+
+	AggregatorTaskSubmissionRetries = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "task_submission_retries_total",
+		Help:      "Task submissions to executors retried after a transient error, by gRPC code",
+	}, []string{"avs_address", "operator_address", "code"})
+
+	AggregatorExecutorConnections = factory.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "executor_connections",
+		Help:      "Open gRPC connections to executors held in the connection pool",
+	})
+
+	AggregatorExecutorConnectionDials = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "executor_connection_dials_total",
+		Help:      "gRPC connections dialed to executors, by reason (new, reconnect or socket_changed)",
+	}, []string{"reason"})
+
+	AggregatorExecutorConnectionEvictions = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "executor_connection_evictions_total",
+		Help:      "gRPC connections to executors closed by the connection pool, by reason (idle, socket_changed or shutdown)",
+	}, []string{"reason"})
+
+	AggregatorOperatorCacheRequests = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "operator_cache_requests_total",
+		Help:      "Operator set cache lookups by cache (peer_weights or curve_type) and result (hit or miss)",
+	}, []string{"avs_address", "cache", "result"})
+
+	AggregatorOperatorCacheInvalidations = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "operator_cache_invalidations_total",
+		Help:      "Cached operator set snapshots dropped because a newer table root was seen or the operator set was invalidated",
+	}, []string{"avs_address"})
+
+	AggregatorTasksRecovered = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "tasks_recovered_total",
+		Help:      "Pending tasks found in storage at startup, by outcome (recovered, skipped or expired)",
+	}, []string{"avs_address", "outcome"})
+
+	AggregatorCertificateSubmissionSeconds = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "certificate_submission_seconds",
+		Help:      "Time taken to submit an aggregated certificate and receive its receipt",
+		Buckets:   []float64{0.5, 1, 2, 5, 10, 20, 30, 60, 120},
+	}, []string{"avs_address", "chain_id", "curve_type"})
+
+	AggregatorCertificateSubmissionGas = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "certificate_submission_gas_used",
+		Help:      "Gas used by certificate submission transactions",
+		Buckets:   prometheus.ExponentialBuckets(50_000, 2, 8),
+	}, []string{"avs_address", "chain_id", "curve_type"})
+
+	AggregatorChainPollerLagBlocks = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "chain_poller_lag_blocks",
+		Help:      "Number of blocks between the chain head and the last processed block",
+	}, []string{"avs_address", "chain_id"})
+
+	AggregatorChainPollerSubscribed = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "chain_poller_subscribed",
+		Help:      "1 while the chain poller receives new block headers over a websocket subscription, 0 while it polls",
+	}, []string{"avs_address", "chain_id"})
+
+	AggregatorChainPollerCatchUpBlocks = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "chain_poller_catch_up_blocks_total",
+		Help:      "Blocks processed in catch-up mode with ranged log requests",
+	}, []string{"avs_address", "chain_id"})
+
+	AggregatorChainPollerBlocksSkipped = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "chain_poller_blocks_skipped_total",
+		Help:      "Blocks skipped because the chain poller was further behind the head than the maximum catch-up depth",
+	}, []string{"avs_address", "chain_id"})
+
+	AggregatorChainReorgs = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "chain_reorgs_total",
+		Help:      "Chain reorganizations handled by the chain poller",
+	}, []string{"avs_address", "chain_id"})
+
+	AggregatorChainReorgDepth = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "chain_reorg_depth_blocks",
+		Help:      "Number of processed blocks orphaned by each chain reorganization",
+		Buckets:   []float64{1, 2, 3, 5, 8, 13, 21, 34, 64},
+	}, []string{"avs_address", "chain_id"})
+
+	AggregatorChainReorgTasks = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "chain_reorg_tasks_total",
+		Help:      "Tasks affected by chain reorganizations: cancelled, retained because they were included again, or new in the canonical branch",
+	}, []string{"avs_address", "chain_id", "outcome"})
+
+	AggregatorChainBlockFeedRequests = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "chain_block_feed_requests_total",
+		Help:      "Chain reads served by the shared block feed, by method (block_number, block or logs) and result (hit or miss, a miss goes to the chain)",
+	}, []string{"chain_id", "method", "result"})
+
+	AggregatorChainBlockFeedSubscribed = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "chain_block_feed_subscribed",
+		Help:      "1 while the shared block feed receives new block headers over a websocket subscription, 0 while it polls",
+	}, []string{"chain_id"})
+
+	AggregatorOperatorResponses = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "operator_responses_total",
+		Help:      "Operator responses to tasks by outcome (accepted, rejected, late or failed)",
+	}, []string{"avs_address", "operator_set_id", "operator_address", "status"})
+
+	AggregatorOperatorDigestDisagreements = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "operator_digest_disagreements_total",
+		Help:      "Operator results whose output differed from the certified response",
+	}, []string{"avs_address", "operator_set_id", "operator_address"})
+
+	AggregatorOperatorConsecutiveMisses = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "operator_consecutive_misses",
+		Help:      "Tasks an operator has missed in a row since its last response",
+	}, []string{"avs_address", "operator_set_id", "operator_address"})
+
+	AggregatorOperatorResponseRate = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: aggregatorSubsystem,
+		Name:      "operator_response_rate",
+		Help:      "Fraction of tasks an operator responded to, excluding tasks that reached consensus before it answered",
+	}, []string{"avs_address", "operator_set_id", "operator_address"})
+)
@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const executorSubsystem = "executor"
+
+var (
+	ExecutorTasksReceived = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: executorSubsystem,
+		Name:      "tasks_received_total",
+		Help:      "Task submissions received from aggregators",
+	}, []string{"avs_address"})
+
+	ExecutorTasksInFlight = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: executorSubsystem,
+		Name:      "tasks_in_flight",
+		Help:      "Tasks currently being executed by a performer",
+	}, []string{"avs_address"})
+
+	ExecutorTasksCompleted = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: executorSubsystem,
+		Name:      "tasks_completed_total",
+		Help:      "Tasks executed and signed successfully",
+	}, []string{"avs_address"})
+
+	ExecutorTasksFailed = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: executorSubsystem,
+		Name:      "tasks_failed_total",
+		Help:      "Task submissions that were rejected or failed to execute",
+	}, []string{"avs_address"})
+
+	ExecutorTaskDurationSeconds = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: executorSubsystem,
+		Name:      "task_duration_seconds",
+		Help:      "Time taken to execute and sign a task",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"avs_address"})
+
+	ExecutorTasksQueued = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: executorSubsystem,
+		Name:      "tasks_queued",
+		Help:      "Tasks waiting for a free slot under the AVS's admission limits",
+	}, []string{"avs_address"})
+
+	ExecutorTasksRejected = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: executorSubsystem,
+		Name:      "tasks_rejected_total",
+		Help:      "Tasks rejected by admission control, by reason (queue_full or queue_timeout)",
+	}, []string{"avs_address", "reason"})
+
+	ExecutorChainStateCacheRequests = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: executorSubsystem,
+		Name:      "chain_state_cache_requests_total",
+		Help:      "Chain state cache lookups by cache (avs_config, operator_set or curve_type) and result (hit or miss)",
+	}, []string{"avs_address", "cache", "result"})
+
+	ExecutorPerformerHealthy = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: executorSubsystem,
+		Name:      "performer_healthy",
+		Help:      "1 if both the performer resource and application are healthy, 0 otherwise",
+	}, []string{"avs_address", "performer_id"})
+)
@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	Namespace = "hourglass"
+
+	DefaultPort = 9090
+	DefaultPath = "/metrics"
+)
+
+// Registry holds every collector exposed by the aggregator and executor
+var Registry = prometheus.NewRegistry()
+
+var factory = promauto.With(Registry)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+type Config struct {
+	Enabled bool   `json:"enabled" yaml:"enabled"`
+	Port    int    `json:"port,omitempty" yaml:"port,omitempty"`
+	Path    string `json:"path,omitempty" yaml:"path,omitempty"`
+}
+
+func (c *Config) Validate() field.ErrorList {
+	var allErrors field.ErrorList
+	if c.Port < 0 || c.Port > 65535 {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("port"), c.Port, "port must be between 0 and 65535"))
+	}
+	if c.Path != "" && c.Path[0] != '/' {
+		allErrors = append(allErrors, field.Invalid(field.NewPath("path"), c.Path, "path must start with '/'"))
+	}
+	return allErrors
+}
+
+// GetPort returns the configured port or DefaultPort
+func (c *Config) GetPort() int {
+	if c.Port == 0 {
+		return DefaultPort
+	}
+	return c.Port
+}
+
+// GetPath returns the configured path or DefaultPath
+func (c *Config) GetPath() string {
+	if c.Path == "" {
+		return DefaultPath
+	}
+	return c.Path
+}
@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Config(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		c := &Config{Enabled: true}
+		assert.Empty(t, c.Validate())
+		assert.Equal(t, DefaultPort, c.GetPort())
+		assert.Equal(t, DefaultPath, c.GetPath())
+	})
+	t.Run("invalid port and path", func(t *testing.T) {
+		c := &Config{Enabled: true, Port: 70000, Path: "metrics"}
+		assert.Len(t, c.Validate(), 2)
+	})
+}
+
+func Test_Server(t *testing.T) {
+	l, err := logger.NewLogger(&logger.LoggerConfig{Debug: false})
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := newServer(&Config{Enabled: true, Path: "/custom"}, listener, l)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, s.Start(ctx))
+
+	AggregatorTasksReceived.WithLabelValues("0xavs", "1").Inc()
+	ExecutorPerformerHealthy.WithLabelValues("0xavs", "performer-1").Set(1)
+
+	res, err := http.Get("http://" + s.Addr() + "/custom")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Contains(t, string(body), `hourglass_aggregator_tasks_received_total{avs_address="0xavs",chain_id="1"} 1`)
+	assert.Contains(t, string(body), `hourglass_executor_performer_healthy{avs_address="0xavs",performer_id="performer-1"} 1`)
+	assert.Contains(t, string(body), "go_goroutines")
+}
@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const rpcSubsystem = "rpc"
+
+var (
+	RpcEndpointHealthy = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: rpcSubsystem,
+		Name:      "endpoint_healthy",
+		Help:      "1 while an RPC endpoint is serving requests, 0 while it is skipped after failures",
+	}, []string{"chain_id", "endpoint"})
+
+	RpcEndpointLatencySeconds = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: rpcSubsystem,
+		Name:      "endpoint_latency_seconds",
+		Help:      "Moving average of successful request latency per RPC endpoint, used to pick the endpoint reads go to",
+	}, []string{"chain_id", "endpoint"})
+
+	RpcFailovers = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: rpcSubsystem,
+		Name:      "failovers_total",
+		Help:      "Requests served by another RPC endpoint after the preferred one failed",
+	}, []string{"chain_id", "method"})
+
+	RpcQuorumFailures = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: rpcSubsystem,
+		Name:      "quorum_failures_total",
+		Help:      "Quorum reads where not enough RPC endpoints agreed",
+	}, []string{"chain_id", "method"})
+)
@@ -0,0 +1,75 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+)
+
+// Server exposes Registry over HTTP for Prometheus to scrape
+type Server struct {
+	config     *Config
+	listener   net.Listener
+	httpServer *http.Server
+	logger     *zap.Logger
+}
+
+func NewServer(cfg *Config, logger *zap.Logger) (*Server, error) {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GetPort()))
+	if err != nil {
+		logger.Sugar().Errorw("Failed to listen",
+			zap.Int("port", cfg.GetPort()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to listen: %v", err)
+	}
+	return newServer(cfg, listener, logger), nil
+}
+
+func newServer(cfg *Config, listener net.Listener, logger *zap.Logger) *Server {
+	mux := http.NewServeMux()
+	mux.Handle(cfg.GetPath(), promhttp.HandlerFor(Registry, promhttp.HandlerOpts{}))
+
+	return &Server{
+		config:   cfg,
+		listener: listener,
+		httpServer: &http.Server{
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+		logger: logger,
+	}
+}
+
+// Start serves metrics in the background until ctx is done
+func (s *Server) Start(ctx context.Context) error {
+	s.logger.Sugar().Infow("Starting metrics server",
+		zap.String("address", s.Addr()),
+		zap.String("path", s.config.GetPath()),
+	)
+	go func() {
+		if err := s.httpServer.Serve(s.listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Sugar().Errorw("Metrics server stopped unexpectedly", zap.Error(err))
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := s.httpServer.Shutdown(shutdownCtx); err != nil {
+			s.logger.Sugar().Warnw("Failed to shut down metrics server", zap.Error(err))
+		}
+	}()
+	return nil
+}
+
+// Addr returns the address the server is listening on
+func (s *Server) Addr() string {
+	return s.listener.Addr().String()
+}
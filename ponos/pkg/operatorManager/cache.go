@@ -0,0 +1,213 @@
+package operatorManager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/util"
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultCacheSize is the number of operator set snapshots and curve types kept in memory
+const DefaultCacheSize = 256
+
+// peerWeightFetchTimeout bounds a shared operator set fetch. The fetch is detached from the
+// context of the task that started it, so other tasks waiting on it don't fail when that
+// task is cancelled.
+const peerWeightFetchTimeout = 30 * time.Second
+
+const (
+	cacheNamePeerWeights = "peer_weights"
+	cacheNameCurveType   = "curve_type"
+)
+
+// peerWeightCacheKey identifies the operator set state a task is evaluated against.
+// Table data and peers are read at l1BlockNumber and the operator info tree root is
+// read at referenceTimestamp, so two tasks with the same key resolve to the same
+// PeerWeight.
+type peerWeightCacheKey struct {
+	avsAddress         string
+	operatorSetId      uint32
+	chainId            config.ChainId
+	curveType          config.CurveType
+	l1BlockNumber      uint64
+	referenceTimestamp uint32
+}
+
+func (k peerWeightCacheKey) operatorSet() operatorSetCacheKey {
+	return operatorSetCacheKey{avsAddress: k.avsAddress, operatorSetId: k.operatorSetId, chainId: k.chainId}
+}
+
+func (k peerWeightCacheKey) String() string {
+	return fmt.Sprintf("%s:%d:%d:%s:%d:%d", k.avsAddress, k.operatorSetId, k.chainId, k.curveType, k.l1BlockNumber, k.referenceTimestamp)
+}
+
+type operatorSetCacheKey struct {
+	avsAddress    string
+	operatorSetId uint32
+	chainId       config.ChainId
+}
+
+type curveTypeCacheKey struct {
+	avsAddress    string
+	operatorSetId uint32
+	blockNumber   uint64
+}
+
+func (k curveTypeCacheKey) String() string {
+	return fmt.Sprintf("%s:%d:%d", k.avsAddress, k.operatorSetId, k.blockNumber)
+}
+
+// operatorSetCache caches resolved operator sets and curve types so that a burst
+// of tasks against the same block resolves the operator set once. Concurrent
+// misses for the same key share a single fetch.
+//
+// Whenever a newer operator table reference timestamp is seen for an operator
+// set, i.e. a new table root was published, snapshots for older reference
+// timestamps are dropped.
+type operatorSetCache struct {
+	avsAddress string
+
+	mu          sync.Mutex
+	peerWeights *util.LruCache[peerWeightCacheKey, *PeerWeight]
+	curveTypes  *util.LruCache[curveTypeCacheKey, config.CurveType]
+	// latestReference is the newest reference timestamp seen for each operator set
+	latestReference map[operatorSetCacheKey]uint32
+
+	fetches singleflight.Group
+}
+
+func newOperatorSetCache(avsAddress string, maxEntries int) *operatorSetCache {
+	if maxEntries <= 0 {
+		maxEntries = DefaultCacheSize
+	}
+	return &operatorSetCache{
+		avsAddress:      strings.ToLower(avsAddress),
+		peerWeights:     util.NewLruCache[peerWeightCacheKey, *PeerWeight](maxEntries),
+		curveTypes:      util.NewLruCache[curveTypeCacheKey, config.CurveType](maxEntries),
+		latestReference: make(map[operatorSetCacheKey]uint32),
+	}
+}
+
+// getPeerWeight returns the cached PeerWeight for the key, calling fetch on a miss. The caller
+// stops waiting once ctx is done, while a fetch shared with other callers carries on.
+func (c *operatorSetCache) getPeerWeight(
+	ctx context.Context,
+	key peerWeightCacheKey,
+	fetch func(ctx context.Context) (*PeerWeight, error),
+) (*PeerWeight, error) {
+	c.mu.Lock()
+	c.observeReferenceTimestamp(key)
+	pw, ok := c.peerWeights.Get(key)
+	c.mu.Unlock()
+	if ok {
+		c.recordRequest(cacheNamePeerWeights, true)
+		return pw, nil
+	}
+	c.recordRequest(cacheNamePeerWeights, false)
+
+	fetched := c.fetches.DoChan(cacheNamePeerWeights+":"+key.String(), func() (interface{}, error) {
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), peerWeightFetchTimeout)
+		defer cancel()
+
+		pw, err := fetch(fetchCtx)
+		if err != nil {
+			return nil, err
+		}
+		c.mu.Lock()
+		// don't cache a snapshot that was superseded while it was being fetched
+		if c.latestReference[key.operatorSet()] <= key.referenceTimestamp {
+			c.peerWeights.Put(key, pw)
+		}
+		c.mu.Unlock()
+		return pw, nil
+	})
+
+	select {
+	case res := <-fetched:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*PeerWeight), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// getCurveType returns the cached curve type for the key, calling fetch on a miss
+func (c *operatorSetCache) getCurveType(key curveTypeCacheKey, fetch func() (config.CurveType, error)) (config.CurveType, error) {
+	c.mu.Lock()
+	curveType, ok := c.curveTypes.Get(key)
+	c.mu.Unlock()
+	if ok {
+		c.recordRequest(cacheNameCurveType, true)
+		return curveType, nil
+	}
+	c.recordRequest(cacheNameCurveType, false)
+
+	res, err, _ := c.fetches.Do(cacheNameCurveType+":"+key.String(), func() (interface{}, error) {
+		curveType, err := fetch()
+		if err != nil {
+			return config.CurveTypeUnknown, err
+		}
+		c.mu.Lock()
+		c.curveTypes.Put(key, curveType)
+		c.mu.Unlock()
+		return curveType, nil
+	})
+	if err != nil {
+		return config.CurveTypeUnknown, err
+	}
+	return res.(config.CurveType), nil
+}
+
+// invalidateOperatorSet drops every cached snapshot of the operator set on the chain
+func (c *operatorSetCache) invalidateOperatorSet(chainId config.ChainId, operatorSetId uint32) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.latestReference, operatorSetCacheKey{avsAddress: c.avsAddress, operatorSetId: operatorSetId, chainId: chainId})
+	removed := c.peerWeights.RemoveIf(func(k peerWeightCacheKey) bool {
+		return k.chainId == chainId && k.operatorSetId == operatorSetId
+	})
+	c.recordInvalidations(removed)
+	return removed
+}
+
+// observeReferenceTimestamp drops snapshots for older reference timestamps once a
+// newer one is seen for the operator set.
+// LOCKING: assumes the caller holds c.mu
+func (c *operatorSetCache) observeReferenceTimestamp(key peerWeightCacheKey) {
+	opset := key.operatorSet()
+	latest, ok := c.latestReference[opset]
+	if ok && key.referenceTimestamp <= latest {
+		return
+	}
+	c.latestReference[opset] = key.referenceTimestamp
+	if !ok {
+		return
+	}
+	removed := c.peerWeights.RemoveIf(func(k peerWeightCacheKey) bool {
+		return k.operatorSet() == opset && k.referenceTimestamp < key.referenceTimestamp
+	})
+	c.recordInvalidations(removed)
+}
+
+func (c *operatorSetCache) recordRequest(cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	metrics.AggregatorOperatorCacheRequests.WithLabelValues(c.avsAddress, cache, result).Inc()
+}
+
+func (c *operatorSetCache) recordInvalidations(removed int) {
+	if removed > 0 {
+		metrics.AggregatorOperatorCacheInvalidations.WithLabelValues(c.avsAddress).Add(float64(removed))
+	}
+}
@@ -0,0 +1,209 @@
+package operatorManager
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/mocks"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contractCaller"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/peering"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+)
+
+const (
+	testAvsAddress = "0x1234567890123456789012345678901234567890"
+	testOperator   = "0x00000000000000000000000000000000000000aa"
+	testL1ChainId  = config.ChainId(1)
+)
+
+func newTestOperatorManager(t *testing.T) (*OperatorManager, *mocks.MockIContractCaller, *mocks.MockIPeeringDataFetcher) {
+	ctrl := gomock.NewController(t)
+	cc := mocks.NewMockIContractCaller(ctrl)
+	pdf := mocks.NewMockIPeeringDataFetcher(ctrl)
+
+	om := NewOperatorManager(&OperatorManagerConfig{
+		AvsAddress: testAvsAddress,
+		ChainIds:   []config.ChainId{testL1ChainId},
+		L1ChainId:  testL1ChainId,
+	}, map[config.ChainId]contractCaller.IContractCaller{testL1ChainId: cc}, pdf, zaptest.NewLogger(t))
+	return om, cc, pdf
+}
+
+func newTestTableData() *contractCaller.OperatorTableData {
+	return &contractCaller.OperatorTableData{
+		Operators:       []common.Address{common.HexToAddress(testOperator)},
+		OperatorWeights: [][]*big.Int{{big.NewInt(100)}},
+	}
+}
+
+func newTestPeers() []*peering.OperatorPeerInfo {
+	return []*peering.OperatorPeerInfo{{
+		OperatorAddress: testOperator,
+		OperatorSets:    []*peering.OperatorSet{{OperatorSetID: 1, NetworkAddress: "localhost:9000"}},
+	}}
+}
+
+func newTestCacheTask(l1Block uint64, referenceTimestamp uint32) *types.Task {
+	return &types.Task{
+		AVSAddress:             testAvsAddress,
+		OperatorSetId:          1,
+		ChainId:                testL1ChainId,
+		L1ReferenceBlockNumber: l1Block,
+		SourceBlockNumber:      l1Block,
+		ReferenceTimestamp:     referenceTimestamp,
+	}
+}
+
+func Test_OperatorManagerCache(t *testing.T) {
+	t.Run("resolves a burst of tasks in the same block once", func(t *testing.T) {
+		om, cc, pdf := newTestOperatorManager(t)
+		cc.EXPECT().
+			GetOperatorTableDataForOperatorSet(gomock.Any(), gomock.Any(), uint32(1), testL1ChainId, uint64(100)).
+			Return(newTestTableData(), nil).
+			Times(1)
+		pdf.EXPECT().
+			ListExecutorOperators(gomock.Any(), testAvsAddress, uint64(100)).
+			Return(newTestPeers(), nil).
+			Times(1)
+
+		var wg sync.WaitGroup
+		results := make([]*PeerWeight, 20)
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				pw, err := om.GetExecutorPeersAndWeightsForTask(context.Background(), newTestCacheTask(100, 1000), config.CurveTypeECDSA)
+				assert.NoError(t, err)
+				results[i] = pw
+			}(i)
+		}
+		wg.Wait()
+
+		for _, pw := range results {
+			require.NotNil(t, pw)
+			assert.Same(t, results[0], pw)
+		}
+		require.Len(t, results[0].Operators, 1)
+		assert.Equal(t, testOperator, results[0].Operators[0].OperatorAddress)
+	})
+
+	t.Run("a cancelled task stops waiting without failing the shared fetch", func(t *testing.T) {
+		om, cc, pdf := newTestOperatorManager(t)
+		fetchStarted := make(chan struct{})
+		unblockFetch := make(chan struct{})
+		cc.EXPECT().
+			GetOperatorTableDataForOperatorSet(gomock.Any(), gomock.Any(), uint32(1), testL1ChainId, uint64(100)).
+			DoAndReturn(func(ctx context.Context, _ common.Address, _ uint32, _ config.ChainId, _ uint64) (*contractCaller.OperatorTableData, error) {
+				close(fetchStarted)
+				<-unblockFetch
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+				return newTestTableData(), nil
+			}).
+			Times(1)
+		pdf.EXPECT().ListExecutorOperators(gomock.Any(), testAvsAddress, uint64(100)).Return(newTestPeers(), nil).Times(1)
+
+		firstCtx, cancelFirst := context.WithCancel(context.Background())
+		firstErr := make(chan error, 1)
+		go func() {
+			_, err := om.GetExecutorPeersAndWeightsForTask(firstCtx, newTestCacheTask(100, 1000), config.CurveTypeECDSA)
+			firstErr <- err
+		}()
+		<-fetchStarted
+
+		secondResult := make(chan *PeerWeight, 1)
+		go func() {
+			pw, err := om.GetExecutorPeersAndWeightsForTask(context.Background(), newTestCacheTask(100, 1000), config.CurveTypeECDSA)
+			assert.NoError(t, err)
+			secondResult <- pw
+		}()
+
+		cancelFirst()
+		select {
+		case err := <-firstErr:
+			require.ErrorIs(t, err, context.Canceled)
+		case <-time.After(time.Second):
+			t.Fatal("cancelled task kept waiting for the shared fetch")
+		}
+
+		close(unblockFetch)
+		select {
+		case pw := <-secondResult:
+			require.NotNil(t, pw)
+			require.Len(t, pw.Operators, 1)
+		case <-time.After(time.Second):
+			t.Fatal("shared fetch did not complete")
+		}
+	})
+
+	t.Run("fetches again for a new block", func(t *testing.T) {
+		om, cc, pdf := newTestOperatorManager(t)
+		cc.EXPECT().
+			GetOperatorTableDataForOperatorSet(gomock.Any(), gomock.Any(), uint32(1), testL1ChainId, gomock.Any()).
+			Return(newTestTableData(), nil).
+			Times(2)
+		pdf.EXPECT().ListExecutorOperators(gomock.Any(), testAvsAddress, gomock.Any()).Return(newTestPeers(), nil).Times(2)
+
+		for _, block := range []uint64{100, 101, 100} {
+			_, err := om.GetExecutorPeersAndWeightsForTask(context.Background(), newTestCacheTask(block, 1000), config.CurveTypeECDSA)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("drops snapshots of older table roots when a new reference timestamp is seen", func(t *testing.T) {
+		om, cc, pdf := newTestOperatorManager(t)
+		cc.EXPECT().
+			GetOperatorTableDataForOperatorSet(gomock.Any(), gomock.Any(), uint32(1), testL1ChainId, uint64(100)).
+			Return(newTestTableData(), nil).
+			Times(3)
+		pdf.EXPECT().ListExecutorOperators(gomock.Any(), testAvsAddress, uint64(100)).Return(newTestPeers(), nil).Times(3)
+
+		// the old root is cached, then superseded, then has to be fetched again
+		for _, referenceTimestamp := range []uint32{1000, 1000, 2000, 1000} {
+			_, err := om.GetExecutorPeersAndWeightsForTask(context.Background(), newTestCacheTask(100, referenceTimestamp), config.CurveTypeECDSA)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 1, om.cache.peerWeights.Len())
+	})
+
+	t.Run("invalidates an operator set on demand", func(t *testing.T) {
+		om, cc, pdf := newTestOperatorManager(t)
+		cc.EXPECT().
+			GetOperatorTableDataForOperatorSet(gomock.Any(), gomock.Any(), uint32(1), testL1ChainId, uint64(100)).
+			Return(newTestTableData(), nil).
+			Times(2)
+		pdf.EXPECT().ListExecutorOperators(gomock.Any(), testAvsAddress, uint64(100)).Return(newTestPeers(), nil).Times(2)
+
+		_, err := om.GetExecutorPeersAndWeightsForTask(context.Background(), newTestCacheTask(100, 1000), config.CurveTypeECDSA)
+		require.NoError(t, err)
+		om.InvalidateOperatorSet(testL1ChainId, 1)
+		_, err = om.GetExecutorPeersAndWeightsForTask(context.Background(), newTestCacheTask(100, 1000), config.CurveTypeECDSA)
+		require.NoError(t, err)
+	})
+
+	t.Run("does not cache failed lookups", func(t *testing.T) {
+		om, cc, _ := newTestOperatorManager(t)
+		gomock.InOrder(
+			cc.EXPECT().GetOperatorSetCurveType(testAvsAddress, uint32(1), uint64(100)).Return(config.CurveTypeUnknown, assert.AnError),
+			cc.EXPECT().GetOperatorSetCurveType(testAvsAddress, uint32(1), uint64(100)).Return(config.CurveTypeBN254, nil),
+		)
+
+		_, err := om.GetCurveTypeForOperatorSet(testAvsAddress, 1, 100)
+		require.ErrorIs(t, err, assert.AnError)
+		for i := 0; i < 3; i++ {
+			curveType, err := om.GetCurveTypeForOperatorSet(testAvsAddress, 1, 100)
+			require.NoError(t, err)
+			assert.Equal(t, config.CurveTypeBN254, curveType)
+		}
+	})
+}
@@ -19,8 +19,13 @@ type OperatorManagerConfig struct {
 	AvsAddress string
 	ChainIds   []config.ChainId
 	L1ChainId  config.ChainId
+
+	// CacheSize is the number of resolved operator sets kept in memory, defaults to DefaultCacheSize
+	CacheSize int
 }
 
+// PeerWeight is a resolved operator set. PeerWeights returned by the OperatorManager
+// are cached and shared between tasks, so they must not be modified.
 type PeerWeight struct {
 	ChainId                config.ChainId
 	OperatorSetId          uint32
@@ -36,6 +41,7 @@ type OperatorManager struct {
 	config             *OperatorManagerConfig
 	contractCallers    map[config.ChainId]contractCaller.IContractCaller
 	peeringDataFetcher peering.IPeeringDataFetcher
+	cache              *operatorSetCache
 	logger             *zap.Logger
 }
 
@@ -50,6 +56,7 @@ func NewOperatorManager(
 		config:             cfg,
 		contractCallers:    ccs,
 		peeringDataFetcher: pdf,
+		cache:              newOperatorSetCache(cfg.AvsAddress, cfg.CacheSize),
 		logger:             logger,
 	}
 }
@@ -64,13 +71,53 @@ func (om *OperatorManager) GetCurveTypeForOperatorSet(avsAddress string, operato
 		return config.CurveTypeUnknown, err
 	}
 
-	return l1Cc.GetOperatorSetCurveType(avsAddress, operatorSetId, blockNumber)
+	key := curveTypeCacheKey{
+		avsAddress:    strings.ToLower(avsAddress),
+		operatorSetId: operatorSetId,
+		blockNumber:   blockNumber,
+	}
+	return om.cache.getCurveType(key, func() (config.CurveType, error) {
+		return l1Cc.GetOperatorSetCurveType(avsAddress, operatorSetId, blockNumber)
+	})
 }
 
+// GetExecutorPeersAndWeightsForTask resolves the executors and weights of the task's
+// operator set. Results are cached by operator set, chain, L1 reference block and
+// operator table reference timestamp, so tasks from the same block share one lookup.
 func (om *OperatorManager) GetExecutorPeersAndWeightsForTask(
 	ctx context.Context,
 	task *types.Task,
 	curveType config.CurveType,
+) (*PeerWeight, error) {
+	key := peerWeightCacheKey{
+		avsAddress:         strings.ToLower(task.AVSAddress),
+		operatorSetId:      task.OperatorSetId,
+		chainId:            task.ChainId,
+		curveType:          curveType,
+		l1BlockNumber:      task.L1ReferenceBlockNumber,
+		referenceTimestamp: task.ReferenceTimestamp,
+	}
+	return om.cache.getPeerWeight(ctx, key, func(ctx context.Context) (*PeerWeight, error) {
+		return om.fetchExecutorPeersAndWeightsForTask(ctx, task, curveType)
+	})
+}
+
+// InvalidateOperatorSet drops every cached snapshot of an operator set on a chain so
+// the next task re-reads it from chain
+func (om *OperatorManager) InvalidateOperatorSet(chainId config.ChainId, operatorSetId uint32) {
+	removed := om.cache.invalidateOperatorSet(chainId, operatorSetId)
+	om.logger.Sugar().Infow("Invalidated cached operator set",
+		zap.String("avsAddress", om.config.AvsAddress),
+		zap.Uint32("chainId", uint32(chainId)),
+		zap.Uint32("operatorSetId", operatorSetId),
+		zap.Int("removed", removed),
+	)
+}
+
+func (om *OperatorManager) fetchExecutorPeersAndWeightsForTask(
+	ctx context.Context,
+	task *types.Task,
+	curveType config.CurveType,
 ) (*PeerWeight, error) {
 	l1BlockForTableData := task.L1ReferenceBlockNumber
 
@@ -0,0 +1,125 @@
+package taskSession
+
+import (
+	"context"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	executorV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/executor"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SubmissionRetryPolicy controls how a task submission to a single executor is
+// retried. Retries are always bounded by the task session's deadline.
+type SubmissionRetryPolicy struct {
+	// InitialDelay is the base delay before the first retry
+	InitialDelay time.Duration
+	// MaxDelay caps the base delay between retries
+	MaxDelay time.Duration
+	// Multiplier is applied to the base delay after every retry
+	Multiplier float64
+	// MaxAttempts is the maximum number of attempts per executor, 0 means no
+	// limit other than the task deadline
+	MaxAttempts int
+}
+
+// DefaultSubmissionRetryPolicy returns the retry policy used by task sessions
+func DefaultSubmissionRetryPolicy() *SubmissionRetryPolicy {
+	return &SubmissionRetryPolicy{
+		InitialDelay: 250 * time.Millisecond,
+		MaxDelay:     5 * time.Second,
+		Multiplier:   2,
+		MaxAttempts:  0,
+	}
+}
+
+// backoff returns the delay to wait before retrying, using "equal jitter": half
+// of the base delay is fixed and the other half is random so executors that
+// restart together are not retried in lockstep.
+func (p *SubmissionRetryPolicy) backoff(base time.Duration) time.Duration {
+	half := base / 2
+	if half <= 0 {
+		return base
+	}
+	return half + rand.N(half+1)
+}
+
+func (p *SubmissionRetryPolicy) nextBase(base time.Duration) time.Duration {
+	next := time.Duration(float64(base) * p.Multiplier)
+	if next > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return next
+}
+
+// isRetryableSubmissionError reports whether an executor error is transient.
+// Rejections such as a failed signature check, an operator set mismatch or a
+// task that was already processed will not change on retry.
+func isRetryableSubmissionError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// submitWithRetry submits the task to an executor, retrying transient failures
+// with jittered exponential backoff until it succeeds, the error is permanent,
+// the attempts are exhausted, or the context is done. The context is cancelled
+// once the signing threshold is met, which stops any outstanding retries.
+func (ts *TaskSession[SigT, CertT, PubKeyT]) submitWithRetry(
+	ctx context.Context,
+	client executorV1.ExecutorServiceClient,
+	taskSubmission *executorV1.TaskSubmission,
+	operatorAddress string,
+) (*executorV1.TaskResult, error) {
+	policy := ts.retryPolicy
+	if policy == nil {
+		policy = DefaultSubmissionRetryPolicy()
+	}
+
+	base := policy.InitialDelay
+	for attempt := 1; ; attempt++ {
+		res, err := client.SubmitTask(ctx, taskSubmission)
+		if err == nil {
+			return res, nil
+		}
+		if ctx.Err() != nil || !isRetryableSubmissionError(err) {
+			return nil, err
+		}
+		if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+			return nil, err
+		}
+
+		delay := policy.backoff(base)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= delay {
+			return nil, err
+		}
+
+		ts.logger.Sugar().Warnw("Retrying task submission to executor",
+			zap.String("taskId", taskSubmission.TaskId),
+			zap.String("executorAddress", operatorAddress),
+			zap.Int("attempt", attempt),
+			zap.Duration("delay", delay),
+			zap.String("code", status.Code(err).String()),
+			zap.Error(err),
+		)
+		metrics.AggregatorTaskSubmissionRetries.
+			WithLabelValues(taskSubmission.AvsAddress, strings.ToLower(operatorAddress), status.Code(err).String()).
+			Inc()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		case <-timer.C:
+		}
+		base = policy.nextBase(base)
+	}
+}
@@ -0,0 +1,168 @@
+package taskSession
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/crypto-libs/pkg/bn254"
+	"github.com/Layr-Labs/crypto-libs/pkg/signing"
+	executorV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/executor"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/signing/aggregation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// scriptedExecutorClient returns the scripted errors in order, then succeeds
+type scriptedExecutorClient struct {
+	mu       sync.Mutex
+	errs     []error
+	attempts int
+}
+
+func (c *scriptedExecutorClient) SubmitTask(ctx context.Context, taskSubmission *executorV1.TaskSubmission, opts ...grpc.CallOption) (*executorV1.TaskResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.attempts++
+	if len(c.errs) > 0 {
+		err := c.errs[0]
+		c.errs = c.errs[1:]
+		return nil, err
+	}
+	return &executorV1.TaskResult{TaskId: taskSubmission.TaskId}, nil
+}
+
+func (c *scriptedExecutorClient) Attempts() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.attempts
+}
+
+func newRetryTestSession(t *testing.T, policy *SubmissionRetryPolicy) *TaskSession[bn254.Signature, aggregation.AggregatedBN254Certificate, signing.PublicKey] {
+	return &TaskSession[bn254.Signature, aggregation.AggregatedBN254Certificate, signing.PublicKey]{
+		logger:      zaptest.NewLogger(t),
+		retryPolicy: policy,
+	}
+}
+
+func fastRetryPolicy() *SubmissionRetryPolicy {
+	return &SubmissionRetryPolicy{
+		InitialDelay: time.Millisecond,
+		MaxDelay:     5 * time.Millisecond,
+		Multiplier:   2,
+	}
+}
+
+func TestTaskSession_SubmitWithRetry(t *testing.T) {
+	submission := &executorV1.TaskSubmission{TaskId: "task-1", AvsAddress: "0xavs"}
+
+	t.Run("retries transient errors until the executor answers", func(t *testing.T) {
+		ts := newRetryTestSession(t, fastRetryPolicy())
+		client := &scriptedExecutorClient{errs: []error{
+			status.Error(codes.Unavailable, "connection refused"),
+			status.Error(codes.ResourceExhausted, "busy"),
+			status.Error(codes.DeadlineExceeded, "attempt timed out"),
+		}}
+
+		res, err := ts.submitWithRetry(context.Background(), client, submission, "0xoperator")
+		require.NoError(t, err)
+		assert.Equal(t, "task-1", res.TaskId)
+		assert.Equal(t, 4, client.Attempts())
+	})
+
+	t.Run("does not retry permanent rejections", func(t *testing.T) {
+		for _, code := range []codes.Code{codes.Unauthenticated, codes.AlreadyExists, codes.PermissionDenied, codes.InvalidArgument, codes.Internal} {
+			ts := newRetryTestSession(t, fastRetryPolicy())
+			client := &scriptedExecutorClient{errs: []error{status.Error(code, "rejected")}}
+
+			_, err := ts.submitWithRetry(context.Background(), client, submission, "0xoperator")
+			assert.Equal(t, code, status.Code(err))
+			assert.Equal(t, 1, client.Attempts(), "code %s should not be retried", code)
+		}
+	})
+
+	t.Run("stops after the maximum number of attempts", func(t *testing.T) {
+		policy := fastRetryPolicy()
+		policy.MaxAttempts = 2
+		ts := newRetryTestSession(t, policy)
+		client := &scriptedExecutorClient{errs: []error{
+			status.Error(codes.Unavailable, "down"),
+			status.Error(codes.Unavailable, "down"),
+			status.Error(codes.Unavailable, "down"),
+		}}
+
+		_, err := ts.submitWithRetry(context.Background(), client, submission, "0xoperator")
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, 2, client.Attempts())
+	})
+
+	t.Run("does not retry past the task deadline", func(t *testing.T) {
+		ts := newRetryTestSession(t, &SubmissionRetryPolicy{
+			InitialDelay: time.Second,
+			MaxDelay:     time.Second,
+			Multiplier:   2,
+		})
+		client := &scriptedExecutorClient{errs: []error{status.Error(codes.Unavailable, "down")}}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := ts.submitWithRetry(ctx, client, submission, "0xoperator")
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, 1, client.Attempts())
+		assert.Less(t, time.Since(start), 100*time.Millisecond)
+	})
+
+	t.Run("stops retrying when the submission context is cancelled", func(t *testing.T) {
+		ts := newRetryTestSession(t, &SubmissionRetryPolicy{
+			InitialDelay: time.Second,
+			MaxDelay:     time.Second,
+			Multiplier:   2,
+		})
+		client := &scriptedExecutorClient{errs: []error{
+			status.Error(codes.Unavailable, "down"),
+			status.Error(codes.Unavailable, "down"),
+		}}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			cancel()
+		}()
+
+		start := time.Now()
+		_, err := ts.submitWithRetry(ctx, client, submission, "0xoperator")
+		require.Error(t, err)
+		assert.Equal(t, 1, client.Attempts())
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("classifies wrapped client errors by gRPC code", func(t *testing.T) {
+		wrapped := errors.Join(errors.New("request failed after 1 attempts"), status.Error(codes.Unavailable, "down"))
+		assert.True(t, isRetryableSubmissionError(wrapped))
+		assert.False(t, isRetryableSubmissionError(errors.New("plain error")))
+	})
+}
+
+func TestSubmissionRetryPolicy_Backoff(t *testing.T) {
+	policy := DefaultSubmissionRetryPolicy()
+
+	for i := 0; i < 100; i++ {
+		delay := policy.backoff(time.Second)
+		assert.GreaterOrEqual(t, delay, 500*time.Millisecond)
+		assert.LessOrEqual(t, delay, time.Second)
+	}
+
+	base := policy.InitialDelay
+	for i := 0; i < 10; i++ {
+		base = policy.nextBase(base)
+	}
+	assert.Equal(t, policy.MaxDelay, base)
+}
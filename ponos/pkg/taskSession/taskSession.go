@@ -5,13 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/Layr-Labs/crypto-libs/pkg/bn254"
 	"github.com/Layr-Labs/crypto-libs/pkg/ecdsa"
 	"github.com/Layr-Labs/crypto-libs/pkg/signing"
 	executorV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/executor"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/clients/executorClient"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contractCaller"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/operatorManager"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/peering"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/signer"
@@ -19,7 +24,10 @@ import (
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/util"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const maximumTaskResponseSize = 1.5 * 1024 * 1024
@@ -33,6 +41,12 @@ type TaskSession[SigT, CertT, PubKeyT any] struct {
 	taskAggregator      aggregation.ITaskResultAggregator[SigT, CertT, PubKeyT]
 	aggregatorAddress   string
 	tlsEnabled          bool
+	executorClients     *executorClient.ExecutorClientPool
+	retryPolicy         *SubmissionRetryPolicy
+
+	// responses tracks how each operator's result was handled, keyed by lowercased operator address
+	responsesMu sync.Mutex
+	responses   map[string]*storage.OperatorResponse
 }
 
 func NewBN254TaskSession(
@@ -43,6 +57,7 @@ func NewBN254TaskSession(
 	signer signer.ISigner,
 	operatorPeersWeight *operatorManager.PeerWeight,
 	tlsEnabled bool,
+	executorClients *executorClient.ExecutorClientPool,
 	logger *zap.Logger,
 ) (*TaskSession[bn254.Signature, aggregation.AggregatedBN254Certificate, signing.PublicKey], error) {
 	operators := make([]*aggregation.Operator[signing.PublicKey], 0)
@@ -89,6 +104,9 @@ func NewBN254TaskSession(
 		taskAggregator:      ta,
 		operatorPeersWeight: operatorPeersWeight,
 		tlsEnabled:          tlsEnabled,
+		executorClients:     executorClients,
+		retryPolicy:         DefaultSubmissionRetryPolicy(),
+		responses:           make(map[string]*storage.OperatorResponse),
 	}
 
 	return ts, nil
@@ -102,6 +120,7 @@ func NewECDSATaskSession(
 	signer signer.ISigner,
 	operatorPeersWeight *operatorManager.PeerWeight,
 	tlsEnabled bool,
+	executorClients *executorClient.ExecutorClientPool,
 	logger *zap.Logger,
 ) (*TaskSession[ecdsa.Signature, aggregation.AggregatedECDSACertificate, common.Address], error) {
 	operators := make([]*aggregation.Operator[common.Address], 0)
@@ -147,6 +166,9 @@ func NewECDSATaskSession(
 		taskAggregator:      ta,
 		operatorPeersWeight: operatorPeersWeight,
 		tlsEnabled:          tlsEnabled,
+		executorClients:     executorClients,
+		retryPolicy:         DefaultSubmissionRetryPolicy(),
+		responses:           make(map[string]*storage.OperatorResponse),
 	}
 
 	return ts, nil
@@ -209,17 +231,20 @@ func (ts *TaskSession[SigT, CertT, PubKeyT]) Broadcast() (*CertT, error) {
 					zap.String("operatorAddress", peer.OperatorAddress),
 					zap.Error(err),
 				)
+				ts.recordFailure(peer.OperatorAddress, storage.OperatorResponseFailed, err)
 				return
 			}
-			c, err := executorClient.NewExecutorClient(socket, ts.tlsEnabled)
+			c, release, err := ts.acquireExecutorClient(peer.OperatorAddress, socket)
 			if err != nil {
 				ts.logger.Sugar().Errorw("Failed to create executor client",
 					zap.String("executorAddress", peer.OperatorAddress),
 					zap.String("taskId", ts.Task.TaskId),
 					zap.Error(err),
 				)
+				ts.recordFailure(peer.OperatorAddress, storage.OperatorResponseFailed, err)
 				return
 			}
+			defer release()
 
 			ts.logger.Sugar().Infow("broadcasting task to operator",
 				zap.String("taskId", ts.Task.TaskId),
@@ -234,6 +259,7 @@ func (ts *TaskSession[SigT, CertT, PubKeyT]) Broadcast() (*CertT, error) {
 					zap.String("executorAddress", peer.OperatorAddress),
 					zap.Error(err),
 				)
+				ts.recordFailure(peer.OperatorAddress, storage.OperatorResponseFailed, err)
 				return
 			}
 
@@ -254,10 +280,11 @@ func (ts *TaskSession[SigT, CertT, PubKeyT]) Broadcast() (*CertT, error) {
 				zap.Any("operatorPeers", ts.operatorPeersWeight.Operators),
 			)
 
-			res, err := c.SubmitTask(submissionContext, taskSubmission)
+			submittedAt := time.Now()
+			res, err := ts.submitWithRetry(submissionContext, c, taskSubmission, peer.OperatorAddress)
 			if err != nil {
 
-				if err.Error() == context.Canceled.Error() {
+				if errors.Is(err, context.Canceled) || status.Code(err) == codes.Canceled {
 					ts.logger.Sugar().Infow("task session submission cancelled")
 					return
 				}
@@ -268,6 +295,7 @@ func (ts *TaskSession[SigT, CertT, PubKeyT]) Broadcast() (*CertT, error) {
 					zap.String("taskId", ts.Task.TaskId),
 					zap.Error(err),
 				)
+				ts.recordFailure(peer.OperatorAddress, storage.OperatorResponseFailed, err)
 				return
 			}
 
@@ -277,6 +305,8 @@ func (ts *TaskSession[SigT, CertT, PubKeyT]) Broadcast() (*CertT, error) {
 					zap.String("expected", peer.OperatorAddress),
 					zap.String("claimed", res.OperatorAddress),
 				)
+				ts.recordFailure(peer.OperatorAddress, storage.OperatorResponseRejected,
+					fmt.Errorf("response claimed to be from operator %s", res.OperatorAddress))
 				return
 			}
 
@@ -285,7 +315,12 @@ func (ts *TaskSession[SigT, CertT, PubKeyT]) Broadcast() (*CertT, error) {
 				zap.String("operatorAddress", peer.OperatorAddress),
 				zap.Any("result", res),
 			)
+			latency := time.Since(submittedAt)
+			metrics.AggregatorSignatureCollectionSeconds.
+				WithLabelValues(ts.Task.AVSAddress, strings.ToLower(peer.OperatorAddress)).
+				Observe(latency.Seconds())
 			tr := types.TaskResultFromTaskResultProto(res)
+			ts.recordReceived(tr, latency)
 			outputSize := len(tr.Output)
 			if outputSize >= maximumTaskResponseSize {
 				ts.logger.Sugar().Errorw("dropping response exceeding maximum output size",
@@ -293,6 +328,8 @@ func (ts *TaskSession[SigT, CertT, PubKeyT]) Broadcast() (*CertT, error) {
 					zap.Int("size", outputSize),
 					zap.Int("maximum", maximumTaskResponseSize),
 				)
+				ts.recordFailure(peer.OperatorAddress, storage.OperatorResponseRejected,
+					fmt.Errorf("output size %d exceeds maximum %d", outputSize, int(maximumTaskResponseSize)))
 				return
 			}
 
@@ -320,8 +357,10 @@ func (ts *TaskSession[SigT, CertT, PubKeyT]) Broadcast() (*CertT, error) {
 					zap.String("operatorAddress", taskResult.OperatorAddress),
 					zap.Error(err),
 				)
+				ts.recordFailure(taskResult.OperatorAddress, storage.OperatorResponseRejected, err)
 				continue
 			}
+			ts.recordAccepted(taskResult.OperatorAddress)
 			ts.logger.Sugar().Infow("task result processed, checking signing threshold",
 				zap.String("taskId", taskResult.TaskId),
 				zap.String("operatorAddress", taskResult.OperatorAddress),
@@ -361,6 +400,106 @@ func (ts *TaskSession[SigT, CertT, PubKeyT]) Broadcast() (*CertT, error) {
 	}
 }
 
+// RespondingOperators returns the operators whose results were accepted. It must
+// only be called once Process has returned.
+func (ts *TaskSession[SigT, CertT, PubKeyT]) RespondingOperators() []string {
+	ts.responsesMu.Lock()
+	defer ts.responsesMu.Unlock()
+
+	var operators []string
+	for _, peer := range ts.operatorPeersWeight.Operators {
+		if r, ok := ts.responses[strings.ToLower(peer.OperatorAddress)]; ok && r.Status == storage.OperatorResponseAccepted {
+			operators = append(operators, r.OperatorAddress)
+		}
+	}
+	return operators
+}
+
+// Responses returns how each operator in the set responded to the task. Operators
+// whose result had not been aggregated by the time it is called are reported as
+// late, so it should only be called once Process has returned.
+func (ts *TaskSession[SigT, CertT, PubKeyT]) Responses() []*storage.OperatorResponse {
+	ts.responsesMu.Lock()
+	defer ts.responsesMu.Unlock()
+
+	responses := make([]*storage.OperatorResponse, 0, len(ts.operatorPeersWeight.Operators))
+	for _, peer := range ts.operatorPeersWeight.Operators {
+		r, ok := ts.responses[strings.ToLower(peer.OperatorAddress)]
+		if !ok {
+			responses = append(responses, &storage.OperatorResponse{
+				TaskId:          ts.Task.TaskId,
+				OperatorAddress: peer.OperatorAddress,
+				Status:          storage.OperatorResponseLate,
+			})
+			continue
+		}
+		response := *r
+		// a result that was received but never made it through aggregation arrived too late
+		if response.Status == "" {
+			response.Status = storage.OperatorResponseLate
+		}
+		responses = append(responses, &response)
+	}
+	return responses
+}
+
+// recordReceived tracks a result as soon as it is received from an executor
+func (ts *TaskSession[SigT, CertT, PubKeyT]) recordReceived(result *types.TaskResult, latency time.Duration) {
+	ts.responsesMu.Lock()
+	defer ts.responsesMu.Unlock()
+
+	outputDigest := util.GetKeccak256Digest(result.Output)
+	ts.responses[strings.ToLower(result.OperatorAddress)] = &storage.OperatorResponse{
+		TaskId:          ts.Task.TaskId,
+		OperatorAddress: result.OperatorAddress,
+		Result:          result,
+		OutputDigest:    hexutil.Encode(outputDigest[:]),
+		ReceivedAt:      time.Now(),
+		Latency:         latency,
+	}
+}
+
+// recordAccepted marks a received result as included in the aggregation
+func (ts *TaskSession[SigT, CertT, PubKeyT]) recordAccepted(operatorAddress string) {
+	ts.responsesMu.Lock()
+	defer ts.responsesMu.Unlock()
+
+	if r, ok := ts.responses[strings.ToLower(operatorAddress)]; ok {
+		r.Status = storage.OperatorResponseAccepted
+	}
+}
+
+// recordFailure marks an operator's response as rejected or failed, keeping any result already received
+func (ts *TaskSession[SigT, CertT, PubKeyT]) recordFailure(operatorAddress string, status storage.OperatorResponseStatus, err error) {
+	ts.responsesMu.Lock()
+	defer ts.responsesMu.Unlock()
+
+	key := strings.ToLower(operatorAddress)
+	r, ok := ts.responses[key]
+	if !ok {
+		r = &storage.OperatorResponse{
+			TaskId:          ts.Task.TaskId,
+			OperatorAddress: operatorAddress,
+		}
+		ts.responses[key] = r
+	}
+	r.Status = status
+	r.Error = err.Error()
+}
+
+// acquireExecutorClient returns a client for the operator's executor from the shared
+// connection pool. Without a pool, a dedicated connection is dialed and closed on release.
+func (ts *TaskSession[SigT, CertT, PubKeyT]) acquireExecutorClient(operatorAddress string, socket string) (executorV1.ExecutorServiceClient, func(), error) {
+	if ts.executorClients != nil {
+		return ts.executorClients.Acquire(operatorAddress, socket)
+	}
+	conn, err := clients.NewGrpcClientWithRetry(socket, ts.tlsEnabled, executorClient.SubmitTaskRetryConfig())
+	if err != nil {
+		return nil, nil, err
+	}
+	return executorV1.NewExecutorServiceClient(conn), func() { _ = conn.Close() }, nil
+}
+
 func (ts *TaskSession[SigT, CertT, PubKeyT]) generateSignatureForExecutor(executorAddress string) ([]byte, error) {
 	encodedMessage, err := util.EncodeTaskSubmissionMessageVersioned(
 		ts.Task.TaskId,
@@ -10,6 +10,7 @@ import (
 	"github.com/Layr-Labs/crypto-libs/pkg/bn254"
 	"github.com/Layr-Labs/crypto-libs/pkg/ecdsa"
 	executorV1 "github.com/Layr-Labs/hourglass-monorepo/ponos/gen/protos/eigenlayer/hourglass/v1/executor"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/contractCaller/caller"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/operatorManager"
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/peering"
@@ -227,7 +228,7 @@ func TestNewBN254TaskSession(t *testing.T) {
 
 		session, err := NewBN254TaskSession(
 			ctx, task, &caller.ContractCaller{}, "0xaggregator", createMockSigner(),
-			operatorPeersWeight, false, logger,
+			operatorPeersWeight, false, nil, logger,
 		)
 
 		require.NoError(t, err)
@@ -257,7 +258,7 @@ func TestNewBN254TaskSession(t *testing.T) {
 
 		session, err := NewBN254TaskSession(
 			ctx, task, &caller.ContractCaller{}, "0xaggregator", createMockSigner(),
-			operatorPeersWeight, false, logger,
+			operatorPeersWeight, false, nil, logger,
 		)
 
 		require.Error(t, err)
@@ -284,7 +285,7 @@ func TestNewECDSATaskSession(t *testing.T) {
 
 		session, err := NewECDSATaskSession(
 			ctx, task, &caller.ContractCaller{}, "0xaggregator", createMockSigner(),
-			operatorPeersWeight, false, logger,
+			operatorPeersWeight, false, nil, logger,
 		)
 
 		require.NoError(t, err)
@@ -314,7 +315,7 @@ func TestTaskSession_Process_ContextHandling(t *testing.T) {
 
 		session, err := NewBN254TaskSession(
 			ctx, task, &caller.ContractCaller{}, "0xaggregator", createMockSigner(),
-			operatorPeersWeight, false, logger,
+			operatorPeersWeight, false, nil, logger,
 		)
 		require.NoError(t, err)
 
@@ -441,7 +442,7 @@ func TestTaskSession_Process_ContextHandling(t *testing.T) {
 
 		session, err := NewBN254TaskSession(
 			ctx, task, &caller.ContractCaller{}, "0xaggregator", createMockSigner(),
-			operatorPeersWeight, false, logger,
+			operatorPeersWeight, false, nil, logger,
 		)
 		require.NoError(t, err)
 
@@ -473,7 +474,7 @@ func TestTaskSession_BasicFunctionality(t *testing.T) {
 
 		session, err := NewBN254TaskSession(
 			ctx, task, &caller.ContractCaller{}, "0xaggregator", createMockSigner(),
-			operatorPeersWeight, false, logger,
+			operatorPeersWeight, false, nil, logger,
 		)
 		require.NoError(t, err)
 
@@ -504,7 +505,7 @@ func TestTaskSession_BasicFunctionality(t *testing.T) {
 
 		session, err := NewECDSATaskSession(
 			ctx, task, &caller.ContractCaller{}, "0xaggregator", createMockSigner(),
-			operatorPeersWeight, false, logger,
+			operatorPeersWeight, false, nil, logger,
 		)
 		require.NoError(t, err)
 
@@ -540,7 +541,7 @@ func TestTaskSession_DeadlockScenario(t *testing.T) {
 
 		session, err := NewBN254TaskSession(
 			ctx, task, &caller.ContractCaller{}, "0xaggregator", createMockSigner(),
-			operatorPeersWeight, false, logger,
+			operatorPeersWeight, false, nil, logger,
 		)
 		require.NoError(t, err)
 
@@ -592,7 +593,7 @@ func TestTaskSession_DeadlockScenario(t *testing.T) {
 
 		session, err := NewBN254TaskSession(
 			ctx, task, &caller.ContractCaller{}, "0xaggregator", createMockSigner(),
-			operatorPeersWeight, false, logger,
+			operatorPeersWeight, false, nil, logger,
 		)
 		require.NoError(t, err)
 
@@ -634,7 +635,7 @@ func TestTaskSession_DeadlockScenario(t *testing.T) {
 
 		session, err := NewBN254TaskSession(
 			ctx, task, &caller.ContractCaller{}, "0xaggregator", createMockSigner(),
-			operatorPeersWeight, false, logger,
+			operatorPeersWeight, false, nil, logger,
 		)
 		require.NoError(t, err)
 
@@ -652,6 +653,16 @@ func TestTaskSession_DeadlockScenario(t *testing.T) {
 		assert.NotNil(t, session.taskAggregator, "Task aggregator should remain accessible")
 		assert.Equal(t, "0xaggregator", session.aggregatorAddress,
 			"Aggregator address should remain unchanged")
+
+		// Every operator is accounted for even though none responded
+		responses := session.Responses()
+		require.Len(t, responses, 3)
+		for _, response := range responses {
+			assert.Equal(t, task.TaskId, response.TaskId)
+			assert.Contains(t, []storage.OperatorResponseStatus{storage.OperatorResponseFailed, storage.OperatorResponseLate}, response.Status)
+			assert.Nil(t, response.Result)
+		}
+		assert.Empty(t, session.RespondingOperators())
 	})
 }
 
@@ -730,7 +741,7 @@ func TestTaskSession_MockIntegration(t *testing.T) {
 		// This should fail because operator set 999 doesn't exist
 		session, err := NewBN254TaskSession(
 			ctx, task, &caller.ContractCaller{}, "0xaggregator", createMockSigner(),
-			operatorPeersWeight, false, logger,
+			operatorPeersWeight, false, nil, logger,
 		)
 
 		require.Error(t, err)
@@ -850,7 +861,7 @@ func TestTaskSession_EdgeCases(t *testing.T) {
 
 		session, err := NewBN254TaskSession(
 			ctx, task, &caller.ContractCaller{}, "0xaggregator", createMockSigner(),
-			operatorPeersWeight, false, logger,
+			operatorPeersWeight, false, nil, logger,
 		)
 
 		// Should return error due to no operators
@@ -875,7 +886,7 @@ func TestTaskSession_EdgeCases(t *testing.T) {
 
 		session, err := NewBN254TaskSession(
 			ctx, task, &caller.ContractCaller{}, "0xaggregator", createMockSigner(),
-			operatorPeersWeight, false, logger,
+			operatorPeersWeight, false, nil, logger,
 		)
 
 		// Should return error due to invalid threshold
@@ -901,7 +912,7 @@ func TestTaskSession_EdgeCases(t *testing.T) {
 
 		session, err := NewBN254TaskSession(
 			ctx, task, &caller.ContractCaller{}, "0xaggregator", createMockSigner(),
-			operatorPeersWeight, false, logger,
+			operatorPeersWeight, false, nil, logger,
 		)
 
 		// Should successfully create session with 100% threshold
@@ -929,7 +940,7 @@ func TestTaskSession_TaskValidation(t *testing.T) {
 
 		session, err := NewBN254TaskSession(
 			ctx, task, &caller.ContractCaller{}, "0xaggregator", createMockSigner(),
-			operatorPeersWeight, false, logger,
+			operatorPeersWeight, false, nil, logger,
 		)
 		require.NoError(t, err)
 
@@ -960,7 +971,7 @@ func TestTaskSession_TaskValidation(t *testing.T) {
 
 		bn254Session, err := NewBN254TaskSession(
 			ctx, task, &caller.ContractCaller{}, "0xaggregator", createMockSigner(),
-			operatorPeersWeight, false, logger,
+			operatorPeersWeight, false, nil, logger,
 		)
 		require.NoError(t, err)
 		require.NotNil(t, bn254Session)
@@ -976,7 +987,7 @@ func TestTaskSession_TaskValidation(t *testing.T) {
 
 		ecdsaSession, err := NewECDSATaskSession(
 			ctx, task, &caller.ContractCaller{}, "0xaggregator", createMockSigner(),
-			ecdsaOperatorPeersWeight, false, logger,
+			ecdsaOperatorPeersWeight, false, nil, logger,
 		)
 		require.NoError(t, err)
 		require.NotNil(t, ecdsaSession)
@@ -1006,7 +1017,7 @@ func TestTaskSession_TLSConfiguration(t *testing.T) {
 		// Test with secure connections (tlsEnabled = false)
 		secureSession, err := NewBN254TaskSession(
 			ctx, task, &caller.ContractCaller{}, "0xaggregator", createMockSigner(),
-			operatorPeersWeight, false, logger,
+			operatorPeersWeight, false, nil, logger,
 		)
 		require.NoError(t, err)
 		require.NotNil(t, secureSession)
@@ -1031,7 +1042,7 @@ func TestTaskSession_TLSConfiguration(t *testing.T) {
 		// Test with insecure connections (tlsEnabled = true)
 		insecureSession, err := NewECDSATaskSession(
 			ctx, task, &caller.ContractCaller{}, "0xaggregator", createMockSigner(),
-			operatorPeersWeight, true, logger,
+			operatorPeersWeight, true, nil, logger,
 		)
 		require.NoError(t, err)
 		require.NotNil(t, insecureSession)
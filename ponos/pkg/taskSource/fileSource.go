@@ -0,0 +1,162 @@
+package taskSource
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.uber.org/zap"
+)
+
+// FileSourceName is the source of tasks read from a file
+const FileSourceName = "file"
+
+const defaultFilePollInterval = time.Second
+
+// FileTask is a line of the file a FileSource reads
+type FileTask struct {
+	OperatorSetId uint32 `json:"operatorSetId"`
+	// Payload is hex encoded
+	Payload              string `json:"payload"`
+	ChainId              uint   `json:"chainId,omitempty"`
+	ReferenceBlockNumber uint64 `json:"referenceBlockNumber,omitempty"`
+	TimeoutSeconds       int    `json:"timeoutSeconds,omitempty"`
+}
+
+type FileSourceConfig struct {
+	Path         string
+	PollInterval time.Duration
+}
+
+// FileSource reads off-chain tasks from a JSON lines file, one FileTask per line, and
+// picks up lines appended while it runs, so the file works as a simple queue for
+// testing. Each line becomes one task: its ID is derived from the line's position,
+// so lines read again after a restart do not create the task again.
+type FileSource struct {
+	config *FileSourceConfig
+	sink   *TaskSink
+	logger *zap.Logger
+
+	// offset is the position in the file after the last line handled
+	offset int64
+}
+
+func NewFileSource(cfg *FileSourceConfig, sink *TaskSink, logger *zap.Logger) *FileSource {
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaultFilePollInterval
+	}
+	return &FileSource{
+		config: cfg,
+		sink:   sink,
+		logger: logger,
+	}
+}
+
+func (fs *FileSource) Name() string {
+	return FileSourceName
+}
+
+func (fs *FileSource) Start(ctx context.Context) error {
+	fs.logger.Sugar().Infow("Reading tasks from file",
+		zap.String("path", fs.config.Path),
+		zap.Duration("pollInterval", fs.config.PollInterval),
+	)
+	go fs.run(ctx)
+	return nil
+}
+
+func (fs *FileSource) run(ctx context.Context) {
+	ticker := time.NewTicker(fs.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := fs.readNewLines(ctx); err != nil {
+			fs.logger.Sugar().Warnw("Failed to read tasks from file",
+				"path", fs.config.Path,
+				"error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// readNewLines submits the complete lines written since the last read. A line that
+// does not fit in the task queue is left for the next read.
+func (fs *FileSource) readNewLines(ctx context.Context) error {
+	file, err := os.Open(fs.config.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if _, err := file.Seek(fs.offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(file)
+	for ctx.Err() == nil {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			// a line without a newline is still being written
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		if strings.TrimSpace(line) != "" {
+			err := fs.submitLine(ctx, line)
+			if errors.Is(err, ErrQueueFull) {
+				return nil
+			}
+			if err != nil {
+				fs.logger.Sugar().Warnw("Skipping task line",
+					"path", fs.config.Path,
+					"offset", fs.offset,
+					"error", err)
+			}
+		}
+		fs.offset += int64(len(line))
+	}
+	return nil
+}
+
+func (fs *FileSource) submitLine(ctx context.Context, line string) error {
+	var fileTask FileTask
+	if err := json.Unmarshal([]byte(line), &fileTask); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidTask, err)
+	}
+	payload, err := hexutil.Decode(fileTask.Payload)
+	if err != nil {
+		return fmt.Errorf("%w: payload must be 0x-prefixed hex: %v", ErrInvalidTask, err)
+	}
+
+	req := &TaskRequest{
+		OperatorSetId:        fileTask.OperatorSetId,
+		Payload:              payload,
+		ChainId:              config.ChainId(fileTask.ChainId),
+		ReferenceBlockNumber: fileTask.ReferenceBlockNumber,
+		IdempotencyKey:       fmt.Sprintf("%s@%d", fs.config.Path, fs.offset),
+	}
+	if fileTask.TimeoutSeconds > 0 {
+		req.Deadline = time.Now().Add(time.Duration(fileTask.TimeoutSeconds) * time.Second)
+	}
+
+	_, err = fs.sink.Submit(ctx, FileSourceName, req)
+	return err
+}
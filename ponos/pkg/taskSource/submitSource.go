@@ -0,0 +1,49 @@
+package taskSource
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+)
+
+// SubmitSourceName is the source of tasks submitted through the SubmitTask management RPC
+const SubmitSourceName = "submit"
+
+// SubmitSource accepts off-chain tasks submitted through the authenticated SubmitTask
+// management RPC, e.g. by a trusted service that posts the results itself later
+type SubmitSource struct {
+	sink *TaskSink
+
+	mu  sync.RWMutex
+	ctx context.Context
+}
+
+func NewSubmitSource(sink *TaskSink) *SubmitSource {
+	return &SubmitSource{sink: sink}
+}
+
+func (ss *SubmitSource) Name() string {
+	return SubmitSourceName
+}
+
+// Start accepts submitted tasks until ctx is done. Tasks are bound to ctx rather
+// than to the request that submitted them.
+func (ss *SubmitSource) Start(ctx context.Context) error {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	ss.ctx = ctx
+	return nil
+}
+
+// Submit creates a task from req and queues it, see TaskSink.Submit
+func (ss *SubmitSource) Submit(req *TaskRequest) (*types.Task, error) {
+	ss.mu.RLock()
+	ctx := ss.ctx
+	ss.mu.RUnlock()
+
+	if ctx == nil || ctx.Err() != nil {
+		return nil, ErrNotStarted
+	}
+	return ss.sink.Submit(ctx, SubmitSourceName, req)
+}
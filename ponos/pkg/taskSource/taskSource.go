@@ -0,0 +1,245 @@
+package taskSource
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/metrics"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go.uber.org/zap"
+)
+
+// TaskSource produces the tasks of an AVS onto its task queue. The chain poller is the
+// source of the tasks created on chain; other sources create off-chain tasks through
+// a TaskSink.
+type TaskSource interface {
+	// Name identifies the source in logs and metrics
+	Name() string
+
+	// Start starts producing tasks and returns once the source is running. The
+	// source stops when ctx is done.
+	Start(ctx context.Context) error
+}
+
+const (
+	DefaultTaskTimeout = 60 * time.Second
+	DefaultMaxTimeout  = time.Hour
+)
+
+var (
+	// ErrInvalidTask is returned for task requests that cannot become a task
+	ErrInvalidTask = errors.New("invalid task")
+
+	// ErrNoReferenceBlock is returned when a task has no reference block and the
+	// aggregator has not processed a block of the task's chain yet
+	ErrNoReferenceBlock = errors.New("no reference block")
+
+	// ErrQueueFull is returned when the task queue of the AVS has no room for the task
+	ErrQueueFull = errors.New("task queue is full")
+
+	// ErrNotStarted is returned when a task is submitted to a source that is not running
+	ErrNotStarted = errors.New("task source is not running")
+)
+
+// TaskRequest describes an off-chain task
+type TaskRequest struct {
+	OperatorSetId uint32
+	Payload       []byte
+
+	// ChainId is the chain whose TaskMailbox holds the executor operator set config
+	// of the task. Defaults to the sink's default chain
+	ChainId config.ChainId
+
+	// ReferenceBlockNumber is the block of ChainId the operator set config and
+	// members are read at. Defaults to the last block the aggregator processed
+	ReferenceBlockNumber uint64
+
+	// Deadline defaults to the sink's default timeout from now
+	Deadline time.Time
+
+	// IdempotencyKey makes a request submitted again return the task it created the
+	// first time. Without one, every request creates a new task
+	IdempotencyKey string
+}
+
+type TaskSinkConfig struct {
+	AvsAddress string
+
+	// ChainIds are the chains tasks of the AVS can reference
+	ChainIds []config.ChainId
+
+	// DefaultChainId is the chain of requests that do not name one
+	DefaultChainId config.ChainId
+
+	// DefaultTimeout is the deadline, from submission, of requests without one
+	DefaultTimeout time.Duration
+
+	// MaxTimeout caps how far in the future a deadline can be
+	MaxTimeout time.Duration
+}
+
+// TaskSink turns the requests of off-chain task sources into pending tasks on the
+// task queue the chain pollers of the AVS feed
+type TaskSink struct {
+	config    *TaskSinkConfig
+	store     storage.AggregatorStore
+	taskQueue chan *types.Task
+	logger    *zap.Logger
+}
+
+func NewTaskSink(
+	cfg *TaskSinkConfig,
+	store storage.AggregatorStore,
+	taskQueue chan *types.Task,
+	logger *zap.Logger,
+) *TaskSink {
+	if cfg.DefaultTimeout <= 0 {
+		cfg.DefaultTimeout = DefaultTaskTimeout
+	}
+	if cfg.MaxTimeout <= 0 {
+		cfg.MaxTimeout = DefaultMaxTimeout
+	}
+	return &TaskSink{
+		config:    cfg,
+		store:     store,
+		taskQueue: taskQueue,
+		logger:    logger,
+	}
+}
+
+// Submit stores the task described by req as pending and queues it for processing.
+// ctx bounds the task: when it is done the task is cancelled. Submit does not wait
+// for room in the task queue; if the queue is full it returns ErrQueueFull and
+// nothing is stored. A request with the IdempotencyKey of an earlier request
+// returns the task created for the earlier one.
+func (ts *TaskSink) Submit(ctx context.Context, source string, req *TaskRequest) (*types.Task, error) {
+	task, err := ts.newTask(ctx, source, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing, err := ts.store.GetTask(ctx, task.TaskId); err == nil {
+		return existing, nil
+	} else if !errors.Is(err, storage.ErrNotFound) {
+		return nil, fmt.Errorf("failed to look up task %s: %w", task.TaskId, err)
+	}
+
+	if len(ts.taskQueue) == cap(ts.taskQueue) {
+		return nil, ErrQueueFull
+	}
+	if err := ts.store.SavePendingTask(ctx, task); err != nil {
+		return nil, fmt.Errorf("failed to save task %s: %w", task.TaskId, err)
+	}
+
+	select {
+	case ts.taskQueue <- task:
+	default:
+		if err := ts.store.DeleteTask(ctx, task.TaskId); err != nil {
+			ts.logger.Sugar().Warnw("Failed to delete task that did not fit in the task queue",
+				"taskId", task.TaskId,
+				"error", err)
+		}
+		return nil, ErrQueueFull
+	}
+
+	ts.logger.Sugar().Infow("Accepted off-chain task",
+		zap.String("taskId", task.TaskId),
+		zap.String("source", source),
+		zap.String("avsAddress", task.AVSAddress),
+		zap.Uint32("operatorSetId", task.OperatorSetId),
+		zap.Uint("chainId", uint(task.ChainId)),
+		zap.Uint64("referenceBlockNumber", task.SourceBlockNumber),
+	)
+	metrics.AggregatorTasksSubmitted.WithLabelValues(ts.config.AvsAddress, source).Inc()
+	metrics.AggregatorTasksQueued.WithLabelValues(ts.config.AvsAddress).Set(float64(len(ts.taskQueue)))
+	return task, nil
+}
+
+func (ts *TaskSink) newTask(ctx context.Context, source string, req *TaskRequest) (*types.Task, error) {
+	if len(req.Payload) == 0 {
+		return nil, fmt.Errorf("%w: payload is required", ErrInvalidTask)
+	}
+
+	chainId := req.ChainId
+	if chainId == 0 {
+		chainId = ts.config.DefaultChainId
+	}
+	if !slices.Contains(ts.config.ChainIds, chainId) {
+		return nil, fmt.Errorf("%w: AVS %s does not use chain %d", ErrInvalidTask, ts.config.AvsAddress, chainId)
+	}
+
+	now := time.Now()
+	deadline := req.Deadline
+	if deadline.IsZero() {
+		deadline = now.Add(ts.config.DefaultTimeout)
+	}
+	if !deadline.After(now) {
+		return nil, fmt.Errorf("%w: deadline %s has passed", ErrInvalidTask, deadline.Format(time.RFC3339))
+	}
+	if deadline.After(now.Add(ts.config.MaxTimeout)) {
+		return nil, fmt.Errorf("%w: deadline is more than %s away", ErrInvalidTask, ts.config.MaxTimeout)
+	}
+
+	referenceBlock := req.ReferenceBlockNumber
+	if referenceBlock == 0 {
+		lastBlock, err := ts.store.GetLastProcessedBlock(ctx, ts.config.AvsAddress, chainId)
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("%w: no block of chain %d has been processed yet", ErrNoReferenceBlock, chainId)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get last processed block of chain %d: %w", chainId, err)
+		}
+		referenceBlock = lastBlock.Number
+	}
+
+	taskId, err := newTaskId(source, ts.config.AvsAddress, chainId, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Task{
+		TaskId:              taskId,
+		AVSAddress:          ts.config.AvsAddress,
+		OperatorSetId:       req.OperatorSetId,
+		DeadlineUnixSeconds: &deadline,
+		Payload:             req.Payload,
+		ChainId:             chainId,
+		SourceBlockNumber:   referenceBlock,
+		Source:              source,
+		Context:             ctx,
+	}, nil
+}
+
+// newTaskId derives the ID of an off-chain task from its source, AVS, operator set,
+// chain, payload and idempotency key, or from random bytes if it has no key. The
+// reference block and deadline are left out so that a retried request keeps its ID.
+func newTaskId(source string, avsAddress string, chainId config.ChainId, req *TaskRequest) (string, error) {
+	key := []byte(req.IdempotencyKey)
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return "", fmt.Errorf("failed to generate task ID: %w", err)
+		}
+	}
+
+	operatorSetId := binary.BigEndian.AppendUint32(nil, req.OperatorSetId)
+	chain := binary.BigEndian.AppendUint64(nil, uint64(chainId))
+	return hexutil.Encode(crypto.Keccak256(
+		crypto.Keccak256([]byte(source)),
+		common.HexToAddress(avsAddress).Bytes(),
+		operatorSetId,
+		chain,
+		crypto.Keccak256(req.Payload),
+		crypto.Keccak256(key),
+	)), nil
+}
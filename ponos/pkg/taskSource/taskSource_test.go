@@ -0,0 +1,221 @@
+package taskSource
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/aggregator/storage/memory"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/config"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/logger"
+	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAvsAddress = "0x1234567890123456789012345678901234567890"
+
+type sinkTest struct {
+	store     *memory.InMemoryAggregatorStore
+	taskQueue chan *types.Task
+	sink      *TaskSink
+}
+
+func newSinkTest(t *testing.T, queueDepth int) *sinkTest {
+	l, err := logger.NewLogger(&logger.LoggerConfig{Debug: false})
+	require.NoError(t, err)
+
+	store := memory.NewInMemoryAggregatorStore()
+	require.NoError(t, store.SaveBlock(context.Background(), testAvsAddress, &storage.BlockRecord{
+		Number:  100,
+		Hash:    "0xblock100",
+		ChainId: config.ChainId_EthereumAnvil,
+	}))
+
+	taskQueue := make(chan *types.Task, queueDepth)
+	sink := NewTaskSink(&TaskSinkConfig{
+		AvsAddress:     testAvsAddress,
+		ChainIds:       []config.ChainId{config.ChainId_EthereumAnvil, config.ChainId_BaseAnvil},
+		DefaultChainId: config.ChainId_EthereumAnvil,
+	}, store, taskQueue, l)
+
+	return &sinkTest{store: store, taskQueue: taskQueue, sink: sink}
+}
+
+func TestTaskSink_Submit(t *testing.T) {
+	t.Run("queues a pending task with defaults", func(t *testing.T) {
+		st := newSinkTest(t, 10)
+
+		task, err := st.sink.Submit(context.Background(), SubmitSourceName, &TaskRequest{
+			OperatorSetId: 1,
+			Payload:       []byte("payload"),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, config.ChainId_EthereumAnvil, task.ChainId)
+		assert.Equal(t, uint64(100), task.SourceBlockNumber)
+		assert.Equal(t, SubmitSourceName, task.Source)
+		assert.False(t, task.IsOnChain())
+		assert.WithinDuration(t, time.Now().Add(DefaultTaskTimeout), *task.DeadlineUnixSeconds, 5*time.Second)
+
+		record, err := st.store.GetTaskRecord(context.Background(), task.TaskId)
+		require.NoError(t, err)
+		assert.Equal(t, storage.TaskStatusPending, record.Status)
+		assert.Equal(t, SubmitSourceName, record.Task.Source)
+
+		require.Len(t, st.taskQueue, 1)
+		assert.Equal(t, task.TaskId, (<-st.taskQueue).TaskId)
+	})
+
+	t.Run("returns the existing task for a repeated idempotency key", func(t *testing.T) {
+		st := newSinkTest(t, 10)
+		req := &TaskRequest{Payload: []byte("payload"), IdempotencyKey: "key-1"}
+
+		first, err := st.sink.Submit(context.Background(), SubmitSourceName, req)
+		require.NoError(t, err)
+		second, err := st.sink.Submit(context.Background(), SubmitSourceName, req)
+		require.NoError(t, err)
+
+		assert.Equal(t, first.TaskId, second.TaskId)
+		assert.Len(t, st.taskQueue, 1)
+
+		other, err := st.sink.Submit(context.Background(), SubmitSourceName, &TaskRequest{Payload: []byte("payload"), IdempotencyKey: "key-2"})
+		require.NoError(t, err)
+		assert.NotEqual(t, first.TaskId, other.TaskId)
+	})
+
+	t.Run("creates a new task for every request without a key", func(t *testing.T) {
+		st := newSinkTest(t, 10)
+		req := &TaskRequest{Payload: []byte("payload")}
+
+		first, err := st.sink.Submit(context.Background(), SubmitSourceName, req)
+		require.NoError(t, err)
+		second, err := st.sink.Submit(context.Background(), SubmitSourceName, req)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, first.TaskId, second.TaskId)
+	})
+
+	t.Run("rejects tasks when the queue is full without storing them", func(t *testing.T) {
+		st := newSinkTest(t, 1)
+
+		_, err := st.sink.Submit(context.Background(), SubmitSourceName, &TaskRequest{Payload: []byte("first")})
+		require.NoError(t, err)
+
+		_, err = st.sink.Submit(context.Background(), SubmitSourceName, &TaskRequest{Payload: []byte("second"), IdempotencyKey: "second"})
+		assert.ErrorIs(t, err, ErrQueueFull)
+
+		page, err := st.store.ListTasks(context.Background(), &storage.TaskFilter{AvsAddress: testAvsAddress})
+		require.NoError(t, err)
+		assert.Len(t, page.Tasks, 1)
+	})
+
+	t.Run("rejects invalid requests", func(t *testing.T) {
+		st := newSinkTest(t, 10)
+
+		tests := map[string]*TaskRequest{
+			"empty payload":     {},
+			"unsupported chain": {Payload: []byte("payload"), ChainId: config.ChainId_EthereumMainnet},
+			"passed deadline":   {Payload: []byte("payload"), Deadline: time.Now().Add(-time.Second)},
+			"deadline too far":  {Payload: []byte("payload"), Deadline: time.Now().Add(2 * DefaultMaxTimeout)},
+		}
+		for name, req := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := st.sink.Submit(context.Background(), SubmitSourceName, req)
+				assert.ErrorIs(t, err, ErrInvalidTask)
+			})
+		}
+		assert.Len(t, st.taskQueue, 0)
+	})
+
+	t.Run("requires a reference block on chains without processed blocks", func(t *testing.T) {
+		st := newSinkTest(t, 10)
+
+		_, err := st.sink.Submit(context.Background(), SubmitSourceName, &TaskRequest{
+			Payload: []byte("payload"),
+			ChainId: config.ChainId_BaseAnvil,
+		})
+		assert.ErrorIs(t, err, ErrNoReferenceBlock)
+
+		task, err := st.sink.Submit(context.Background(), SubmitSourceName, &TaskRequest{
+			Payload:              []byte("payload"),
+			ChainId:              config.ChainId_BaseAnvil,
+			ReferenceBlockNumber: 42,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(42), task.SourceBlockNumber)
+	})
+}
+
+func TestSubmitSource_Submit(t *testing.T) {
+	st := newSinkTest(t, 10)
+	source := NewSubmitSource(st.sink)
+
+	_, err := source.Submit(&TaskRequest{Payload: []byte("payload")})
+	assert.ErrorIs(t, err, ErrNotStarted)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, source.Start(ctx))
+
+	task, err := source.Submit(&TaskRequest{Payload: []byte("payload")})
+	require.NoError(t, err)
+	assert.Equal(t, SubmitSourceName, task.Source)
+
+	cancel()
+	<-task.Context.Done()
+
+	_, err = source.Submit(&TaskRequest{Payload: []byte("payload")})
+	assert.ErrorIs(t, err, ErrNotStarted)
+}
+
+func TestFileSource_readNewLines(t *testing.T) {
+	st := newSinkTest(t, 2)
+	l, err := logger.NewLogger(&logger.LoggerConfig{Debug: false})
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "tasks.jsonl")
+	source := NewFileSource(&FileSourceConfig{Path: path}, st.sink, l)
+	ctx := context.Background()
+
+	// a missing file has no tasks yet
+	require.NoError(t, source.readNewLines(ctx))
+	assert.Len(t, st.taskQueue, 0)
+
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	_, err = file.WriteString(`{"operatorSetId": 1, "payload": "0x01"}` + "\n" +
+		`not json` + "\n" +
+		`{"operatorSetId": 2, "payload": "0x02", "timeoutSeconds": 30}` + "\n" +
+		`{"operatorSetId": 3, "payload": "0x03"}` + "\n" +
+		`{"operatorSetId": 4, "payl`)
+	require.NoError(t, err)
+
+	// the invalid line is skipped and the third task does not fit in the queue
+	require.NoError(t, source.readNewLines(ctx))
+	require.Len(t, st.taskQueue, 2)
+	first := <-st.taskQueue
+	second := <-st.taskQueue
+	assert.Equal(t, uint32(1), first.OperatorSetId)
+	assert.Equal(t, FileSourceName, first.Source)
+	assert.Equal(t, uint32(2), second.OperatorSetId)
+	assert.WithinDuration(t, time.Now().Add(30*time.Second), *second.DeadlineUnixSeconds, 5*time.Second)
+
+	// the task left behind is submitted once there is room, and the partial line
+	// once it is complete
+	_, err = file.WriteString(`oad": "0x04"}` + "\n")
+	require.NoError(t, err)
+	require.NoError(t, source.readNewLines(ctx))
+	require.Len(t, st.taskQueue, 2)
+	assert.Equal(t, uint32(3), (<-st.taskQueue).OperatorSetId)
+	assert.Equal(t, uint32(4), (<-st.taskQueue).OperatorSetId)
+
+	// a restarted source reads the file again without creating the tasks twice
+	restarted := NewFileSource(&FileSourceConfig{Path: path}, st.sink, l)
+	require.NoError(t, restarted.readNewLines(ctx))
+	assert.Len(t, st.taskQueue, 0)
+}
@@ -50,6 +50,18 @@ type Task struct {
 	BlockHash              string          `json:"blockHash"`
 	Version                uint32          `json:"version"`
 	Context                context.Context `json:"-"`
+
+	// Source is the task source the task came from. Empty for tasks created on chain
+	Source string `json:"source,omitempty"`
+}
+
+// TaskSourceChain is the source of tasks created by TaskCreated events of a TaskMailbox
+const TaskSourceChain = "chain"
+
+// IsOnChain returns true for tasks created on chain, whose results are submitted to the
+// TaskMailbox. The certificates of other tasks are kept for their submitter to fetch.
+func (t *Task) IsOnChain() bool {
+	return t.Source == "" || t.Source == TaskSourceChain
 }
 
 type TaskResult struct {
@@ -87,3 +87,18 @@ func EncodeString(str string) ([]byte, error) {
 
 	return encoded, nil
 }
+
+// CalculateTaskMessageHash computes the message hash of a task result locally, matching
+// TaskMailbox.getMessageHash: keccak256(abi.encode(taskHash, result))
+func CalculateTaskMessageHash(taskHash [32]byte, result []byte) ([32]byte, error) {
+	bytes32Type, _ := abi.NewType("bytes32", "", nil)
+	bytesType, _ := abi.NewType("bytes", "", nil)
+	arguments := abi.Arguments{{Type: bytes32Type}, {Type: bytesType}}
+
+	encoded, err := arguments.Pack(taskHash, result)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("failed to ABI encode task message: %w", err)
+	}
+
+	return GetKeccak256Digest(encoded), nil
+}
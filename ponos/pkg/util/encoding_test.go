@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -162,3 +163,49 @@ func TestEncodeOperatorInfoLeafAgainstKnownValues(t *testing.T) {
 		t.Logf("Previous on-chain root: 0x%s", previousOnChainRoot)
 	})
 }
+
+func TestCalculateTaskMessageHash(t *testing.T) {
+	var taskHash [32]byte
+	copy(taskHash[:], common.FromHex("0x1111111111111111111111111111111111111111111111111111111111111111"))
+
+	// abi.encode(bytes32, bytes): the hash, the offset of the bytes (0x40), their length and
+	// the bytes right-padded to a multiple of 32
+	word := func(v byte) []byte {
+		w := make([]byte, 32)
+		w[31] = v
+		return w
+	}
+	padded := func(b []byte) []byte {
+		return append(b, make([]byte, (32-len(b)%32)%32)...)
+	}
+
+	tests := []struct {
+		name    string
+		result  []byte
+		encoded []byte
+	}{
+		{
+			name:    "empty result",
+			result:  []byte{},
+			encoded: append(append(taskHash[:], word(0x40)...), word(0)...),
+		},
+		{
+			name:    "short result",
+			result:  []byte("hello"),
+			encoded: append(append(append(taskHash[:], word(0x40)...), word(5)...), padded([]byte("hello"))...),
+		},
+		{
+			name:    "result spanning several words",
+			result:  make([]byte, 70),
+			encoded: append(append(append(taskHash[:], word(0x40)...), word(70)...), make([]byte, 96)...),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := CalculateTaskMessageHash(taskHash, tt.result)
+			require.NoError(t, err)
+			assert.Equal(t, crypto.Keccak256(tt.encoded), hash[:])
+		})
+	}
+}
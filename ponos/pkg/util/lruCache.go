@@ -0,0 +1,64 @@
+package util
+
+import "container/list"
+
+// LruCache is a size bounded least-recently-used cache. It is not safe for
+// concurrent use.
+type LruCache[K comparable, V any] struct {
+	maxEntries int
+	entries    map[K]*list.Element
+	order      *list.List
+}
+
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+func NewLruCache[K comparable, V any](maxEntries int) *LruCache[K, V] {
+	return &LruCache[K, V]{
+		maxEntries: maxEntries,
+		entries:    make(map[K]*list.Element),
+		order:      list.New(),
+	}
+}
+
+func (c *LruCache[K, V]) Get(key K) (V, bool) {
+	if el, ok := c.entries[key]; ok {
+		c.order.MoveToFront(el)
+		return el.Value.(*lruEntry[K, V]).value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (c *LruCache[K, V]) Put(key K, value V) {
+	if el, ok := c.entries[key]; ok {
+		el.Value.(*lruEntry[K, V]).value = value
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value})
+	for c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry[K, V]).key)
+	}
+}
+
+// RemoveIf removes every entry whose key matches and returns how many were removed
+func (c *LruCache[K, V]) RemoveIf(match func(K) bool) int {
+	removed := 0
+	for key, el := range c.entries {
+		if match(key) {
+			c.order.Remove(el)
+			delete(c.entries, key)
+			removed++
+		}
+	}
+	return removed
+}
+
+func (c *LruCache[K, V]) Len() int {
+	return c.order.Len()
+}
@@ -0,0 +1,37 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLruCache(t *testing.T) {
+	t.Run("evicts the least recently used entries", func(t *testing.T) {
+		cache := NewLruCache[int, string](2)
+		cache.Put(1, "a")
+		cache.Put(2, "b")
+		_, _ = cache.Get(1)
+		cache.Put(3, "c")
+
+		_, ok := cache.Get(2)
+		assert.False(t, ok)
+		v, ok := cache.Get(1)
+		assert.True(t, ok)
+		assert.Equal(t, "a", v)
+		assert.Equal(t, 2, cache.Len())
+	})
+
+	t.Run("removes matching entries", func(t *testing.T) {
+		cache := NewLruCache[int, string](4)
+		for i := 1; i <= 4; i++ {
+			cache.Put(i, "v")
+		}
+
+		removed := cache.RemoveIf(func(k int) bool { return k%2 == 0 })
+		assert.Equal(t, 2, removed)
+		assert.Equal(t, 2, cache.Len())
+		_, ok := cache.Get(2)
+		assert.False(t, ok)
+	})
+}